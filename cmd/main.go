@@ -11,7 +11,14 @@ import (
 var appVersion = "v0.0.0"
 
 func main() {
-	if err := application.New(appVersion).Run(); err != nil {
+	app := application.New(appVersion)
+
+	run := app.Run
+	if application.IsCommand(os.Args[1:]) {
+		run = func() error { return app.RunCommand(os.Args[1:]) }
+	}
+
+	if err := run(); err != nil {
 		fmt.Fprintln(os.Stderr, err)
 		os.Exit(1)
 	}
@@ -0,0 +1,3 @@
+ALTER TABLE users DROP COLUMN seniority;
+
+ALTER TABLE teams DROP COLUMN reviewer_strategy;
@@ -0,0 +1,7 @@
+ALTER TABLE teams
+    ADD COLUMN reviewer_strategy VARCHAR(32) NOT NULL DEFAULT 'random',
+    ADD CHECK (reviewer_strategy IN ('random', 'least_loaded', 'round_robin', 'seniority_weighted'));
+
+ALTER TABLE users
+    ADD COLUMN seniority INT NOT NULL DEFAULT 1,
+    ADD CHECK (seniority > 0);
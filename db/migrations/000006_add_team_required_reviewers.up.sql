@@ -0,0 +1,3 @@
+ALTER TABLE teams
+    ADD COLUMN required_reviewers INT NOT NULL DEFAULT 2,
+    ADD CHECK (required_reviewers > 0);
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_pr_reviewers_reviewer_id;
+DROP INDEX IF EXISTS idx_pull_requests_author_id;
+DROP INDEX IF EXISTS idx_pull_requests_created_at_id;
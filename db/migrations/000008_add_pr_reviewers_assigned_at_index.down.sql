@@ -0,0 +1 @@
+DROP INDEX IF EXISTS idx_pr_reviewers_reviewer_assigned_at;
@@ -0,0 +1 @@
+CREATE INDEX IF NOT EXISTS idx_pr_reviewers_reviewer_assigned_at ON pr_reviewers (reviewer_id, assigned_at DESC, pull_request_id DESC);
@@ -0,0 +1,6 @@
+DELETE FROM pr_reviewers WHERE NOT is_current;
+
+DROP INDEX IF EXISTS idx_pr_reviewers_current;
+ALTER TABLE pr_reviewers ADD CONSTRAINT pr_reviewers_pull_request_id_reviewer_id_key UNIQUE (pull_request_id, reviewer_id);
+
+ALTER TABLE pr_reviewers DROP COLUMN replace_reason;
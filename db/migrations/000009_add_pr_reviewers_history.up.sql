@@ -0,0 +1,7 @@
+ALTER TABLE pr_reviewers
+    ADD COLUMN replace_reason VARCHAR(32),
+    ADD CHECK (replace_reason IN ('manual_reassign', 'deactivation', 'team_move'));
+
+-- история хранит несколько записей на пару (PR, ревьювер), уникален только текущий ревьювер
+ALTER TABLE pr_reviewers DROP CONSTRAINT pr_reviewers_pull_request_id_reviewer_id_key;
+CREATE UNIQUE INDEX IF NOT EXISTS idx_pr_reviewers_current ON pr_reviewers (pull_request_id, reviewer_id) WHERE is_current;
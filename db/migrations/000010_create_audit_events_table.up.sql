@@ -0,0 +1,25 @@
+CREATE TABLE audit_events (
+                              id BIGSERIAL PRIMARY KEY,
+                              actor VARCHAR(255) NOT NULL,
+                              operation VARCHAR(64) NOT NULL,
+                              entity_type VARCHAR(32) NOT NULL,
+                              entity_id VARCHAR(255) NOT NULL,
+                              before JSONB,
+                              after JSONB,
+                              trace_id VARCHAR(64),
+                              created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_audit_events_entity ON audit_events (entity_type, entity_id, created_at DESC, id DESC);
+CREATE INDEX IF NOT EXISTS idx_audit_events_created_at ON audit_events (created_at DESC, id DESC);
+
+-- журнал только дополняется: изменение и удаление записей запрещены
+CREATE FUNCTION audit_events_append_only() RETURNS trigger AS $$
+BEGIN
+    RAISE EXCEPTION 'audit_events is append-only';
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER audit_events_append_only
+    BEFORE UPDATE OR DELETE ON audit_events
+    FOR EACH ROW EXECUTE FUNCTION audit_events_append_only();
@@ -0,0 +1,12 @@
+CREATE TABLE outbox_events (
+                               id BIGSERIAL PRIMARY KEY,
+                               event_type VARCHAR(64) NOT NULL,
+                               payload JSONB NOT NULL,
+                               attempts INT NOT NULL DEFAULT 0,
+                               next_attempt_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+                               last_error TEXT,
+                               created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+                               processed_at TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_outbox_events_pending ON outbox_events (next_attempt_at, id) WHERE processed_at IS NULL;
@@ -0,0 +1,16 @@
+CREATE TABLE external_identities (
+                                     provider VARCHAR(16) NOT NULL,
+                                     login VARCHAR(255) NOT NULL,
+                                     user_id VARCHAR(255) NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+                                     created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+                                     PRIMARY KEY (provider, login),
+                                     CHECK (provider IN ('github', 'gitlab'))
+);
+
+-- обработанные входящие вебхуки VCS, чтобы повторная доставка не выполнялась дважды
+CREATE TABLE inbound_deliveries (
+                                    provider VARCHAR(16) NOT NULL,
+                                    delivery_id VARCHAR(255) NOT NULL,
+                                    processed_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+                                    PRIMARY KEY (provider, delivery_id)
+);
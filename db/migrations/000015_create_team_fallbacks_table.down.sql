@@ -0,0 +1,3 @@
+ALTER TABLE pr_reviewers DROP COLUMN source_team;
+
+DROP TABLE IF EXISTS team_fallbacks;
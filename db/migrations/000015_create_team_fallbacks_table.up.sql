@@ -0,0 +1,9 @@
+CREATE TABLE team_fallbacks (
+                                team_name VARCHAR(255) NOT NULL REFERENCES teams(name) ON DELETE CASCADE,
+                                fallback_team VARCHAR(255) NOT NULL REFERENCES teams(name) ON DELETE CASCADE,
+                                priority INT NOT NULL,
+                                PRIMARY KEY (team_name, fallback_team),
+                                CHECK (team_name != fallback_team)
+);
+
+ALTER TABLE pr_reviewers ADD COLUMN source_team VARCHAR(255);
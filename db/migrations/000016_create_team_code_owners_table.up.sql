@@ -0,0 +1,5 @@
+CREATE TABLE team_code_owners (
+                                  team_name VARCHAR(255) PRIMARY KEY REFERENCES teams(name) ON DELETE CASCADE,
+                                  content TEXT NOT NULL,
+                                  updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
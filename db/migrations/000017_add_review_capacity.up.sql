@@ -0,0 +1,3 @@
+-- NULL — без ограничения; лимит пользователя важнее лимита команды
+ALTER TABLE teams ADD COLUMN max_open_reviews INT CHECK (max_open_reviews > 0);
+ALTER TABLE users ADD COLUMN max_open_reviews INT CHECK (max_open_reviews > 0);
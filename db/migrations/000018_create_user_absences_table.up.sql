@@ -0,0 +1,16 @@
+-- started_at/finished_at проставляет планировщик; deactivated — отсутствие само выключило пользователя
+-- и должно вернуть его по окончании
+CREATE TABLE user_absences (
+                               id BIGSERIAL PRIMARY KEY,
+                               user_id VARCHAR(255) NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+                               starts_at TIMESTAMP NOT NULL,
+                               ends_at TIMESTAMP NOT NULL,
+                               reason TEXT NOT NULL DEFAULT '',
+                               deactivated BOOLEAN NOT NULL DEFAULT FALSE,
+                               created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+                               started_at TIMESTAMP,
+                               finished_at TIMESTAMP,
+                               CHECK (ends_at > starts_at)
+);
+
+CREATE INDEX IF NOT EXISTS idx_user_absences_pending ON user_absences (user_id, starts_at) WHERE finished_at IS NULL;
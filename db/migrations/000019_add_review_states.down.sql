@@ -0,0 +1,6 @@
+ALTER TABLE teams DROP COLUMN require_approvals;
+
+ALTER TABLE pr_reviewers
+    DROP COLUMN reviewed_at,
+    DROP COLUMN review_comment,
+    DROP COLUMN review_state;
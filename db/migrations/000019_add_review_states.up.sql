@@ -0,0 +1,8 @@
+ALTER TABLE pr_reviewers
+    ADD COLUMN review_state VARCHAR(32) NOT NULL DEFAULT 'pending',
+    ADD COLUMN review_comment TEXT NOT NULL DEFAULT '',
+    ADD COLUMN reviewed_at TIMESTAMP,
+    ADD CHECK (review_state IN ('pending', 'approved', 'changes_requested', 'commented'));
+
+-- слияние PR команды требует required_reviewers одобрений текущих ревьюверов
+ALTER TABLE teams ADD COLUMN require_approvals BOOLEAN NOT NULL DEFAULT FALSE;
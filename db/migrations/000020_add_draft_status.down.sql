@@ -0,0 +1,8 @@
+UPDATE pr_reviewers SET replace_reason = 'manual_reassign' WHERE replace_reason = 'pr_closed';
+ALTER TABLE pr_reviewers DROP CONSTRAINT pr_reviewers_replace_reason_check;
+ALTER TABLE pr_reviewers ADD CONSTRAINT pr_reviewers_replace_reason_check
+    CHECK (replace_reason IN ('manual_reassign', 'deactivation', 'team_move'));
+
+UPDATE pull_requests SET status = 'OPEN' WHERE status = 'DRAFT';
+ALTER TABLE pull_requests DROP CONSTRAINT pull_requests_status_check;
+ALTER TABLE pull_requests ADD CONSTRAINT pull_requests_status_check CHECK (status IN ('OPEN', 'MERGED', 'CLOSED'));
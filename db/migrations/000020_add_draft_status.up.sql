@@ -0,0 +1,7 @@
+ALTER TABLE pull_requests DROP CONSTRAINT pull_requests_status_check;
+ALTER TABLE pull_requests ADD CONSTRAINT pull_requests_status_check CHECK (status IN ('DRAFT', 'OPEN', 'MERGED', 'CLOSED'));
+
+-- при закрытии PR ревьюверы снимаются, чтобы освободить их нагрузку
+ALTER TABLE pr_reviewers DROP CONSTRAINT pr_reviewers_replace_reason_check;
+ALTER TABLE pr_reviewers ADD CONSTRAINT pr_reviewers_replace_reason_check
+    CHECK (replace_reason IN ('manual_reassign', 'deactivation', 'team_move', 'pr_closed'));
@@ -0,0 +1,15 @@
+-- ответ на запрос с Idempotency-Key; пока status_code пуст, запрос ещё выполняется.
+-- scope — вызывающий, метод и путь, чтобы одинаковые ключи разных клиентов и операций не пересекались
+CREATE TABLE idempotency_keys (
+                                  scope TEXT NOT NULL,
+                                  key VARCHAR(255) NOT NULL,
+                                  fingerprint VARCHAR(64) NOT NULL,
+                                  status_code INT,
+                                  content_type TEXT,
+                                  response_body BYTEA,
+                                  created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+                                  expires_at TIMESTAMP NOT NULL,
+                                  PRIMARY KEY (scope, key)
+);
+
+CREATE INDEX IF NOT EXISTS idx_idempotency_keys_expires_at ON idempotency_keys (expires_at);
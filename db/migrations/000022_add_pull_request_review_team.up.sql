@@ -0,0 +1,3 @@
+-- команда, из пулов которой добираются ревьюверы PR вместо команды автора:
+-- задаётся, когда PR передаётся другой команде при архивации или удалении команды автора
+ALTER TABLE pull_requests ADD COLUMN review_team VARCHAR(255) REFERENCES teams(name) ON DELETE SET NULL;
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"golang.org/x/sync/errgroup"
 	"log/slog"
+	"net"
 	"net/http"
 	"os/signal"
 	"pull_requests_service/internal/config"
@@ -15,11 +16,16 @@ import (
 	"pull_requests_service/pkg/application/connectors"
 	"pull_requests_service/pkg/application/modules"
 	"pull_requests_service/pkg/contextx"
+	"pull_requests_service/pkg/jwtx"
 	"pull_requests_service/pkg/middlewarex"
+	"pull_requests_service/pkg/webhook"
 	"syscall"
 
 	"github.com/go-chi/chi/v5"
 	"github.com/go-chi/chi/v5/middleware"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/samber/lo"
 )
 
@@ -29,16 +35,31 @@ type App struct {
 	cfg        config.Config
 	slog       *connectors.Slog
 	postgres   *connectors.Postgres
+	tracing    *connectors.Tracing
 	httpServer modules.HTTPServer
 
-	userRepo *persistence.UserRepository
-	teamRepo *persistence.TeamRepository
-	prRepo   *persistence.PullRequestRepository
-
-	userService *service.UserService
-	teamService *service.TeamService
-	prService   *service.PullRequestService
-	statService *service.StatisticsService
+	userRepo        *persistence.UserRepository
+	teamRepo        *persistence.TeamRepository
+	prRepo          *persistence.PullRequestRepository
+	auditRepo       *persistence.AuditRepository
+	outboxRepo      *persistence.OutboxRepository
+	webhookRepo     *persistence.WebhookRepository
+	integrationRepo *persistence.IntegrationRepository
+	absenceRepo     *persistence.AbsenceRepository
+	idempotencyRepo *persistence.IdempotencyRepository
+	bulkRepo        *persistence.BulkRepository
+
+	userService        *service.UserService
+	teamService        *service.TeamService
+	prService          *service.PullRequestService
+	statService        *service.StatisticsService
+	auditService       *service.AuditService
+	webhookService     *service.WebhookService
+	integrationService *service.IntegrationService
+	absenceService     *service.AbsenceService
+	idempotencyService *service.IdempotencyService
+	bulkService        *service.BulkService
+	healthService      *service.HealthService
 }
 
 func New(appVersion string) App {
@@ -59,6 +80,13 @@ func New(appVersion string) App {
 			MaxOpenConns:    cfg.Postgres.MaxOpenConns,
 			ConnMaxLifetime: cfg.Postgres.ConnMaxLifetime,
 		},
+		tracing: &connectors.Tracing{
+			Name:          appName,
+			Version:       appVersion,
+			Endpoint:      cfg.Tracing.OTLPEndpoint,
+			SampleRatio:   cfg.Tracing.SampleRatio,
+			ExportTimeout: cfg.Tracing.ExportTimeout,
+		},
 
 		httpServer: modules.HTTPServer{
 			ShutdownTimeout: cfg.HTTP.ShutdownTimeout,
@@ -68,6 +96,7 @@ func New(appVersion string) App {
 
 func (app App) shutdown(ctx context.Context) {
 	app.postgres.Close(ctx)
+	app.tracing.Close(ctx)
 }
 
 func (app App) Run() error {
@@ -85,6 +114,8 @@ func (app App) Run() error {
 
 	logger(ctx).Info("config", slog.Any("config", app.cfg))
 
+	app.tracing.Provider(ctx)
+
 	client := app.postgres.Client(ctx)
 	err := app.postgres.RunMigrations(ctx)
 	if err != nil {
@@ -94,24 +125,89 @@ func (app App) Run() error {
 	app.userRepo = persistence.NewUserRepository(client)
 	app.teamRepo = persistence.NewTeamRepository(client)
 	app.prRepo = persistence.NewPullRequestRepository(client)
+	app.auditRepo = persistence.NewAuditRepository(client)
+	app.outboxRepo = persistence.NewOutboxRepository(client)
+	app.webhookRepo = persistence.NewWebhookRepository(client)
+	app.integrationRepo = persistence.NewIntegrationRepository(client)
+	app.absenceRepo = persistence.NewAbsenceRepository(client)
+	app.idempotencyRepo = persistence.NewIdempotencyRepository(client)
+	app.bulkRepo = persistence.NewBulkRepository(client)
+
+	prometheus.MustRegister(
+		collectors.NewDBStatsCollector(client.DB, "postgres"),
+		persistence.NewStatsCollector(client),
+	)
 
-	jobs := make(chan service.PrWorkerJob, 100)
-
-	app.userService = service.NewUserService(app.userRepo, jobs)
-	app.teamService = service.NewTeamService(app.teamRepo, app.userRepo)
-	app.prService = service.NewPullRequestService(app.userRepo, app.prRepo, jobs)
+	selectors := service.NewReviewerSelectors()
+
+	app.userService = service.NewUserService(app.userRepo)
+	app.teamService = service.NewTeamService(app.teamRepo, app.userRepo, selectors)
+	app.prService = service.NewPullRequestService(app.userRepo, app.teamRepo, app.prRepo, app.outboxRepo, selectors,
+		service.OutboxOptions{
+			PollInterval: app.cfg.Outbox.PollInterval,
+			BatchSize:    app.cfg.Outbox.BatchSize,
+			Lease:        app.cfg.Outbox.Lease,
+			MaxBackoff:   app.cfg.Outbox.MaxBackoff,
+		})
 	app.statService = service.NewStatisticsService(app.userRepo)
+	app.auditService = service.NewAuditService(app.auditRepo)
+	app.webhookService = service.NewWebhookService(app.webhookRepo,
+		webhook.NewClient(&http.Client{Timeout: app.cfg.Webhook.Timeout}),
+		service.WebhookOptions{
+			PollInterval: app.cfg.Webhook.PollInterval,
+			BatchSize:    app.cfg.Webhook.BatchSize,
+			Lease:        app.cfg.Webhook.Lease,
+			MaxBackoff:   app.cfg.Webhook.MaxBackoff,
+			MaxAttempts:  app.cfg.Webhook.MaxAttempts,
+		})
+	app.integrationService = service.NewIntegrationService(app.integrationRepo, app.prService)
+	app.absenceService = service.NewAbsenceService(app.absenceRepo, service.AbsenceOptions{
+		PollInterval: app.cfg.Absence.PollInterval,
+	})
+	app.idempotencyService = service.NewIdempotencyService(app.idempotencyRepo, service.IdempotencyOptions{
+		TTL:             app.cfg.Idempotency.TTL,
+		LockTimeout:     app.cfg.Idempotency.LockTimeout,
+		CleanupInterval: app.cfg.Idempotency.CleanupInterval,
+	})
+	app.bulkService = service.NewBulkService(app.bulkRepo, app.teamService, app.prService, service.BulkOptions{
+		BatchSize: app.cfg.Bulk.BatchSize,
+	})
+	app.healthService = service.NewHealthService(client, app.postgres, app.prService, service.HealthOptions{
+		CheckTimeout:     app.cfg.Health.CheckTimeout,
+		HeartbeatTimeout: app.cfg.Health.HeartbeatTimeout,
+	})
 
 	g, gCtx := errgroup.WithContext(ctx)
 
 	httpSrv := app.newHTTPServer(gCtx)
 	app.httpServer.Run(gCtx, g, httpSrv)
 
+	g.Go(func() error {
+		<-gCtx.Done()
+		app.healthService.SetShuttingDown()
+		return nil
+	})
+
 	g.Go(func() error {
 		app.prService.StartEventWorker(gCtx)
 		return nil
 	})
 
+	g.Go(func() error {
+		app.webhookService.StartDeliveryWorker(gCtx)
+		return nil
+	})
+
+	g.Go(func() error {
+		app.absenceService.StartScheduler(gCtx)
+		return nil
+	})
+
+	g.Go(func() error {
+		app.idempotencyService.StartCleaner(gCtx)
+		return nil
+	})
+
 	if err = g.Wait(); err != nil {
 		if !errors.Is(err, context.Canceled) && !errors.Is(err, http.ErrServerClosed) {
 			logger(gCtx).Error("errgroup wait error", slog.Any("error", err))
@@ -130,14 +226,38 @@ func (app App) newHTTPServer(ctx context.Context) *http.Server { //nolint:funlen
 
 	router.Use(
 		middleware.RealIP,
+		middlewarex.RequestID,
+		middlewarex.Tracing,
 		middlewarex.Logger,
+		middlewarex.Metrics,
+		middlewarex.Auth(jwtx.NewVerifier(app.cfg.Auth.Keys(), app.cfg.Auth.ClockSkew)),
 	)
 
-	server := server.NewServer(app.prService, app.teamService, app.userService, app.statService)
+	srv := server.NewServer(app.prService, app.teamService, app.userService, app.statService, app.auditService,
+		app.webhookService, app.integrationService, app.absenceService, app.healthService)
+
+	integrations := server.NewIntegrationHandler(app.integrationService,
+		app.cfg.Integrations.GitHubSecret, app.cfg.Integrations.GitLabToken)
+	router.Handle("/metrics", promhttp.Handler())
+
+	router.With(middlewarex.RouteLogger).Post("/integrations/github", integrations.GitHub)
+	router.With(middlewarex.RouteLogger).Post("/integrations/gitlab", integrations.GitLab)
 
-	handler := generated.NewStrictHandler(server, nil)
+	bulk := server.NewBulkHandler(app.bulkService, app.cfg.Bulk.MaxFileSize)
+	router.With(middlewarex.RouteLogger, server.RequireAdmin, bulk.LimitBody,
+		server.Idempotency(app.idempotencyService, app.cfg.Bulk.MaxFileSize)).
+		Post("/bulk/import", bulk.Import)
+	router.With(middlewarex.RouteLogger, server.RequireAdmin).Get("/bulk/export", bulk.Export)
 
-	generated.HandlerFromMux(handler, router)
+	handler := generated.NewStrictHandler(srv, []generated.StrictMiddlewareFunc{server.Trace})
+
+	// последний в списке выполняется первым: Idempotency видит только авторизованные запросы
+	generated.HandlerWithOptions(handler, generated.ChiServerOptions{
+		BaseRouter: router,
+		Middlewares: []generated.MiddlewareFunc{
+			server.Idempotency(app.idempotencyService, app.cfg.Idempotency.MaxBodySize), server.Authorize, middlewarex.RouteLogger,
+		},
+	})
 
 	return &http.Server{
 		//nolint:exhaustruct
@@ -147,5 +267,7 @@ func (app App) newHTTPServer(ctx context.Context) *http.Server { //nolint:funlen
 		ReadHeaderTimeout: app.cfg.HTTP.ReadTimeout,
 		IdleTimeout:       app.cfg.HTTP.IdleTimeout,
 		Handler:           router,
+		// логгер приложения нужен в контексте запросов; отмену при остановке не наследуем — её ведёт Shutdown
+		BaseContext: func(net.Listener) context.Context { return context.WithoutCancel(ctx) },
 	}
 }
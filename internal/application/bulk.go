@@ -0,0 +1,137 @@
+package application
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"pull_requests_service/internal/domain/service"
+	"pull_requests_service/internal/infrastructure/persistence"
+	"pull_requests_service/pkg/bulkfile"
+	"pull_requests_service/pkg/contextx"
+	"strings"
+	"syscall"
+)
+
+// ErrImportRows — импорт завершился, но часть строк не применена; подробности в выведенном результате.
+var ErrImportRows = errors.New("some rows were not imported")
+
+// IsCommand сообщает, что аргументы запуска — подкоманда CLI, а не запуск сервера.
+func IsCommand(args []string) bool {
+	return len(args) > 0 && (args[0] == "import" || args[0] == "export")
+}
+
+// RunCommand выполняет подкоманду CLI:
+//
+//	import -kind teams|users|pull_requests [-format jsonl|csv] [-file path]
+//	export -kind teams|users|pull_requests [-format jsonl|csv] [-out path]
+//
+// Без -file и -out используются stdin и stdout, формат по умолчанию берётся из расширения файла.
+// Логи пишутся в stderr, чтобы не смешиваться с выгрузкой.
+func (app App) RunCommand(args []string) error {
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	ctx = contextx.WithLogger(ctx, slog.New(slog.NewTextHandler(os.Stderr, nil)))
+
+	flags := flag.NewFlagSet(args[0], flag.ContinueOnError)
+	kind := flags.String("kind", "", "teams, users or pull_requests")
+	format := flags.String("format", "", "jsonl or csv, by default taken from the file extension")
+	path := flags.String("file", "-", "file to import, - for stdin")
+	if args[0] == "export" {
+		path = flags.String("out", "-", "file to export to, - for stdout")
+	}
+	if err := flags.Parse(args[1:]); err != nil {
+		return err
+	}
+	if *format == "" {
+		*format = formatFromPath(*path)
+	}
+
+	defer app.shutdown(ctx)
+
+	client := app.postgres.Client(ctx)
+	if err := app.postgres.RunMigrations(ctx); err != nil {
+		return fmt.Errorf("run migrations: %w", err)
+	}
+
+	app.userRepo = persistence.NewUserRepository(client)
+	app.teamRepo = persistence.NewTeamRepository(client)
+	app.prRepo = persistence.NewPullRequestRepository(client)
+	app.outboxRepo = persistence.NewOutboxRepository(client)
+	app.bulkRepo = persistence.NewBulkRepository(client)
+
+	// подбор ревьюверов при импорте PR использует те же стратегии, что и сервер
+	selectors := service.NewReviewerSelectors()
+	app.teamService = service.NewTeamService(app.teamRepo, app.userRepo, selectors)
+	app.prService = service.NewPullRequestService(app.userRepo, app.teamRepo, app.prRepo, app.outboxRepo, selectors,
+		service.OutboxOptions{})
+	app.bulkService = service.NewBulkService(app.bulkRepo, app.teamService, app.prService, service.BulkOptions{
+		BatchSize: app.cfg.Bulk.BatchSize,
+	})
+
+	if args[0] == "export" {
+		return app.export(ctx, *kind, *format, *path)
+	}
+	return app.importFile(ctx, *kind, *format, *path)
+}
+
+func (app App) importFile(ctx context.Context, kind, format, path string) error {
+	var r io.Reader = os.Stdin
+	if path != "-" {
+		file, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+		r = file
+	}
+
+	result, err := app.bulkService.Import(ctx, kind, format, r)
+	if err != nil {
+		return err
+	}
+
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+	if err = encoder.Encode(result); err != nil {
+		return err
+	}
+	if len(result.Errors) > 0 {
+		return fmt.Errorf("%w: %d of %d", ErrImportRows, len(result.Errors), result.Total)
+	}
+
+	return nil
+}
+
+func (app App) export(ctx context.Context, kind, format, path string) error {
+	if path == "-" {
+		return app.bulkService.Export(ctx, kind, format, os.Stdout)
+	}
+
+	file, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	if err = app.bulkService.Export(ctx, kind, format, file); err != nil {
+		_ = file.Close()
+		return err
+	}
+
+	return file.Close()
+}
+
+func formatFromPath(path string) string {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".csv":
+		return bulkfile.FormatCSV
+	default:
+		return bulkfile.FormatJSONL
+	}
+}
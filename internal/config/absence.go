@@ -0,0 +1,8 @@
+package config
+
+import "time"
+
+type Absence struct {
+	// PollInterval — как часто планировщик проверяет начало и конец отсутствий.
+	PollInterval time.Duration `env:"ABSENCE_POLL_INTERVAL" envDefault:"1m"`
+}
@@ -0,0 +1,21 @@
+package config
+
+import "time"
+
+type Auth struct {
+	JWTSecret          string        `env:"AUTH_JWT_SECRET,notEmpty" json:"-"`
+	JWTPreviousSecrets []string      `env:"AUTH_JWT_PREVIOUS_SECRETS" json:"-"`
+	ClockSkew          time.Duration `env:"AUTH_CLOCK_SKEW" envDefault:"30s"`
+}
+
+// Keys возвращает текущий секрет и секреты, которые ещё принимаются после ротации.
+func (a Auth) Keys() [][]byte {
+	keys := make([][]byte, 0, len(a.JWTPreviousSecrets)+1)
+	keys = append(keys, []byte(a.JWTSecret))
+
+	for _, secret := range a.JWTPreviousSecrets {
+		keys = append(keys, []byte(secret))
+	}
+
+	return keys
+}
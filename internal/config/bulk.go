@@ -0,0 +1,8 @@
+package config
+
+type Bulk struct {
+	// BatchSize — сколько строк файла применяется в одной транзакции.
+	BatchSize int `env:"BULK_BATCH_SIZE" envDefault:"100"`
+	// MaxFileSize — предельный размер файла импорта через HTTP в байтах.
+	MaxFileSize int64 `env:"BULK_MAX_FILE_SIZE" envDefault:"33554432"`
+}
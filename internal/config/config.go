@@ -10,9 +10,18 @@ import (
 )
 
 type Config struct {
-	Postgres Postgres
-	HTTP     HTTP
-	Debug    bool `env:"DEBUG" envDefault:"false"`
+	Postgres     Postgres
+	HTTP         HTTP
+	Auth         Auth
+	Outbox       Outbox
+	Webhook      Webhook
+	Absence      Absence
+	Idempotency  Idempotency
+	Bulk         Bulk
+	Integrations Integrations
+	Health       Health
+	Tracing      Tracing
+	Debug        bool `env:"DEBUG" envDefault:"false"`
 }
 
 func Load() (Config, error) {
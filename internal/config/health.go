@@ -0,0 +1,9 @@
+package config
+
+import "time"
+
+type Health struct {
+	CheckTimeout time.Duration `env:"HEALTH_CHECK_TIMEOUT" envDefault:"2s"`
+	// HeartbeatTimeout — сколько воркер событий может молчать, прежде чем реплика перестанет считаться готовой.
+	HeartbeatTimeout time.Duration `env:"HEALTH_HEARTBEAT_TIMEOUT" envDefault:"30s"`
+}
@@ -0,0 +1,12 @@
+package config
+
+import "time"
+
+type Idempotency struct {
+	// TTL — сколько хранится ответ на запрос с Idempotency-Key.
+	TTL             time.Duration `env:"IDEMPOTENCY_TTL" envDefault:"24h"`
+	LockTimeout     time.Duration `env:"IDEMPOTENCY_LOCK_TIMEOUT" envDefault:"1m"`
+	CleanupInterval time.Duration `env:"IDEMPOTENCY_CLEANUP_INTERVAL" envDefault:"10m"`
+	// MaxBodySize — предельный размер тела запроса с Idempotency-Key в байтах: тело читается в память целиком.
+	MaxBodySize int64 `env:"IDEMPOTENCY_MAX_BODY_SIZE" envDefault:"1048576"`
+}
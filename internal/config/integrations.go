@@ -0,0 +1,7 @@
+package config
+
+// Integrations — секреты входящих вебхуков GitHub/GitLab, пустой секрет отключает интеграцию.
+type Integrations struct {
+	GitHubSecret string `env:"INTEGRATIONS_GITHUB_SECRET" json:"-"`
+	GitLabToken  string `env:"INTEGRATIONS_GITLAB_TOKEN" json:"-"`
+}
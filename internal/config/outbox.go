@@ -0,0 +1,10 @@
+package config
+
+import "time"
+
+type Outbox struct {
+	PollInterval time.Duration `env:"OUTBOX_POLL_INTERVAL" envDefault:"1s"`
+	BatchSize    int           `env:"OUTBOX_BATCH_SIZE" envDefault:"20"`
+	Lease        time.Duration `env:"OUTBOX_LEASE" envDefault:"1m"`
+	MaxBackoff   time.Duration `env:"OUTBOX_MAX_BACKOFF" envDefault:"5m"`
+}
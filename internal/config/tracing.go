@@ -0,0 +1,10 @@
+package config
+
+import "time"
+
+type Tracing struct {
+	// OTLPEndpoint — полный адрес OTLP/HTTP приёмника, например http://otel-collector:4318/v1/traces.
+	OTLPEndpoint  string        `env:"TRACING_OTLP_ENDPOINT"`
+	SampleRatio   float64       `env:"TRACING_SAMPLE_RATIO" envDefault:"1"`
+	ExportTimeout time.Duration `env:"TRACING_EXPORT_TIMEOUT" envDefault:"10s"`
+}
@@ -0,0 +1,12 @@
+package config
+
+import "time"
+
+type Webhook struct {
+	PollInterval time.Duration `env:"WEBHOOK_POLL_INTERVAL" envDefault:"1s"`
+	BatchSize    int           `env:"WEBHOOK_BATCH_SIZE" envDefault:"20"`
+	Lease        time.Duration `env:"WEBHOOK_LEASE" envDefault:"1m"`
+	MaxBackoff   time.Duration `env:"WEBHOOK_MAX_BACKOFF" envDefault:"10m"`
+	MaxAttempts  int           `env:"WEBHOOK_MAX_ATTEMPTS" envDefault:"10"`
+	Timeout      time.Duration `env:"WEBHOOK_TIMEOUT" envDefault:"10s"`
+}
@@ -0,0 +1,49 @@
+package entity
+
+import "time"
+
+// Состояния запланированного отсутствия.
+const (
+	AbsenceScheduled = "scheduled"
+	AbsenceActive    = "active"
+	AbsenceFinished  = "finished"
+)
+
+// Absence — запланированное отсутствие пользователя (отпуск, больничный). Планировщик
+// выключает пользователя в StartsAt и включает в EndsAt, но только если выключал сам (Deactivated).
+type Absence struct {
+	Id          int64      `db:"id" json:"id"`
+	UserId      string     `db:"user_id" json:"user_id"`
+	StartsAt    time.Time  `db:"starts_at" json:"starts_at"`
+	EndsAt      time.Time  `db:"ends_at" json:"ends_at"`
+	Reason      string     `db:"reason" json:"reason"`
+	Deactivated bool       `db:"deactivated" json:"deactivated"`
+	CreatedAt   time.Time  `db:"created_at" json:"created_at"`
+	StartedAt   *time.Time `db:"started_at" json:"started_at"`
+	FinishedAt  *time.Time `db:"finished_at" json:"finished_at"`
+}
+
+// Status возвращает состояние отсутствия по отметкам планировщика.
+func (a Absence) Status() string {
+	switch {
+	case a.FinishedAt != nil:
+		return AbsenceFinished
+	case a.StartedAt != nil:
+		return AbsenceActive
+	default:
+		return AbsenceScheduled
+	}
+}
+
+// AbsenceFilter — условия выборки отсутствий. Пустой UserId не фильтрует,
+// завершённые возвращаются только с IncludeFinished.
+type AbsenceFilter struct {
+	UserId          string
+	IncludeFinished bool
+}
+
+// AbsenceTransitions — итог одного прохода планировщика: кого выключили и кого вернули.
+type AbsenceTransitions struct {
+	Deactivated []string
+	Reactivated []string
+}
@@ -0,0 +1,72 @@
+package entity
+
+import (
+	"pull_requests_service/pkg/pagination"
+	"time"
+)
+
+// Типы сущностей в журнале аудита.
+const (
+	AuditEntityTeam        = "team"
+	AuditEntityUser        = "user"
+	AuditEntityPullRequest = "pull_request"
+	AuditEntityWebhook     = "webhook"
+	AuditEntityIdentity    = "identity"
+	AuditEntityAbsence     = "absence"
+)
+
+// Операции, изменяющие состояние и попадающие в журнал аудита.
+const (
+	AuditTeamCreate          = "team.create"
+	AuditTeamUpdateSettings  = "team.update_settings"
+	AuditUserUpsert          = "user.upsert"
+	AuditUserSetIsActive     = "user.set_is_active"
+	AuditUserSetCapacity     = "user.set_max_open_reviews"
+	AuditTeamAddMember       = "team.add_member"
+	AuditTeamRemoveMember    = "team.remove_member"
+	AuditTeamMoveMember      = "team.move_member"
+	AuditTeamArchive         = "team.archive"
+	AuditTeamDelete          = "team.delete"
+	AuditTeamSetCodeOwners   = "team.set_code_owners"
+	AuditPullRequestCreate   = "pull_request.create"
+	AuditPullRequestMerge    = "pull_request.merge"
+	AuditPullRequestReassign = "pull_request.reassign"
+	AuditPullRequestReview   = "pull_request.review"
+	AuditPullRequestReady    = "pull_request.ready"
+	AuditPullRequestClose    = "pull_request.close"
+	AuditPullRequestReopen   = "pull_request.reopen"
+	AuditWebhookCreate       = "webhook.create"
+	AuditWebhookUpdate       = "webhook.update"
+	AuditWebhookDelete       = "webhook.delete"
+	AuditIdentityLink        = "identity.link"
+	AuditIdentityUnlink      = "identity.unlink"
+	AuditAbsenceCreate       = "absence.create"
+	AuditAbsenceCancel       = "absence.cancel"
+)
+
+// AuditActorSystem — автор изменений, сделанных без пользователя (фоновые задачи).
+const AuditActorSystem = "system"
+
+// AuditEvent — запись журнала аудита. Before и After — JSON-снимки сущности
+// до и после изменения, nil если сущности не было.
+type AuditEvent struct {
+	Id         int64     `db:"id"`
+	Actor      string    `db:"actor"`
+	Operation  string    `db:"operation"`
+	EntityType string    `db:"entity_type"`
+	EntityId   string    `db:"entity_id"`
+	Before     []byte    `db:"before"`
+	After      []byte    `db:"after"`
+	TraceId    *string   `db:"trace_id"`
+	CreatedAt  time.Time `db:"created_at"`
+}
+
+// AuditFilter — условия выборки журнала. Пустые поля не фильтруют.
+type AuditFilter struct {
+	EntityType string
+	EntityId   string
+	From       *time.Time
+	To         *time.Time
+	Limit      int
+	After      *pagination.Cursor
+}
@@ -0,0 +1,59 @@
+package entity
+
+// Виды записей массового импорта и экспорта.
+const (
+	BulkTeams        = "teams"
+	BulkUsers        = "users"
+	BulkPullRequests = "pull_requests"
+)
+
+// TeamRecord — строка файла команд. Пустые настройки при импорте получают значения по умолчанию,
+// резервные команды должны существовать или стоять в файле раньше.
+type TeamRecord struct {
+	TeamName          string   `json:"team_name"`
+	ReviewerStrategy  string   `json:"reviewer_strategy"`
+	RequiredReviewers int      `json:"required_reviewers"`
+	MaxOpenReviews    *int     `json:"max_open_reviews"`
+	RequireApprovals  bool     `json:"require_approvals"`
+	FallbackTeams     []string `json:"fallback_teams"`
+	Archived          bool     `json:"archived"`
+}
+
+// UserRecord — строка файла пользователей. Пользователь без is_active импортируется активным,
+// пустой team_name — без команды.
+type UserRecord struct {
+	UserId         string `json:"user_id"`
+	Username       string `json:"username"`
+	TeamName       string `json:"team_name"`
+	IsActive       *bool  `json:"is_active"`
+	Seniority      int    `json:"seniority"`
+	MaxOpenReviews *int   `json:"max_open_reviews"`
+}
+
+// PullRequestRecord — строка файла PR. Импортируются только открытые PR; если ревьюверы
+// не указаны, они подбираются как при создании PR.
+type PullRequestRecord struct {
+	PullRequestId     string   `json:"pull_request_id"`
+	PullRequestName   string   `json:"pull_request_name"`
+	AuthorId          string   `json:"author_id"`
+	Status            string   `json:"status"`
+	AssignedReviewers []string `json:"assigned_reviewers"`
+}
+
+// ImportRowError — строка файла, которую не удалось импортировать.
+type ImportRowError struct {
+	Line    int    `json:"line"`
+	Id      string `json:"id,omitempty"`
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+// ImportResult — итог импорта: Skipped — строки с уже существующими сущностями,
+// повторный импорт того же файла их пропускает.
+type ImportResult struct {
+	Kind     string           `json:"kind"`
+	Total    int              `json:"total"`
+	Imported int              `json:"imported"`
+	Skipped  int              `json:"skipped"`
+	Errors   []ImportRowError `json:"errors"`
+}
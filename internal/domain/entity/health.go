@@ -0,0 +1,28 @@
+package entity
+
+type HealthStatus string
+
+const (
+	HealthUp   HealthStatus = "up"
+	HealthDown HealthStatus = "down"
+)
+
+// Имена проверок готовности.
+const (
+	HealthCheckPostgres   = "postgres"
+	HealthCheckMigrations = "migrations"
+	HealthCheckWorker     = "event_worker"
+	HealthCheckShutdown   = "shutdown"
+)
+
+type HealthCheck struct {
+	Name    string
+	Status  HealthStatus
+	Message string
+}
+
+// HealthReport — итог проверки: Status равен HealthUp, только если прошли все проверки.
+type HealthReport struct {
+	Status HealthStatus
+	Checks []HealthCheck
+}
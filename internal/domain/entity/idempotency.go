@@ -0,0 +1,21 @@
+package entity
+
+import "time"
+
+// IdempotencyRecord — запрос с Idempotency-Key и сохранённый ответ на него.
+// Пока StatusCode пуст, запрос с этим ключом ещё выполняется.
+type IdempotencyRecord struct {
+	Scope        string    `db:"scope"`
+	Key          string    `db:"key"`
+	Fingerprint  string    `db:"fingerprint"`
+	StatusCode   *int      `db:"status_code"`
+	ContentType  *string   `db:"content_type"`
+	ResponseBody []byte    `db:"response_body"`
+	CreatedAt    time.Time `db:"created_at"`
+	ExpiresAt    time.Time `db:"expires_at"`
+}
+
+// Completed сообщает, сохранён ли уже ответ на запрос.
+func (r IdempotencyRecord) Completed() bool {
+	return r.StatusCode != nil
+}
@@ -0,0 +1,42 @@
+package entity
+
+import (
+	"fmt"
+	"time"
+)
+
+// Поддерживаемые системы контроля версий.
+const (
+	ProviderGitHub = "github"
+	ProviderGitLab = "gitlab"
+)
+
+// ExternalIdentity связывает логин в VCS с нашим пользователем.
+type ExternalIdentity struct {
+	Provider  string    `db:"provider" json:"provider"`
+	Login     string    `db:"login" json:"login"`
+	UserId    string    `db:"user_id" json:"user_id"`
+	CreatedAt time.Time `db:"created_at" json:"created_at"`
+}
+
+// Действия с PR во входящих вебхуках, которые мы обрабатываем.
+const (
+	VCSActionOpened = "opened"
+	VCSActionMerged = "merged"
+)
+
+// VCSPullRequestEvent — событие PR из GitHub или GitLab, приведённое к общему виду.
+type VCSPullRequestEvent struct {
+	Provider    string
+	DeliveryId  string
+	Action      string
+	Repository  string
+	Number      int
+	Title       string
+	AuthorLogin string
+}
+
+// PullRequestId — идентификатор PR в сервисе, стабильный между событиями одного PR.
+func (e VCSPullRequestEvent) PullRequestId() string {
+	return fmt.Sprintf("%s:%s#%d", e.Provider, e.Repository, e.Number)
+}
@@ -0,0 +1,38 @@
+package entity
+
+import "time"
+
+// Типы событий в outbox.
+const (
+	OutboxUserActivityChanged = "user.activity_changed"
+	OutboxUserTeamChanged     = "user.team_changed"
+	OutboxReviewerReleased    = "reviewer.released"
+)
+
+// OutboxEvent — событие, записанное в транзакции изменения и обрабатываемое воркером как минимум один раз.
+type OutboxEvent struct {
+	Id        int64     `db:"id"`
+	EventType string    `db:"event_type"`
+	Payload   []byte    `db:"payload"`
+	Attempts  int       `db:"attempts"`
+	CreatedAt time.Time `db:"created_at"`
+}
+
+// UserActivityChanged — payload события OutboxUserActivityChanged.
+type UserActivityChanged struct {
+	UserId   string `json:"user_id"`
+	IsActive bool   `json:"is_active"`
+}
+
+// ReviewerReleased — payload события OutboxReviewerReleased: ревьювер снят с закрытого PR
+// и может быть добран на PR, где ревьюверов не хватает.
+type ReviewerReleased struct {
+	UserId        string `json:"user_id"`
+	PullRequestId string `json:"pull_request_id"`
+}
+
+// UserTeamChanged — payload события OutboxUserTeamChanged; пустой Team означает, что пользователь выведен из команды.
+type UserTeamChanged struct {
+	UserId string `json:"user_id"`
+	Team   string `json:"team_name"`
+}
@@ -1,19 +1,59 @@
 package entity
 
 import (
+	"pull_requests_service/pkg/pagination"
 	"time"
 )
 
 const StatusOpen = "OPEN"
 const StatusMerged = "MERGED"
 
+// StatusClosed — PR закрыт без слияния: вручную или при архивации и удалении команды автора.
+const StatusClosed = "CLOSED"
+
+// StatusDraft — черновик: ревьюверы назначаются, только когда PR готов к ревью.
+const StatusDraft = "DRAFT"
+
+// PullRequest — PR и его текущие ревьюверы. Reviews — состояния ревью текущих ревьюверов,
+// заполняются там, где они нужны. ChangedPaths — файлы, которые меняет PR:
+// нужны только при назначении ревьюверов по владельцам путей и не сохраняются.
 type PullRequest struct {
-	Id                string     `db:"id"`
-	Name              string     `db:"name"`
-	AuthorId          string     `db:"author_id"`
-	Status            string     `db:"status"`
-	NeedMoreReviewers bool       `db:"need_more_reviewers"`
-	CreatedAt         time.Time  `db:"created_at"`
-	MergedAt          *time.Time `db:"merged_at"`
-	AssignedReviewers []string
+	Id                string     `db:"id" json:"pull_request_id"`
+	Name              string     `db:"name" json:"pull_request_name"`
+	AuthorId          string     `db:"author_id" json:"author_id"`
+	Status            string     `db:"status" json:"status"`
+	NeedMoreReviewers bool       `db:"need_more_reviewers" json:"need_more_reviewers"`
+	CreatedAt         time.Time  `db:"created_at" json:"created_at"`
+	MergedAt          *time.Time `db:"merged_at" json:"merged_at"`
+	ClosedAt          *time.Time `db:"closed_at" json:"closed_at"`
+	AssignedReviewers []string   `json:"assigned_reviewers"`
+	Reviews           []Review   `db:"-" json:"reviews,omitempty"`
+	ChangedPaths      []string   `db:"-" json:"-"`
+}
+
+// PullRequestFilter — условия выборки списка PR. Пустые поля не фильтруют.
+type PullRequestFilter struct {
+	Status            string
+	AuthorId          string
+	TeamName          string
+	ReviewerId        string
+	NeedMoreReviewers *bool
+	CreatedFrom       *time.Time
+	CreatedTo         *time.Time
+	Limit             int
+	After             *pagination.Cursor
+}
+
+// ReviewAssignment — PR, на который назначен ревьювер, и момент назначения.
+type ReviewAssignment struct {
+	PullRequest
+	AssignedAt time.Time `db:"assigned_at"`
+}
+
+// UserReviewsFilter — условия выборки PR, где пользователь ревьювер.
+type UserReviewsFilter struct {
+	UserId string
+	Status string
+	Limit  int
+	After  *pagination.Cursor
 }
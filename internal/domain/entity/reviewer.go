@@ -0,0 +1,114 @@
+package entity
+
+import "time"
+
+// ReviewerCandidate — активный участник команды, которого можно назначить ревьювером,
+// вместе с данными, нужными стратегиям выбора.
+type ReviewerCandidate struct {
+	UserId         string     `db:"id"`
+	Seniority      int        `db:"seniority"`
+	OpenReviews    int        `db:"open_reviews"`
+	LastAssignedAt *time.Time `db:"last_assigned_at"`
+}
+
+// ReviewerPicker выбирает до n ревьюверов из кандидатов по стратегии команды.
+// Репозиторий вызывает его внутри своей транзакции, сама логика выбора живёт в домене.
+type ReviewerPicker func(team Team, candidates []ReviewerCandidate, n int) []string
+
+// CandidatePool — кандидаты одной команды. Первый пул — команда автора,
+// следующие — её резервные команды в порядке приоритета.
+type CandidatePool struct {
+	Team       Team
+	Candidates []ReviewerCandidate
+}
+
+// PickedReviewer — выбранный ревьювер и команда, из пула которой он взят.
+type PickedReviewer struct {
+	ReviewerId string
+	SourceTeam string
+}
+
+// PickFromPools выбирает до n ревьюверов, обходя пулы по порядку: следующий пул
+// используется, только если в предыдущих не хватило кандидатов. Внутри пула
+// действует стратегия его команды.
+func PickFromPools(pools []CandidatePool, n int, pick ReviewerPicker) []PickedReviewer {
+	picked := make([]PickedReviewer, 0, n)
+	for _, pool := range pools {
+		if len(picked) >= n {
+			break
+		}
+		for _, id := range pick(pool.Team, pool.Candidates, n-len(picked)) {
+			picked = append(picked, PickedReviewer{ReviewerId: id, SourceTeam: pool.Team.Name})
+		}
+	}
+
+	return picked
+}
+
+// ReviewerIds возвращает идентификаторы выбранных ревьюверов.
+func ReviewerIds(picked []PickedReviewer) []string {
+	ids := make([]string, len(picked))
+	for i, p := range picked {
+		ids[i] = p.ReviewerId
+	}
+
+	return ids
+}
+
+// Причины снятия ревьювера с PR, сохраняются в истории назначений.
+const (
+	ReplaceReasonManual       = "manual_reassign"
+	ReplaceReasonDeactivation = "deactivation"
+	ReplaceReasonTeamMove     = "team_move"
+	ReplaceReasonPRClosed     = "pr_closed"
+)
+
+// ReviewerAssignment — запись истории назначений ревьювера на PR.
+// У текущего ревьювера ReplacedAt и ReplaceReason пустые.
+type ReviewerAssignment struct {
+	ReviewerId    string     `db:"reviewer_id"`
+	AssignedAt    time.Time  `db:"assigned_at"`
+	ReplacedAt    *time.Time `db:"replaced_at"`
+	ReplaceReason *string    `db:"replace_reason"`
+	SourceTeam    *string    `db:"source_team"`
+	IsCurrent     bool       `db:"is_current"`
+	ReviewState   string     `db:"review_state"`
+}
+
+// Состояния ревью. Назначенный ревьювер начинает с ReviewPending,
+// остальные состояния он выставляет сам, последнее действует.
+const (
+	ReviewPending          = "pending"
+	ReviewApproved         = "approved"
+	ReviewChangesRequested = "changes_requested"
+	ReviewCommented        = "commented"
+)
+
+// IsSubmittedReviewState сообщает, может ли ревьювер выставить такое состояние.
+func IsSubmittedReviewState(state string) bool {
+	switch state {
+	case ReviewApproved, ReviewChangesRequested, ReviewCommented:
+		return true
+	}
+	return false
+}
+
+// Review — ревью текущего ревьювера PR. ReviewedAt пуст, пока ревью не отправлено.
+type Review struct {
+	ReviewerId string     `db:"reviewer_id" json:"reviewer_id"`
+	State      string     `db:"review_state" json:"state"`
+	Comment    string     `db:"review_comment" json:"comment"`
+	ReviewedAt *time.Time `db:"reviewed_at" json:"reviewed_at"`
+}
+
+// CountApprovals возвращает число одобривших ревьюверов.
+func CountApprovals(reviews []Review) int {
+	approvals := 0
+	for _, review := range reviews {
+		if review.State == ReviewApproved {
+			approvals++
+		}
+	}
+
+	return approvals
+}
@@ -2,7 +2,73 @@ package entity
 
 import "time"
 
+// Стратегии выбора ревьюверов, задаются на уровне команды.
+const (
+	StrategyRandom            = "random"
+	StrategyLeastLoaded       = "least_loaded"
+	StrategyRoundRobin        = "round_robin"
+	StrategySeniorityWeighted = "seniority_weighted"
+)
+
+const DefaultRequiredReviewers = 2
+
+// Team — команда и её настройки назначения. FallbackTeams — резервные команды в порядке
+// приоритета, из которых добираются ревьюверы, когда своих кандидатов не хватает.
+// MaxOpenReviews — лимит открытых ревью на участника по умолчанию, nil — без лимита.
+// RequireApprovals — PR участников нельзя слить без RequiredReviewers одобрений.
 type Team struct {
-	Name      string    `db:"name"`
-	CreatedAt time.Time `db:"created_at"`
+	Name              string     `db:"name" json:"team_name"`
+	ReviewerStrategy  string     `db:"reviewer_strategy" json:"reviewer_strategy"`
+	RequiredReviewers int        `db:"required_reviewers" json:"required_reviewers"`
+	CreatedAt         time.Time  `db:"created_at" json:"created_at"`
+	ArchivedAt        *time.Time `db:"archived_at" json:"archived_at"`
+	MaxOpenReviews    *int       `db:"max_open_reviews" json:"max_open_reviews"`
+	RequireApprovals  bool       `db:"require_approvals" json:"require_approvals"`
+	FallbackTeams     []string   `db:"-" json:"fallback_teams"`
+}
+
+// TeamSettings — изменяемые настройки назначения ревьюверов, nil означает «не менять».
+// Нулевой MaxOpenReviews снимает лимит.
+type TeamSettings struct {
+	ReviewerStrategy  *string
+	RequiredReviewers *int
+	FallbackTeams     *[]string
+	MaxOpenReviews    *int
+	RequireApprovals  *bool
+}
+
+// MembershipChange — итог изменения состава команды: пользователь после изменения
+// и открытые PR прежней команды, с которых он снят.
+type MembershipChange struct {
+	User                   User
+	ReassignedPullRequests []string
+}
+
+// Что делать с открытыми PR участников команды при её архивации или удалении.
+const (
+	RetirePolicyClose    = "close"
+	RetirePolicyReassign = "reassign"
+)
+
+// TeamRetirePolicy — политика для открытых PR: закрыть их или передать ревью в TargetTeam.
+type TeamRetirePolicy struct {
+	PullRequests string
+	TargetTeam   string
+}
+
+// TeamRetirement — итог архивации или удаления команды: затронутые участники,
+// закрытые PR и PR, на которых заменены ревьюверы.
+type TeamRetirement struct {
+	Team                   string
+	Members                []string
+	ClosedPullRequests     []string
+	ReassignedPullRequests []string
+}
+
+// CodeOwners — файл владельцев путей команды в формате CODEOWNERS.
+// Владельцы указываются user_id, ведущий '@' допускается.
+type CodeOwners struct {
+	Team      string    `db:"team_name" json:"team_name"`
+	Content   string    `db:"content" json:"content"`
+	UpdatedAt time.Time `db:"updated_at" json:"updated_at"`
 }
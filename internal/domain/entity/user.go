@@ -2,10 +2,33 @@ package entity
 
 import "time"
 
+// User — пользователь. MaxOpenReviews — личный лимит открытых ревью,
+// nil — действует лимит команды.
 type User struct {
-	Id        string    `db:"id"`
-	Name      string    `db:"name"`
-	IsActive  bool      `db:"is_active"`
-	Team      string    `db:"team_id"`
-	CreatedAt time.Time `db:"created_at"`
+	Id             string    `db:"id" json:"user_id"`
+	Name           string    `db:"name" json:"username"`
+	IsActive       bool      `db:"is_active" json:"is_active"`
+	Team           string    `db:"team_id" json:"team_name"`
+	Seniority      int       `db:"seniority" json:"seniority"`
+	MaxOpenReviews *int      `db:"max_open_reviews" json:"max_open_reviews"`
+	CreatedAt      time.Time `db:"created_at" json:"created_at"`
+}
+
+// UserWorkload — текущие открытые ревью пользователя и его лимит
+// (личный или командный), nil в MaxOpenReviews — без лимита.
+type UserWorkload struct {
+	UserId         string `db:"user_id"`
+	Username       string `db:"username"`
+	Team           string `db:"team_name"`
+	IsActive       bool   `db:"is_active"`
+	OpenReviews    int    `db:"open_reviews"`
+	MaxOpenReviews *int   `db:"max_open_reviews"`
+}
+
+// Available возвращает, сколько ещё ревью можно назначить; ok = false, если лимита нет.
+func (w UserWorkload) Available() (available int, ok bool) {
+	if w.MaxOpenReviews == nil {
+		return 0, false
+	}
+	return max(*w.MaxOpenReviews-w.OpenReviews, 0), true
 }
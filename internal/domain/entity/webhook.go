@@ -0,0 +1,113 @@
+package entity
+
+import (
+	"pull_requests_service/pkg/pagination"
+	"time"
+)
+
+// События, на которые можно подписаться вебхуком.
+const (
+	EventPullRequestCreated = "pr.created"
+	EventPullRequestMerged  = "pr.merged"
+	EventPullRequestClosed  = "pr.closed"
+	EventPullRequestReady   = "pr.ready"
+	EventPullRequestReopen  = "pr.reopened"
+	EventReviewerAssigned   = "reviewer.assigned"
+	EventReviewerReplaced   = "reviewer.replaced"
+	EventReviewSubmitted    = "review.submitted"
+)
+
+// IsWebhookEvent сообщает, известно ли событие.
+func IsWebhookEvent(event string) bool {
+	switch event {
+	case EventPullRequestCreated, EventPullRequestMerged, EventPullRequestClosed, EventPullRequestReady, EventPullRequestReopen,
+		EventReviewerAssigned, EventReviewerReplaced, EventReviewSubmitted:
+		return true
+	}
+	return false
+}
+
+// Статусы доставки вебхука.
+const (
+	DeliveryPending   = "pending"
+	DeliveryDelivered = "delivered"
+	DeliveryFailed    = "failed"
+)
+
+// WebhookSubscription — адрес, на который отправляются события из Events.
+// Secret используется для подписи тела и наружу после создания не отдаётся.
+type WebhookSubscription struct {
+	Id        int64     `db:"id" json:"id"`
+	Url       string    `db:"url" json:"url"`
+	Secret    string    `db:"secret" json:"-"`
+	Events    []string  `db:"-" json:"events"`
+	IsActive  bool      `db:"is_active" json:"is_active"`
+	CreatedAt time.Time `db:"created_at" json:"created_at"`
+}
+
+// WebhookSettings — изменяемые поля подписки, nil означает «не менять».
+type WebhookSettings struct {
+	Url      *string
+	Events   []string
+	IsActive *bool
+}
+
+// WebhookEnvelope — тело запроса, которое получает подписчик.
+type WebhookEnvelope struct {
+	Id         string    `json:"id"`
+	Type       string    `json:"type"`
+	OccurredAt time.Time `json:"occurred_at"`
+	Data       any       `json:"data"`
+}
+
+// ReviewerAssigned — данные события reviewer.assigned.
+type ReviewerAssigned struct {
+	PullRequestId string `json:"pull_request_id"`
+	ReviewerId    string `json:"reviewer_id"`
+}
+
+// ReviewerReplaced — данные события reviewer.replaced. NewReviewerId пуст, если замену найти не удалось.
+type ReviewerReplaced struct {
+	PullRequestId string `json:"pull_request_id"`
+	OldReviewerId string `json:"old_reviewer_id"`
+	NewReviewerId string `json:"new_reviewer_id,omitempty"`
+	Reason        string `json:"reason"`
+}
+
+// ReviewSubmitted — данные события review.submitted.
+type ReviewSubmitted struct {
+	PullRequestId string `json:"pull_request_id"`
+	ReviewerId    string `json:"reviewer_id"`
+	State         string `json:"state"`
+	Comment       string `json:"comment,omitempty"`
+}
+
+// WebhookDelivery — попытки доставки одного события одному подписчику.
+type WebhookDelivery struct {
+	Id             int64      `db:"id"`
+	SubscriptionId int64      `db:"subscription_id"`
+	EventId        string     `db:"event_id"`
+	EventType      string     `db:"event_type"`
+	Payload        []byte     `db:"payload"`
+	Status         string     `db:"status"`
+	Attempts       int        `db:"attempts"`
+	LastStatusCode *int       `db:"last_status_code"`
+	LastError      *string    `db:"last_error"`
+	CreatedAt      time.Time  `db:"created_at"`
+	DeliveredAt    *time.Time `db:"delivered_at"`
+}
+
+// WebhookDeliveryJob — захваченная воркером доставка вместе с адресом и секретом подписки.
+type WebhookDeliveryJob struct {
+	WebhookDelivery
+	Url    string `db:"url"`
+	Secret string `db:"secret"`
+}
+
+// WebhookDeliveryFilter — условия выборки журнала доставок.
+type WebhookDeliveryFilter struct {
+	SubscriptionId int64
+	Status         string
+	Limit          int
+	After          *pagination.Cursor
+}
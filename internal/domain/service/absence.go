@@ -0,0 +1,102 @@
+package service
+
+import (
+	"context"
+	"pull_requests_service/internal/domain"
+	"pull_requests_service/internal/domain/entity"
+	"pull_requests_service/pkg/errcodes"
+	"pull_requests_service/pkg/logx"
+	"pull_requests_service/pkg/tracing"
+	"time"
+)
+
+type AbsenceRepository interface {
+	Create(ctx context.Context, absence entity.Absence) (entity.Absence, error)
+	List(ctx context.Context, filter entity.AbsenceFilter) ([]entity.Absence, error)
+	Cancel(ctx context.Context, id int64) (entity.Absence, error)
+	ApplyDue(ctx context.Context) (entity.AbsenceTransitions, error)
+}
+
+// AbsenceOptions — параметры планировщика отсутствий.
+type AbsenceOptions struct {
+	PollInterval time.Duration
+}
+
+type AbsenceService struct {
+	repo AbsenceRepository
+	opts AbsenceOptions
+}
+
+func NewAbsenceService(repo AbsenceRepository, opts AbsenceOptions) *AbsenceService {
+	return &AbsenceService{
+		repo: repo,
+		opts: opts,
+	}
+}
+
+// ScheduleAbsence планирует отсутствие пользователя. Пересекающиеся отсутствия допустимы.
+func (s *AbsenceService) ScheduleAbsence(ctx context.Context, absence entity.Absence) (entity.Absence, error) {
+	ctx, span := tracing.Tracer().Start(ctx, "AbsenceService.ScheduleAbsence")
+	defer span.End()
+
+	if !absence.EndsAt.After(absence.StartsAt) {
+		return entity.Absence{}, domain.NewError(errcodes.InvalidArgument, "ends_at must be after starts_at")
+	}
+	if !absence.EndsAt.After(time.Now()) {
+		return entity.Absence{}, domain.NewError(errcodes.InvalidArgument, "ends_at must be in the future")
+	}
+
+	// колонки без часового пояса хранят UTC, как и NOW() в БД
+	absence.StartsAt = absence.StartsAt.UTC()
+	absence.EndsAt = absence.EndsAt.UTC()
+
+	return s.repo.Create(ctx, absence)
+}
+
+func (s *AbsenceService) ListAbsences(ctx context.Context, filter entity.AbsenceFilter) ([]entity.Absence, error) {
+	ctx, span := tracing.Tracer().Start(ctx, "AbsenceService.ListAbsences")
+	defer span.End()
+
+	return s.repo.List(ctx, filter)
+}
+
+// CancelAbsence отменяет запланированное отсутствие или досрочно завершает текущее.
+func (s *AbsenceService) CancelAbsence(ctx context.Context, id int64) (entity.Absence, error) {
+	ctx, span := tracing.Tracer().Start(ctx, "AbsenceService.CancelAbsence")
+	defer span.End()
+
+	return s.repo.Cancel(ctx, id)
+}
+
+// StartScheduler выключает и включает пользователей по наступившим отсутствиям.
+// Ревью перераспределяет воркер событий, как при ручном /users/setIsActive.
+func (s *AbsenceService) StartScheduler(ctx context.Context) {
+	logger(ctx).Info("Starting absence scheduler...")
+
+	ticker := time.NewTicker(s.opts.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		s.applyDue(ctx)
+
+		select {
+		case <-ctx.Done():
+			logger(ctx).Info("Stopping absence scheduler...")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *AbsenceService) applyDue(ctx context.Context) {
+	transitions, err := s.repo.ApplyDue(ctx)
+	if err != nil {
+		logger(ctx).Error("Failed to apply due absences", logx.Error(err))
+		return
+	}
+
+	if len(transitions.Deactivated) > 0 || len(transitions.Reactivated) > 0 {
+		logger(ctx).Info("Applied due absences",
+			"deactivated", transitions.Deactivated, "reactivated", transitions.Reactivated)
+	}
+}
@@ -0,0 +1,56 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"pull_requests_service/internal/domain"
+	"pull_requests_service/internal/domain/entity"
+	"pull_requests_service/pkg/errcodes"
+)
+
+type fakeAbsenceRepo struct {
+	AbsenceRepository
+	created []entity.Absence
+}
+
+func (r *fakeAbsenceRepo) Create(_ context.Context, absence entity.Absence) (entity.Absence, error) {
+	r.created = append(r.created, absence)
+	return absence, nil
+}
+
+func TestScheduleAbsence(t *testing.T) {
+	rq := require.New(t)
+
+	repo := &fakeAbsenceRepo{}
+	svc := NewAbsenceService(repo, AbsenceOptions{})
+
+	now := time.Now()
+	invalid := []entity.Absence{
+		{UserId: "u1", StartsAt: now.Add(48 * time.Hour), EndsAt: now.Add(24 * time.Hour)},
+		{UserId: "u1", StartsAt: now.Add(24 * time.Hour), EndsAt: now.Add(24 * time.Hour)},
+		{UserId: "u1", StartsAt: now.Add(-48 * time.Hour), EndsAt: now.Add(-24 * time.Hour)},
+	}
+	for _, absence := range invalid {
+		_, err := svc.ScheduleAbsence(context.Background(), absence)
+		var appErr *domain.AppError
+		rq.ErrorAs(err, &appErr)
+		rq.Equal(errcodes.InvalidArgument, appErr.Code)
+	}
+	rq.Empty(repo.created)
+
+	moscow := time.FixedZone("MSK", 3*60*60)
+	startsAt := time.Date(2030, 7, 1, 9, 0, 0, 0, moscow)
+	created, err := svc.ScheduleAbsence(context.Background(), entity.Absence{
+		UserId:   "u1",
+		StartsAt: startsAt,
+		EndsAt:   startsAt.Add(14 * 24 * time.Hour),
+	})
+	rq.NoError(err)
+	rq.Equal(time.UTC, created.StartsAt.Location())
+	rq.True(created.StartsAt.Equal(startsAt))
+	rq.Equal(entity.AbsenceScheduled, created.Status())
+}
@@ -0,0 +1,49 @@
+package service
+
+import (
+	"context"
+	"pull_requests_service/internal/domain/entity"
+	"pull_requests_service/pkg/pagination"
+	"pull_requests_service/pkg/tracing"
+	"strconv"
+)
+
+type AuditRepository interface {
+	List(ctx context.Context, filter entity.AuditFilter) ([]entity.AuditEvent, error)
+}
+
+type AuditService struct {
+	auditRepo AuditRepository
+}
+
+func NewAuditService(auditRepo AuditRepository) *AuditService {
+	return &AuditService{auditRepo: auditRepo}
+}
+
+// ListEvents возвращает страницу журнала аудита и курсор следующей страницы.
+func (s *AuditService) ListEvents(ctx context.Context, filter entity.AuditFilter, cursor string) (
+	[]entity.AuditEvent, string, error) {
+	ctx, span := tracing.Tracer().Start(ctx, "AuditService.ListEvents")
+	defer span.End()
+
+	pageSize, after, err := parsePage(filter.Limit, cursor)
+	if err != nil {
+		return nil, "", err
+	}
+	filter.Limit = pageSize + 1
+	filter.After = after
+
+	events, err := s.auditRepo.List(ctx, filter)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if len(events) <= pageSize {
+		return events, "", nil
+	}
+
+	events = events[:pageSize]
+	last := events[len(events)-1]
+
+	return events, pagination.Cursor{At: last.CreatedAt, ID: strconv.FormatInt(last.Id, 10)}.Encode(), nil
+}
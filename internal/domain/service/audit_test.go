@@ -0,0 +1,85 @@
+package service
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"pull_requests_service/internal/domain/entity"
+	"pull_requests_service/pkg/pagination"
+)
+
+type pagedAuditRepo struct {
+	events  []entity.AuditEvent
+	filters []entity.AuditFilter
+}
+
+func (r *pagedAuditRepo) List(_ context.Context, filter entity.AuditFilter) ([]entity.AuditEvent, error) {
+	r.filters = append(r.filters, filter)
+
+	var page []entity.AuditEvent
+	for _, event := range r.events {
+		if filter.EntityType != "" && event.EntityType != filter.EntityType {
+			continue
+		}
+		if filter.After != nil {
+			afterId, _ := strconv.ParseInt(filter.After.ID, 10, 64)
+			if !event.CreatedAt.Before(filter.After.At) && !(event.CreatedAt.Equal(filter.After.At) && event.Id < afterId) {
+				continue
+			}
+		}
+		if len(page) == filter.Limit {
+			break
+		}
+		page = append(page, event)
+	}
+	return page, nil
+}
+
+func TestListEventsPaginates(t *testing.T) {
+	rq := require.New(t)
+
+	start := time.Date(2025, 10, 1, 12, 0, 0, 0, time.UTC)
+	repo := &pagedAuditRepo{}
+	for id := int64(7); id >= 1; id-- {
+		entityType := entity.AuditEntityTeam
+		if id%2 == 0 {
+			entityType = entity.AuditEntityPullRequest
+		}
+		repo.events = append(repo.events, entity.AuditEvent{Id: id, EntityType: entityType,
+			CreatedAt: start.Add(time.Duration(id/2) * time.Second)})
+	}
+	svc := NewAuditService(repo)
+
+	var ids []int64
+	cursor := ""
+	for pages := 0; ; pages++ {
+		rq.Less(pages, 4)
+		events, next, err := svc.ListEvents(context.Background(), entity.AuditFilter{Limit: 2}, cursor)
+		rq.NoError(err)
+		for _, event := range events {
+			ids = append(ids, event.Id)
+		}
+		if next == "" {
+			break
+		}
+
+		decoded, err := pagination.Decode(next)
+		rq.NoError(err)
+		rq.Equal(strconv.FormatInt(events[len(events)-1].Id, 10), decoded.ID)
+		cursor = next
+	}
+	rq.Equal([]int64{7, 6, 5, 4, 3, 2, 1}, ids)
+
+	events, next, err := svc.ListEvents(context.Background(),
+		entity.AuditFilter{EntityType: entity.AuditEntityPullRequest}, "")
+	rq.NoError(err)
+	rq.Empty(next)
+	rq.Len(events, 3)
+	last := repo.filters[len(repo.filters)-1]
+	rq.Equal(entity.AuditEntityPullRequest, last.EntityType)
+	rq.Equal(defaultPageSize+1, last.Limit)
+}
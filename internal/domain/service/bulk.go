@@ -0,0 +1,296 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"pull_requests_service/internal/domain"
+	"pull_requests_service/internal/domain/entity"
+	"pull_requests_service/pkg/bulkfile"
+	"pull_requests_service/pkg/errcodes"
+	"pull_requests_service/pkg/tracing"
+	"slices"
+)
+
+type BulkRepository interface {
+	ImportTeams(ctx context.Context, teams []entity.TeamRecord) ([]error, error)
+	ImportUsers(ctx context.Context, users []entity.UserRecord) ([]error, error)
+	ImportPullRequests(ctx context.Context, prs []entity.PullRequestRecord) ([]error, error)
+	ExportTeams(ctx context.Context) ([]entity.TeamRecord, error)
+	ExportUsers(ctx context.Context) ([]entity.UserRecord, error)
+	ExportPullRequests(ctx context.Context) ([]entity.PullRequestRecord, error)
+}
+
+type BulkOptions struct {
+	// BatchSize — сколько строк применяется в одной транзакции.
+	BatchSize int
+}
+
+type BulkService struct {
+	repo        BulkRepository
+	teamService *TeamService
+	prService   *PullRequestService
+	opts        BulkOptions
+}
+
+func NewBulkService(repo BulkRepository, teamService *TeamService, prService *PullRequestService,
+	opts BulkOptions) *BulkService {
+	if opts.BatchSize < 1 {
+		opts.BatchSize = 1
+	}
+	return &BulkService{
+		repo:        repo,
+		teamService: teamService,
+		prService:   prService,
+		opts:        opts,
+	}
+}
+
+// Import читает файл записей вида kind и применяет их пачками. Строки с ошибками разбора,
+// проверки или применения попадают в результат и не мешают остальным; уже существующие
+// сущности пропускаются. Ошибка возвращается, только если импорт пришлось прервать.
+func (s *BulkService) Import(ctx context.Context, kind, format string, r io.Reader) (entity.ImportResult, error) {
+	ctx, span := tracing.Tracer().Start(ctx, "BulkService.Import")
+	defer span.End()
+
+	if err := checkFormat(format); err != nil {
+		return entity.ImportResult{}, err
+	}
+
+	result := entity.ImportResult{Kind: kind, Errors: []entity.ImportRowError{}}
+	var err error
+	switch kind {
+	case entity.BulkTeams:
+		err = importRecords(ctx, r, format, s.opts.BatchSize, &result,
+			func(team entity.TeamRecord) string { return team.TeamName },
+			s.prepareTeam, s.repo.ImportTeams)
+	case entity.BulkUsers:
+		err = importRecords(ctx, r, format, s.opts.BatchSize, &result,
+			func(user entity.UserRecord) string { return user.UserId },
+			prepareUser, s.repo.ImportUsers)
+	case entity.BulkPullRequests:
+		err = importRecords(ctx, r, format, s.opts.BatchSize, &result,
+			func(pr entity.PullRequestRecord) string { return pr.PullRequestId },
+			s.preparePullRequest, s.repo.ImportPullRequests)
+	default:
+		return entity.ImportResult{}, unknownKind(kind)
+	}
+	if err != nil {
+		return entity.ImportResult{}, err
+	}
+	// ошибки применения пачки приходят позже ошибок проверки строк после неё
+	slices.SortStableFunc(result.Errors, func(a, b entity.ImportRowError) int { return a.Line - b.Line })
+
+	logger(ctx).Info("Bulk import finished", "kind", kind, "total", result.Total,
+		"imported", result.Imported, "skipped", result.Skipped, "failed", len(result.Errors))
+
+	return result, nil
+}
+
+// Export выгружает все сущности вида kind, включая архивные команды и закрытые PR.
+func (s *BulkService) Export(ctx context.Context, kind, format string, w io.Writer) error {
+	ctx, span := tracing.Tracer().Start(ctx, "BulkService.Export")
+	defer span.End()
+
+	if err := checkFormat(format); err != nil {
+		return err
+	}
+
+	switch kind {
+	case entity.BulkTeams:
+		return exportRecords(ctx, w, format, s.repo.ExportTeams)
+	case entity.BulkUsers:
+		return exportRecords(ctx, w, format, s.repo.ExportUsers)
+	case entity.BulkPullRequests:
+		return exportRecords(ctx, w, format, s.repo.ExportPullRequests)
+	default:
+		return unknownKind(kind)
+	}
+}
+
+func (s *BulkService) prepareTeam(_ context.Context, team *entity.TeamRecord) error {
+	if team.TeamName == "" {
+		return domain.NewError(errcodes.InvalidArgument, "team_name is required")
+	}
+	if team.ReviewerStrategy == "" {
+		team.ReviewerStrategy = entity.StrategyRandom
+	}
+	if team.RequiredReviewers == 0 {
+		team.RequiredReviewers = entity.DefaultRequiredReviewers
+	}
+
+	return s.teamService.validateSettings(entity.Team{
+		Name:              team.TeamName,
+		ReviewerStrategy:  team.ReviewerStrategy,
+		RequiredReviewers: team.RequiredReviewers,
+		MaxOpenReviews:    team.MaxOpenReviews,
+		FallbackTeams:     team.FallbackTeams,
+	})
+}
+
+func prepareUser(_ context.Context, user *entity.UserRecord) error {
+	if user.UserId == "" || user.Username == "" {
+		return domain.NewError(errcodes.InvalidArgument, "user_id and username are required")
+	}
+	if user.IsActive == nil {
+		active := true
+		user.IsActive = &active
+	}
+	if user.Seniority == 0 {
+		user.Seniority = 1
+	}
+	if user.Seniority < 1 {
+		return domain.NewError(errcodes.InvalidArgument, "seniority must be at least 1")
+	}
+	if user.MaxOpenReviews != nil && *user.MaxOpenReviews < 1 {
+		return domain.NewError(errcodes.InvalidArgument, "max_open_reviews must be at least 1")
+	}
+
+	return nil
+}
+
+// preparePullRequest пропускает только открытые PR; ревьюверы без явного списка
+// подбираются так же, как при создании PR.
+func (s *BulkService) preparePullRequest(ctx context.Context, pr *entity.PullRequestRecord) error {
+	if pr.PullRequestId == "" || pr.PullRequestName == "" || pr.AuthorId == "" {
+		return domain.NewError(errcodes.InvalidArgument, "pull_request_id, pull_request_name and author_id are required")
+	}
+	if pr.Status != "" && pr.Status != entity.StatusOpen {
+		return domain.NewError(errcodes.InvalidArgument,
+			fmt.Sprintf("only %s pull requests can be imported, got %s", entity.StatusOpen, pr.Status))
+	}
+	pr.Status = entity.StatusOpen
+
+	if len(pr.AssignedReviewers) > 0 {
+		return nil
+	}
+	reviewers, _, err := s.prService.pickAuthorReviewers(ctx, pr.AuthorId, nil)
+	if err != nil {
+		return err
+	}
+	pr.AssignedReviewers = entity.ReviewerIds(reviewers)
+
+	return nil
+}
+
+type bulkRow[T any] struct {
+	line   int
+	record T
+}
+
+// importRecords читает записи, проверяет их через prepare и отдаёт в apply пачками по batchSize.
+func importRecords[T any](ctx context.Context, r io.Reader, format string, batchSize int, result *entity.ImportResult,
+	idOf func(T) string,
+	prepare func(ctx context.Context, record *T) error,
+	apply func(ctx context.Context, records []T) ([]error, error)) error {
+
+	batch := make([]bulkRow[T], 0, batchSize)
+	flush := func() error {
+		if len(batch) == 0 {
+			return nil
+		}
+		records := make([]T, len(batch))
+		for i, row := range batch {
+			records[i] = row.record
+		}
+
+		rowErrors, err := apply(ctx, records)
+		if err != nil {
+			return err
+		}
+		for i, rowErr := range rowErrors {
+			if rowErr != nil {
+				if err = addRowError(result, batch[i].line, idOf(batch[i].record), rowErr); err != nil {
+					return err
+				}
+				continue
+			}
+			result.Imported++
+		}
+		batch = batch[:0]
+		return nil
+	}
+
+	err := bulkfile.Read(r, format, func(line int, record T, err error) error {
+		result.Total++
+		if err != nil {
+			return addRowError(result, line, idOf(record), domain.WrapError(err, errcodes.InvalidArgument, "invalid record"))
+		}
+		if err = prepare(ctx, &record); err != nil {
+			return addRowError(result, line, idOf(record), err)
+		}
+
+		batch = append(batch, bulkRow[T]{line: line, record: record})
+		if len(batch) < batchSize {
+			return nil
+		}
+		return flush()
+	})
+	if err != nil {
+		var appErr *domain.AppError
+		if errors.As(err, &appErr) {
+			return err
+		}
+		return domain.WrapError(err, errcodes.InvalidArgument, "failed to read import file")
+	}
+
+	return flush()
+}
+
+// addRowError записывает ошибку строки в результат: существующая сущность считается пропущенной,
+// а ошибка не из домена прерывает импорт.
+func addRowError(result *entity.ImportResult, line int, id string, err error) error {
+	var appErr *domain.AppError
+	if !errors.As(err, &appErr) {
+		return err
+	}
+
+	switch appErr.Code {
+	case errcodes.TeamAlreadyExists, errcodes.UserAlreadyExists, errcodes.PullRequestExists:
+		result.Skipped++
+	default:
+		result.Errors = append(result.Errors, entity.ImportRowError{
+			Line:    line,
+			Id:      id,
+			Code:    string(appErr.Code),
+			Message: appErr.Error(),
+		})
+	}
+	return nil
+}
+
+func exportRecords[T any](ctx context.Context, w io.Writer, format string,
+	load func(ctx context.Context) ([]T, error)) error {
+
+	records, err := load(ctx)
+	if err != nil {
+		return err
+	}
+
+	writer, err := bulkfile.NewWriter[T](w, format)
+	if err != nil {
+		return domain.WrapError(err, errcodes.InvalidArgument, "unsupported export format")
+	}
+	for _, record := range records {
+		if err = writer.Write(record); err != nil {
+			return domain.WrapError(err, errcodes.InternalServerError, "failed to write export")
+		}
+	}
+	if err = writer.Flush(); err != nil {
+		return domain.WrapError(err, errcodes.InternalServerError, "failed to write export")
+	}
+
+	return nil
+}
+
+func checkFormat(format string) error {
+	if !slices.Contains([]string{bulkfile.FormatJSONL, bulkfile.FormatCSV}, format) {
+		return domain.NewError(errcodes.InvalidArgument, fmt.Sprintf("unknown format '%s'", format))
+	}
+	return nil
+}
+
+func unknownKind(kind string) error {
+	return domain.NewError(errcodes.InvalidArgument, fmt.Sprintf("unknown kind '%s'", kind))
+}
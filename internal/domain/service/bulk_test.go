@@ -0,0 +1,176 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+
+	"pull_requests_service/internal/domain"
+	"pull_requests_service/internal/domain/entity"
+	"pull_requests_service/pkg/bulkfile"
+	"pull_requests_service/pkg/errcodes"
+)
+
+type fakeBulkRepo struct {
+	BulkRepository
+	batches  [][]entity.UserRecord
+	existing map[string]bool
+	teams    []entity.TeamRecord
+}
+
+func (r *fakeBulkRepo) ImportUsers(_ context.Context, users []entity.UserRecord) ([]error, error) {
+	r.batches = append(r.batches, users)
+	rowErrors := make([]error, len(users))
+	for i, user := range users {
+		switch {
+		case r.existing[user.UserId]:
+			rowErrors[i] = domain.NewError(errcodes.UserAlreadyExists, "user already exists")
+		case user.TeamName == "ghost":
+			rowErrors[i] = domain.NewError(errcodes.NotFound, "team not found")
+		}
+	}
+	return rowErrors, nil
+}
+
+func (r *fakeBulkRepo) ExportTeams(context.Context) ([]entity.TeamRecord, error) {
+	return r.teams, nil
+}
+
+func TestBulkImportReportsRowsInBatches(t *testing.T) {
+	rq := require.New(t)
+
+	repo := &fakeBulkRepo{existing: map[string]bool{"u2": true}}
+	svc := NewBulkService(repo, nil, nil, BulkOptions{BatchSize: 2})
+
+	input := "user_id,username,team_name,seniority\n" +
+		"u1,Alice,backend,\n" +
+		"u2,Bob,backend,2\n" +
+		"u3,Carol,ghost,\n" +
+		"u4,,backend,\n" +
+		"u5,Eve,backend,abc\n" +
+		"u6,Frank,,3\n"
+
+	result, err := svc.Import(context.Background(), entity.BulkUsers, bulkfile.FormatCSV, strings.NewReader(input))
+	rq.NoError(err)
+
+	rq.Equal(6, result.Total)
+	rq.Equal(2, result.Imported)
+	rq.Equal(1, result.Skipped)
+	rq.Len(result.Errors, 3)
+	rq.Equal(entity.ImportRowError{Line: 4, Id: "u3", Code: string(errcodes.NotFound), Message: "team not found"},
+		result.Errors[0])
+	rq.Equal(5, result.Errors[1].Line)
+	rq.Equal(string(errcodes.InvalidArgument), result.Errors[1].Code)
+	rq.Equal(6, result.Errors[2].Line)
+
+	// строки с ошибками разбора и проверки не доходят до репозитория
+	rq.Len(repo.batches, 2)
+	rq.Len(repo.batches[0], 2)
+	rq.Equal(1, repo.batches[0][0].Seniority)
+	rq.True(*repo.batches[0][0].IsActive)
+	rq.Equal([]string{"u3", "u6"}, []string{repo.batches[1][0].UserId, repo.batches[1][1].UserId})
+}
+
+func TestBulkRejectsUnknownKindAndFormat(t *testing.T) {
+	rq := require.New(t)
+
+	svc := NewBulkService(&fakeBulkRepo{}, nil, nil, BulkOptions{BatchSize: 10})
+
+	var appErr *domain.AppError
+	_, err := svc.Import(context.Background(), "projects", bulkfile.FormatJSONL, strings.NewReader(""))
+	rq.ErrorAs(err, &appErr)
+	rq.Equal(errcodes.InvalidArgument, appErr.Code)
+
+	err = svc.Export(context.Background(), entity.BulkTeams, "xml", &bytes.Buffer{})
+	rq.ErrorAs(err, &appErr)
+	rq.Equal(errcodes.InvalidArgument, appErr.Code)
+}
+
+func TestBulkExportTeams(t *testing.T) {
+	rq := require.New(t)
+
+	limit := 2
+	repo := &fakeBulkRepo{teams: []entity.TeamRecord{
+		{TeamName: "backend", ReviewerStrategy: entity.StrategyRandom, RequiredReviewers: 2, MaxOpenReviews: &limit,
+			FallbackTeams: []string{"platform", "frontend"}},
+	}}
+	svc := NewBulkService(repo, nil, nil, BulkOptions{BatchSize: 10})
+
+	var buf bytes.Buffer
+	rq.NoError(svc.Export(context.Background(), entity.BulkTeams, bulkfile.FormatCSV, &buf))
+	rq.Equal("team_name,reviewer_strategy,required_reviewers,max_open_reviews,require_approvals,fallback_teams,archived\n"+
+		"backend,"+entity.StrategyRandom+",2,2,false,platform;frontend,false\n", buf.String())
+}
+
+// memoryBulkRepo хранит импортированные записи как есть: правила ссылок на команды
+// проверяются тестами BulkRepository, здесь — только то, что файл выгрузки читается обратно без потерь.
+type memoryBulkRepo struct {
+	BulkRepository
+	teams []entity.TeamRecord
+	users []entity.UserRecord
+}
+
+func (r *memoryBulkRepo) ImportTeams(_ context.Context, teams []entity.TeamRecord) ([]error, error) {
+	r.teams = append(r.teams, teams...)
+	return make([]error, len(teams)), nil
+}
+
+func (r *memoryBulkRepo) ImportUsers(_ context.Context, users []entity.UserRecord) ([]error, error) {
+	r.users = append(r.users, users...)
+	return make([]error, len(users)), nil
+}
+
+func (r *memoryBulkRepo) ExportTeams(context.Context) ([]entity.TeamRecord, error) {
+	return r.teams, nil
+}
+
+func (r *memoryBulkRepo) ExportUsers(context.Context) ([]entity.UserRecord, error) {
+	return r.users, nil
+}
+
+func TestBulkRoundTripWithArchivedTeams(t *testing.T) {
+	limit := 3
+	source := &memoryBulkRepo{
+		teams: []entity.TeamRecord{
+			{TeamName: "platform", ReviewerStrategy: entity.StrategyRandom, RequiredReviewers: 1},
+			{TeamName: "legacy", ReviewerStrategy: entity.StrategyRandom, RequiredReviewers: 2,
+				FallbackTeams: []string{"platform"}, Archived: true},
+			{TeamName: "backend", ReviewerStrategy: entity.StrategyRandom, RequiredReviewers: 2, MaxOpenReviews: &limit,
+				RequireApprovals: true, FallbackTeams: []string{"legacy", "platform"}},
+		},
+		users: []entity.UserRecord{
+			{UserId: "u1", Username: "Alice", TeamName: "legacy", IsActive: lo.ToPtr(false), Seniority: 2},
+			{UserId: "u2", Username: "Bob", TeamName: "backend", IsActive: lo.ToPtr(true), Seniority: 1,
+				MaxOpenReviews: &limit},
+			{UserId: "u3", Username: "Carol", IsActive: lo.ToPtr(true), Seniority: 3},
+		},
+	}
+
+	for _, format := range []string{bulkfile.FormatJSONL, bulkfile.FormatCSV} {
+		t.Run(format, func(t *testing.T) {
+			rq := require.New(t)
+
+			teamService := NewTeamService(nil, nil, NewReviewerSelectors())
+			target := &memoryBulkRepo{}
+			exporter := NewBulkService(source, teamService, nil, BulkOptions{BatchSize: 2})
+			importer := NewBulkService(target, teamService, nil, BulkOptions{BatchSize: 2})
+
+			for _, kind := range []string{entity.BulkTeams, entity.BulkUsers} {
+				var file bytes.Buffer
+				rq.NoError(exporter.Export(context.Background(), kind, format, &file))
+
+				result, err := importer.Import(context.Background(), kind, format, &file)
+				rq.NoError(err)
+				rq.Empty(result.Errors)
+				rq.Equal(result.Total, result.Imported)
+			}
+
+			rq.Equal(source.teams, target.teams)
+			rq.Equal(source.users, target.users)
+		})
+	}
+}
@@ -0,0 +1,87 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"pull_requests_service/internal/domain"
+	"pull_requests_service/internal/domain/entity"
+	"pull_requests_service/pkg/codeowners"
+	"pull_requests_service/pkg/errcodes"
+	"pull_requests_service/pkg/tracing"
+	"slices"
+)
+
+// SetCodeOwners сохраняет файл владельцев путей команды, предварительно проверив его разбор.
+func (s *TeamService) SetCodeOwners(ctx context.Context, owners entity.CodeOwners) (entity.CodeOwners, error) {
+	ctx, span := tracing.Tracer().Start(ctx, "TeamService.SetCodeOwners")
+	defer span.End()
+
+	if _, err := codeowners.Parse(owners.Content); err != nil {
+		return entity.CodeOwners{}, domain.WrapError(err, errcodes.InvalidArgument, "invalid code owners: "+err.Error())
+	}
+
+	return s.teamRepo.SetCodeOwners(ctx, owners)
+}
+
+func (s *TeamService) GetCodeOwners(ctx context.Context, teamName string) (entity.CodeOwners, error) {
+	ctx, span := tracing.Tracer().Start(ctx, "TeamService.GetCodeOwners")
+	defer span.End()
+
+	return s.teamRepo.GetCodeOwners(ctx, teamName)
+}
+
+// pathOwners возвращает владельцев изменённых путей по файлу команды.
+// Без путей или без файла предпочтений нет.
+func (s *PullRequestService) pathOwners(ctx context.Context, teamName string, paths []string) ([]string, error) {
+	if len(paths) == 0 {
+		return nil, nil
+	}
+
+	owners, err := s.teamRepo.GetCodeOwners(ctx, teamName)
+	if err != nil {
+		var appErr *domain.AppError
+		if errors.As(err, &appErr) && appErr.Code == errcodes.NotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	file, err := codeowners.Parse(owners.Content)
+	if err != nil {
+		return nil, domain.WrapError(err, errcodes.InternalServerError, "failed to parse stored code owners")
+	}
+
+	return file.OwnersOf(paths), nil
+}
+
+// pickPreferringOwners сначала выбирает ревьюверов среди владельцев путей, а недостающих
+// добирает из пулов как обычно. Владелец учитывается, только если он есть среди кандидатов пула.
+func pickPreferringOwners(pools []entity.CandidatePool, owners []string, n int, pick entity.ReviewerPicker) []entity.PickedReviewer {
+	if len(owners) == 0 {
+		return entity.PickFromPools(pools, n, pick)
+	}
+
+	filterPools := func(keep func(entity.ReviewerCandidate) bool) []entity.CandidatePool {
+		filtered := make([]entity.CandidatePool, len(pools))
+		for i, pool := range pools {
+			filtered[i] = entity.CandidatePool{Team: pool.Team}
+			for _, candidate := range pool.Candidates {
+				if keep(candidate) {
+					filtered[i].Candidates = append(filtered[i].Candidates, candidate)
+				}
+			}
+		}
+		return filtered
+	}
+
+	picked := entity.PickFromPools(filterPools(func(c entity.ReviewerCandidate) bool {
+		return slices.Contains(owners, c.UserId)
+	}), n, pick)
+
+	pickedIds := entity.ReviewerIds(picked)
+	rest := filterPools(func(c entity.ReviewerCandidate) bool {
+		return !slices.Contains(pickedIds, c.UserId)
+	})
+
+	return append(picked, entity.PickFromPools(rest, n-len(picked), pick)...)
+}
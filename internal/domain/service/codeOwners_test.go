@@ -0,0 +1,37 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"pull_requests_service/internal/domain/entity"
+)
+
+func TestPickPreferringOwners(t *testing.T) {
+	rq := require.New(t)
+
+	selectors := newReviewerSelectors(func(int) int { return 0 })
+	pools := []entity.CandidatePool{
+		{Team: entity.Team{Name: "payments", ReviewerStrategy: entity.StrategyRandom}, Candidates: []entity.ReviewerCandidate{
+			{UserId: "u1", Seniority: 1},
+			{UserId: "u2", Seniority: 1},
+			{UserId: "u3", Seniority: 1},
+		}},
+		{Team: entity.Team{Name: "backend", ReviewerStrategy: entity.StrategyRandom}, Candidates: []entity.ReviewerCandidate{
+			{UserId: "u4", Seniority: 1},
+		}},
+	}
+
+	// владелец из резервной команды идёт раньше своей команды, неактивный владелец u9 пропускается
+	picked := pickPreferringOwners(pools, []string{"u9", "u4", "u3"}, 3, selectors.Pick)
+	rq.Equal([]entity.PickedReviewer{
+		{ReviewerId: "u3", SourceTeam: "payments"},
+		{ReviewerId: "u4", SourceTeam: "backend"},
+		{ReviewerId: "u1", SourceTeam: "payments"},
+	}, picked)
+
+	// без владельцев — обычный выбор по пулам
+	picked = pickPreferringOwners(pools, nil, 2, selectors.Pick)
+	rq.Equal([]string{"u1", "u2"}, entity.ReviewerIds(picked))
+}
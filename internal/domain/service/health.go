@@ -0,0 +1,121 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"pull_requests_service/internal/domain/entity"
+	"sync/atomic"
+	"time"
+)
+
+type DatabasePinger interface {
+	PingContext(ctx context.Context) error
+}
+
+type MigrationChecker interface {
+	// CheckMigrations возвращает применённую версию схемы или ошибку, если схема грязная или отстаёт.
+	CheckMigrations(ctx context.Context) (uint, error)
+}
+
+type WorkerHeartbeat interface {
+	LastHeartbeat() time.Time
+}
+
+type HealthOptions struct {
+	CheckTimeout     time.Duration
+	HeartbeatTimeout time.Duration
+}
+
+type HealthService struct {
+	db           DatabasePinger
+	migrations   MigrationChecker
+	worker       WorkerHeartbeat
+	options      HealthOptions
+	shuttingDown atomic.Bool
+}
+
+func NewHealthService(db DatabasePinger, migrations MigrationChecker, worker WorkerHeartbeat,
+	options HealthOptions) *HealthService {
+	return &HealthService{
+		db:         db,
+		migrations: migrations,
+		worker:     worker,
+		options:    options,
+	}
+}
+
+// Live сообщает только, что процесс отвечает: зависимости на живость не влияют,
+// иначе недоступная БД приводила бы к перезапуску всех реплик.
+func (s *HealthService) Live(_ context.Context) entity.HealthReport {
+	return entity.HealthReport{Status: entity.HealthUp, Checks: []entity.HealthCheck{}}
+}
+
+// Ready проверяет зависимости, без которых реплика не должна получать трафик.
+func (s *HealthService) Ready(ctx context.Context) entity.HealthReport {
+	ctx, cancel := context.WithTimeout(ctx, s.options.CheckTimeout)
+	defer cancel()
+
+	checks := []entity.HealthCheck{
+		s.checkShutdown(),
+		s.checkPostgres(ctx),
+		s.checkMigrations(ctx),
+		s.checkWorker(),
+	}
+
+	report := entity.HealthReport{Status: entity.HealthUp, Checks: checks}
+	for _, check := range checks {
+		if check.Status != entity.HealthUp {
+			report.Status = entity.HealthDown
+		}
+	}
+
+	return report
+}
+
+// SetShuttingDown переводит реплику в неготовое состояние на время остановки.
+func (s *HealthService) SetShuttingDown() {
+	s.shuttingDown.Store(true)
+}
+
+func (s *HealthService) checkShutdown() entity.HealthCheck {
+	if s.shuttingDown.Load() {
+		return healthDown(entity.HealthCheckShutdown, "shutting down")
+	}
+	return healthUp(entity.HealthCheckShutdown, "")
+}
+
+func (s *HealthService) checkPostgres(ctx context.Context) entity.HealthCheck {
+	if err := s.db.PingContext(ctx); err != nil {
+		return healthDown(entity.HealthCheckPostgres, err.Error())
+	}
+	return healthUp(entity.HealthCheckPostgres, "")
+}
+
+func (s *HealthService) checkMigrations(ctx context.Context) entity.HealthCheck {
+	version, err := s.migrations.CheckMigrations(ctx)
+	if err != nil {
+		return healthDown(entity.HealthCheckMigrations, err.Error())
+	}
+	return healthUp(entity.HealthCheckMigrations, fmt.Sprintf("version %d", version))
+}
+
+func (s *HealthService) checkWorker() entity.HealthCheck {
+	last := s.worker.LastHeartbeat()
+	if last.IsZero() {
+		return healthDown(entity.HealthCheckWorker, "worker has not started")
+	}
+
+	since := time.Since(last).Round(time.Millisecond)
+	if since > s.options.HeartbeatTimeout {
+		return healthDown(entity.HealthCheckWorker, fmt.Sprintf("last heartbeat %s ago", since))
+	}
+	return healthUp(entity.HealthCheckWorker, fmt.Sprintf("last heartbeat %s ago", since))
+}
+
+func healthUp(name, message string) entity.HealthCheck {
+	return entity.HealthCheck{Name: name, Status: entity.HealthUp, Message: message}
+}
+
+func healthDown(name, message string) entity.HealthCheck {
+	return entity.HealthCheck{Name: name, Status: entity.HealthDown, Message: message}
+}
@@ -0,0 +1,68 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"pull_requests_service/internal/domain/entity"
+)
+
+type stubPinger struct{ err error }
+
+func (p stubPinger) PingContext(context.Context) error { return p.err }
+
+type stubMigrations struct {
+	version uint
+	err     error
+}
+
+func (m stubMigrations) CheckMigrations(context.Context) (uint, error) { return m.version, m.err }
+
+type stubHeartbeat struct{ at time.Time }
+
+func (h stubHeartbeat) LastHeartbeat() time.Time { return h.at }
+
+func healthChecks(report entity.HealthReport) map[string]entity.HealthStatus {
+	statuses := make(map[string]entity.HealthStatus, len(report.Checks))
+	for _, check := range report.Checks {
+		statuses[check.Name] = check.Status
+	}
+	return statuses
+}
+
+func TestHealthReady(t *testing.T) {
+	rq := require.New(t)
+	options := HealthOptions{CheckTimeout: time.Second, HeartbeatTimeout: time.Minute}
+
+	svc := NewHealthService(stubPinger{}, stubMigrations{version: 13}, stubHeartbeat{at: time.Now()}, options)
+	report := svc.Ready(context.Background())
+	rq.Equal(entity.HealthUp, report.Status)
+	rq.Len(report.Checks, 4)
+
+	svc = NewHealthService(stubPinger{err: errors.New("connection refused")}, stubMigrations{version: 13},
+		stubHeartbeat{at: time.Now().Add(-time.Hour)}, options)
+	report = svc.Ready(context.Background())
+	rq.Equal(entity.HealthDown, report.Status)
+	statuses := healthChecks(report)
+	rq.Equal(entity.HealthDown, statuses[entity.HealthCheckPostgres])
+	rq.Equal(entity.HealthUp, statuses[entity.HealthCheckMigrations])
+	rq.Equal(entity.HealthDown, statuses[entity.HealthCheckWorker])
+
+	svc = NewHealthService(stubPinger{}, stubMigrations{version: 13}, stubHeartbeat{}, options)
+	rq.Equal(entity.HealthDown, healthChecks(svc.Ready(context.Background()))[entity.HealthCheckWorker])
+}
+
+func TestHealthShuttingDown(t *testing.T) {
+	rq := require.New(t)
+
+	svc := NewHealthService(stubPinger{}, stubMigrations{version: 13}, stubHeartbeat{at: time.Now()},
+		HealthOptions{CheckTimeout: time.Second, HeartbeatTimeout: time.Minute})
+	svc.SetShuttingDown()
+
+	rq.Equal(entity.HealthDown, svc.Ready(context.Background()).Status)
+	rq.Equal(entity.HealthUp, svc.Live(context.Background()).Status)
+}
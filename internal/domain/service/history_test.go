@@ -0,0 +1,67 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+
+	"pull_requests_service/internal/domain"
+	"pull_requests_service/internal/domain/entity"
+	"pull_requests_service/pkg/errcodes"
+)
+
+type historyPRRepo struct {
+	PullRequestRepository
+	history map[string][]entity.ReviewerAssignment
+}
+
+func (r *historyPRRepo) History(_ context.Context, prId string) ([]entity.ReviewerAssignment, error) {
+	history, ok := r.history[prId]
+	if !ok {
+		return nil, domain.NewError(errcodes.NotFound, "pull request not found")
+	}
+	return history, nil
+}
+
+func TestGetHistory(t *testing.T) {
+	rq := require.New(t)
+
+	assigned := time.Date(2025, 10, 1, 12, 0, 0, 0, time.UTC)
+	replaced := assigned.Add(time.Hour)
+	backend := "backend"
+	history := []entity.ReviewerAssignment{
+		{ReviewerId: "u2", AssignedAt: assigned, ReplacedAt: &replaced, ReplaceReason: lo.ToPtr(entity.ReplaceReasonManual),
+			SourceTeam: &backend, ReviewState: entity.ReviewPending},
+		{ReviewerId: "u3", AssignedAt: assigned, ReplacedAt: &replaced, ReplaceReason: lo.ToPtr(entity.ReplaceReasonDeactivation)},
+		{ReviewerId: "u4", AssignedAt: replaced, ReplacedAt: &replaced, ReplaceReason: lo.ToPtr(entity.ReplaceReasonTeamMove)},
+		{ReviewerId: "u5", AssignedAt: replaced, IsCurrent: true, SourceTeam: &backend, ReviewState: entity.ReviewPending},
+	}
+	repo := &historyPRRepo{history: map[string][]entity.ReviewerAssignment{"pr-1": history, "pr-2": {}}}
+	svc := NewPullRequestService(nil, nil, repo, nil, NewReviewerSelectors(), OutboxOptions{})
+
+	got, err := svc.GetHistory(context.Background(), "pr-1")
+	rq.NoError(err)
+	rq.Equal(history, got)
+	reasons := make([]string, 0, len(got))
+	for _, assignment := range got {
+		if assignment.IsCurrent {
+			rq.Nil(assignment.ReplaceReason)
+			continue
+		}
+		reasons = append(reasons, *assignment.ReplaceReason)
+	}
+	rq.Equal([]string{entity.ReplaceReasonManual, entity.ReplaceReasonDeactivation, entity.ReplaceReasonTeamMove}, reasons)
+
+	// у PR без назначений история пустая, а не ошибка
+	got, err = svc.GetHistory(context.Background(), "pr-2")
+	rq.NoError(err)
+	rq.Empty(got)
+
+	_, err = svc.GetHistory(context.Background(), "pr-404")
+	var appErr *domain.AppError
+	rq.ErrorAs(err, &appErr)
+	rq.Equal(errcodes.NotFound, appErr.Code)
+}
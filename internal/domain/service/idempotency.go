@@ -0,0 +1,105 @@
+package service
+
+import (
+	"context"
+	"pull_requests_service/internal/domain"
+	"pull_requests_service/internal/domain/entity"
+	"pull_requests_service/pkg/errcodes"
+	"pull_requests_service/pkg/logx"
+	"pull_requests_service/pkg/tracing"
+	"time"
+)
+
+type IdempotencyRepository interface {
+	Reserve(ctx context.Context, scope, key, fingerprint string, lock time.Duration) (entity.IdempotencyRecord, bool, error)
+	Complete(ctx context.Context, record entity.IdempotencyRecord, ttl time.Duration) error
+	Release(ctx context.Context, scope, key string) error
+	DeleteExpired(ctx context.Context) (int64, error)
+}
+
+// IdempotencyOptions — сроки хранения ответов на запросы с Idempotency-Key.
+type IdempotencyOptions struct {
+	// TTL — сколько хранится ответ и повторы получают его вместо нового выполнения.
+	TTL time.Duration
+	// LockTimeout — через сколько ключ запроса, не дождавшегося ответа, можно занять заново.
+	LockTimeout     time.Duration
+	CleanupInterval time.Duration
+}
+
+type IdempotencyService struct {
+	repo IdempotencyRepository
+	opts IdempotencyOptions
+}
+
+func NewIdempotencyService(repo IdempotencyRepository, opts IdempotencyOptions) *IdempotencyService {
+	return &IdempotencyService{
+		repo: repo,
+		opts: opts,
+	}
+}
+
+// Begin занимает ключ под запрос. Если на запрос уже есть ответ, он возвращается для повтора;
+// nil означает, что запрос нужно выполнить и затем вызвать Complete или Release.
+func (s *IdempotencyService) Begin(ctx context.Context, scope, key, fingerprint string) (*entity.IdempotencyRecord, error) {
+	ctx, span := tracing.Tracer().Start(ctx, "IdempotencyService.Begin")
+	defer span.End()
+
+	record, reserved, err := s.repo.Reserve(ctx, scope, key, fingerprint, s.opts.LockTimeout)
+	if err != nil {
+		return nil, err
+	}
+	if reserved {
+		return nil, nil
+	}
+
+	if record.Fingerprint != fingerprint {
+		return nil, domain.NewError(errcodes.IdempotencyKeyReuse, "idempotency key was already used with a different request")
+	}
+	if !record.Completed() {
+		return nil, domain.NewError(errcodes.RequestInProgress, "request with this idempotency key is still in progress")
+	}
+
+	return &record, nil
+}
+
+// Complete сохраняет ответ на запрос для повторов.
+func (s *IdempotencyService) Complete(ctx context.Context, record entity.IdempotencyRecord) error {
+	ctx, span := tracing.Tracer().Start(ctx, "IdempotencyService.Complete")
+	defer span.End()
+
+	return s.repo.Complete(ctx, record, s.opts.TTL)
+}
+
+// Release освобождает ключ без ответа, например после внутренней ошибки: повтор выполнится заново.
+func (s *IdempotencyService) Release(ctx context.Context, scope, key string) error {
+	ctx, span := tracing.Tracer().Start(ctx, "IdempotencyService.Release")
+	defer span.End()
+
+	return s.repo.Release(ctx, scope, key)
+}
+
+// StartCleaner периодически удаляет истёкшие ответы.
+func (s *IdempotencyService) StartCleaner(ctx context.Context) {
+	logger(ctx).Info("Starting idempotency keys cleaner...")
+
+	ticker := time.NewTicker(s.opts.CleanupInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			logger(ctx).Info("Stopping idempotency keys cleaner...")
+			return
+		case <-ticker.C:
+		}
+
+		deleted, err := s.repo.DeleteExpired(ctx)
+		if err != nil {
+			logger(ctx).Error("Failed to delete expired idempotency keys", logx.Error(err))
+			continue
+		}
+		if deleted > 0 {
+			logger(ctx).Info("Deleted expired idempotency keys", "count", deleted)
+		}
+	}
+}
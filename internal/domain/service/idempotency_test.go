@@ -0,0 +1,45 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"pull_requests_service/internal/domain"
+	"pull_requests_service/internal/domain/entity"
+	"pull_requests_service/pkg/errcodes"
+)
+
+type takenKeyRepo struct {
+	IdempotencyRepository
+	existing entity.IdempotencyRecord
+}
+
+func (r *takenKeyRepo) Reserve(_ context.Context, _, _, _ string, _ time.Duration) (entity.IdempotencyRecord, bool, error) {
+	return r.existing, false, nil
+}
+
+func TestIdempotencyBegin(t *testing.T) {
+	rq := require.New(t)
+
+	repo := &takenKeyRepo{existing: entity.IdempotencyRecord{Scope: "u1 POST /team/add", Key: "k1", Fingerprint: "abc"}}
+	svc := NewIdempotencyService(repo, IdempotencyOptions{TTL: time.Hour, LockTimeout: time.Minute})
+
+	var appErr *domain.AppError
+	_, err := svc.Begin(context.Background(), "u1 POST /team/add", "k1", "abc")
+	rq.ErrorAs(err, &appErr)
+	rq.Equal(errcodes.RequestInProgress, appErr.Code)
+
+	_, err = svc.Begin(context.Background(), "u1 POST /team/add", "k1", "def")
+	rq.ErrorAs(err, &appErr)
+	rq.Equal(errcodes.IdempotencyKeyReuse, appErr.Code)
+
+	status := 201
+	repo.existing.StatusCode = &status
+	record, err := svc.Begin(context.Background(), "u1 POST /team/add", "k1", "abc")
+	rq.NoError(err)
+	rq.NotNil(record)
+	rq.Equal(201, *record.StatusCode)
+}
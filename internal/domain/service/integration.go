@@ -0,0 +1,128 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"pull_requests_service/internal/domain"
+	"pull_requests_service/internal/domain/entity"
+	"pull_requests_service/pkg/errcodes"
+	"pull_requests_service/pkg/tracing"
+	"strings"
+)
+
+type IntegrationRepository interface {
+	LinkIdentity(ctx context.Context, identity entity.ExternalIdentity) (entity.ExternalIdentity, error)
+	UnlinkIdentity(ctx context.Context, provider, login string) error
+	ResolveIdentity(ctx context.Context, provider, login string) (string, error)
+	IsDelivered(ctx context.Context, provider, deliveryId string) (bool, error)
+	MarkDelivered(ctx context.Context, provider, deliveryId string) error
+}
+
+// IntegrationService создаёт и мержит PR по вебхукам GitHub и GitLab.
+type IntegrationService struct {
+	repo      IntegrationRepository
+	prService *PullRequestService
+}
+
+func NewIntegrationService(repo IntegrationRepository, prService *PullRequestService) *IntegrationService {
+	return &IntegrationService{
+		repo:      repo,
+		prService: prService,
+	}
+}
+
+func (s *IntegrationService) LinkIdentity(ctx context.Context, identity entity.ExternalIdentity) (entity.ExternalIdentity, error) {
+	ctx, span := tracing.Tracer().Start(ctx, "IntegrationService.LinkIdentity")
+	defer span.End()
+
+	if err := validateProvider(identity.Provider); err != nil {
+		return entity.ExternalIdentity{}, err
+	}
+	if identity.Login == "" {
+		return entity.ExternalIdentity{}, domain.NewError(errcodes.InvalidArgument, "login is required")
+	}
+	identity.Login = normalizeLogin(identity.Login)
+
+	return s.repo.LinkIdentity(ctx, identity)
+}
+
+func (s *IntegrationService) UnlinkIdentity(ctx context.Context, provider, login string) error {
+	ctx, span := tracing.Tracer().Start(ctx, "IntegrationService.UnlinkIdentity")
+	defer span.End()
+
+	if err := validateProvider(provider); err != nil {
+		return err
+	}
+
+	return s.repo.UnlinkIdentity(ctx, provider, normalizeLogin(login))
+}
+
+// HandlePullRequestEvent применяет событие PR из VCS. Повторная доставка с тем же id пропускается;
+// доставка запоминается только после успешной обработки, чтобы VCS могла её повторить.
+func (s *IntegrationService) HandlePullRequestEvent(ctx context.Context, event entity.VCSPullRequestEvent) error {
+	ctx, span := tracing.Tracer().Start(ctx, "IntegrationService.HandlePullRequestEvent")
+	defer span.End()
+
+	if event.DeliveryId != "" {
+		delivered, err := s.repo.IsDelivered(ctx, event.Provider, event.DeliveryId)
+		if err != nil {
+			return err
+		}
+		if delivered {
+			logger(ctx).Info("Skipping duplicate VCS delivery", "provider", event.Provider, "delivery_id", event.DeliveryId)
+			return nil
+		}
+	}
+
+	var err error
+	switch event.Action {
+	case entity.VCSActionOpened:
+		err = s.openPullRequest(ctx, event)
+	case entity.VCSActionMerged:
+		_, err = s.prService.MergeFromVCS(ctx, event.PullRequestId())
+	default:
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	if event.DeliveryId == "" {
+		return nil
+	}
+	return s.repo.MarkDelivered(ctx, event.Provider, event.DeliveryId)
+}
+
+func (s *IntegrationService) openPullRequest(ctx context.Context, event entity.VCSPullRequestEvent) error {
+	authorId, err := s.repo.ResolveIdentity(ctx, event.Provider, normalizeLogin(event.AuthorLogin))
+	if err != nil {
+		return err
+	}
+
+	_, err = s.prService.CreatePullRequest(ctx, entity.PullRequest{
+		Id:       event.PullRequestId(),
+		Name:     event.Title,
+		AuthorId: authorId,
+	})
+
+	// PR уже создан предыдущей (параллельной) доставкой того же события
+	var appErr *domain.AppError
+	if errors.As(err, &appErr) && appErr.Code == errcodes.PullRequestExists {
+		return nil
+	}
+
+	return err
+}
+
+func validateProvider(provider string) error {
+	if provider != entity.ProviderGitHub && provider != entity.ProviderGitLab {
+		return domain.NewError(errcodes.InvalidArgument, fmt.Sprintf("unknown provider '%s'", provider))
+	}
+	return nil
+}
+
+// normalizeLogin приводит логин к нижнему регистру: и GitHub, и GitLab не различают регистр логинов.
+func normalizeLogin(login string) string {
+	return strings.ToLower(strings.TrimSpace(login))
+}
@@ -0,0 +1,43 @@
+package service
+
+import (
+	"context"
+	"pull_requests_service/internal/domain/entity"
+	"pull_requests_service/internal/metrics"
+	"time"
+)
+
+type OutboxRepository interface {
+	Claim(ctx context.Context, limit int, lease time.Duration) ([]entity.OutboxEvent, error)
+	MarkDone(ctx context.Context, id int64) error
+	MarkFailed(ctx context.Context, id int64, cause string, retryIn time.Duration) error
+}
+
+// OutboxOptions — параметры опроса outbox воркером.
+type OutboxOptions struct {
+	PollInterval time.Duration
+	BatchSize    int
+	// Lease — на сколько событие скрывается от других реплик после захвата.
+	Lease      time.Duration
+	MaxBackoff time.Duration
+}
+
+const retryBaseBackoff = time.Second
+
+// retryBackoff возвращает задержку перед следующей попыткой: 1s, 2s, 4s... но не больше maxBackoff.
+func retryBackoff(attempts int, maxBackoff time.Duration) time.Duration {
+	backoff := retryBaseBackoff
+	for i := 1; i < attempts && backoff < maxBackoff; i++ {
+		backoff *= 2
+	}
+
+	return min(backoff, maxBackoff)
+}
+
+func observeOutboxEvent(eventType string, startTime time.Time, err error) {
+	result := metrics.ResultSuccess
+	if err != nil {
+		result = metrics.ResultError
+	}
+	metrics.OutboxEventDuration.WithLabelValues(eventType, result).Observe(time.Since(startTime).Seconds())
+}
@@ -0,0 +1,18 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRetryBackoff(t *testing.T) {
+	rq := require.New(t)
+
+	rq.Equal(time.Second, retryBackoff(1, time.Minute))
+	rq.Equal(2*time.Second, retryBackoff(2, time.Minute))
+	rq.Equal(8*time.Second, retryBackoff(4, time.Minute))
+	rq.Equal(time.Minute, retryBackoff(10, time.Minute))
+	rq.Equal(time.Minute, retryBackoff(1000, time.Minute))
+}
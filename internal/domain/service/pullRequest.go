@@ -2,47 +2,79 @@ package service
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"pull_requests_service/internal/domain"
 	"pull_requests_service/internal/domain/entity"
+	"pull_requests_service/internal/metrics"
 	"pull_requests_service/pkg/errcodes"
+	"pull_requests_service/pkg/logx"
+	"pull_requests_service/pkg/pagination"
+	"pull_requests_service/pkg/tracing"
+	"sync/atomic"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 )
 
 type PullRequestRepository interface {
-	CreateWithReviewers(ctx context.Context, pr *entity.PullRequest, reviewerIDs []string) error
-	Merge(ctx context.Context, prId string) (entity.PullRequest, error)
-	Reassign(ctx context.Context, prId, oldReviewerId string) (entity.PullRequest, string, error)
-	GetUserReviews(ctx context.Context, userId string) ([]entity.PullRequest, error)
+	CreateWithReviewers(ctx context.Context, pr *entity.PullRequest, reviewers []entity.PickedReviewer) error
+	Merge(ctx context.Context, prId string, requireApprovals bool) (entity.PullRequest, error)
+	Reassign(ctx context.Context, prId, oldReviewerId string, pick entity.ReviewerPicker) (entity.PullRequest, string, error)
+	GetUserReviews(ctx context.Context, filter entity.UserReviewsFilter) ([]entity.ReviewAssignment, error)
+	Get(ctx context.Context, prId string) (entity.PullRequest, error)
+	List(ctx context.Context, filter entity.PullRequestFilter) ([]entity.PullRequest, error)
 	AssignToNeedyPRs(ctx context.Context, userID string) error
-	ReassignFromAllPRs(ctx context.Context, userID string) error
+	ReassignFromAllPRs(ctx context.Context, userID, reason string, pick entity.ReviewerPicker) error
+	History(ctx context.Context, prId string) ([]entity.ReviewerAssignment, error)
+	SubmitReview(ctx context.Context, prId string, review entity.Review) (entity.PullRequest, error)
+	MarkReady(ctx context.Context, prId string, reviewers []entity.PickedReviewer, needMore bool) (entity.PullRequest, error)
+	Close(ctx context.Context, prId, from string) (entity.PullRequest, error)
+	Reopen(ctx context.Context, prId string, reviewers []entity.PickedReviewer, needMore bool) (entity.PullRequest, error)
 }
 
 type PullRequestService struct {
-	userRepo  UserRepository
-	prRepo    PullRequestRepository
-	eventChan <-chan PrWorkerJob
+	userRepo   UserRepository
+	teamRepo   TeamRepository
+	prRepo     PullRequestRepository
+	outboxRepo OutboxRepository
+	selectors  *ReviewerSelectors
+	outbox     OutboxOptions
+	// heartbeat — время последней итерации воркера событий в UnixNano.
+	heartbeat atomic.Int64
 }
 
-func NewPullRequestService(userRepo UserRepository, prRepo PullRequestRepository, eventChan <-chan PrWorkerJob) *PullRequestService {
+func NewPullRequestService(userRepo UserRepository, teamRepo TeamRepository, prRepo PullRequestRepository,
+	outboxRepo OutboxRepository, selectors *ReviewerSelectors, outbox OutboxOptions) *PullRequestService {
 	return &PullRequestService{
-		userRepo:  userRepo,
-		prRepo:    prRepo,
-		eventChan: eventChan,
+		userRepo:   userRepo,
+		teamRepo:   teamRepo,
+		prRepo:     prRepo,
+		outboxRepo: outboxRepo,
+		selectors:  selectors,
+		outbox:     outbox,
 	}
 }
 
+// CreatePullRequest создаёт PR и назначает ревьюверов. Черновик создаётся без ревьюверов,
+// они подбираются при переводе в OPEN.
 func (s *PullRequestService) CreatePullRequest(ctx context.Context, pr entity.PullRequest) (entity.PullRequest, error) {
-	candidateIds, err := s.userRepo.GetActiveTeamCandidatesId(ctx, pr.AuthorId)
+	ctx, span := tracing.Tracer().Start(ctx, "PullRequestService.CreatePullRequest")
+	defer span.End()
+
+	reviewers, needMore, err := s.pickAuthorReviewers(ctx, pr.AuthorId, pr.ChangedPaths)
 	if err != nil {
-		var appErr *domain.AppError
-		if errors.As(err, &appErr) {
-			return entity.PullRequest{}, err
-		}
-		return entity.PullRequest{}, domain.WrapError(err, errcodes.InternalServerError, "failed to get team candidates")
+		return entity.PullRequest{}, err
+	}
+	if pr.Status == entity.StatusDraft {
+		reviewers, needMore = nil, false
+	} else {
+		pr.Status = entity.StatusOpen
 	}
-	pr.Status = entity.StatusOpen
-	err = s.prRepo.CreateWithReviewers(ctx, &pr, candidateIds)
+	pr.NeedMoreReviewers = needMore
+	err = s.prRepo.CreateWithReviewers(ctx, &pr, reviewers)
 	if err != nil {
 		var appErr *domain.AppError
 		if errors.As(err, &appErr) {
@@ -50,11 +82,70 @@ func (s *PullRequestService) CreatePullRequest(ctx context.Context, pr entity.Pu
 		}
 		return entity.PullRequest{}, domain.WrapError(err, errcodes.InternalServerError, "failed to create pull request with reviewers")
 	}
+	metrics.PullRequestsCreated.Inc()
 	return pr, nil
 }
 
+// pickAuthorReviewers подбирает ревьюверов нового PR из команды автора и её резервных команд.
+func (s *PullRequestService) pickAuthorReviewers(ctx context.Context, authorId string, changedPaths []string) (
+	[]entity.PickedReviewer, bool, error) {
+
+	pools, err := s.userRepo.GetCandidatePools(ctx, authorId)
+	if err != nil {
+		return nil, false, poolsError(err)
+	}
+
+	return s.pickReviewers(ctx, pools, changedPaths)
+}
+
+// pickReviewers подбирает ревьюверов PR из пулов кандидатов и сообщает, хватило ли кандидатов.
+func (s *PullRequestService) pickReviewers(ctx context.Context, pools []entity.CandidatePool, changedPaths []string) (
+	[]entity.PickedReviewer, bool, error) {
+
+	// первый пул — команда автора (или команда, которой передан PR), её настройки определяют число ревьюверов
+	team := pools[0].Team
+	owners, err := s.pathOwners(ctx, team.Name, changedPaths)
+	if err != nil {
+		return nil, false, err
+	}
+	reviewers := pickPreferringOwners(pools, owners, team.RequiredReviewers, s.selectors.Pick)
+
+	return reviewers, len(reviewers) < team.RequiredReviewers, nil
+}
+
+func poolsError(err error) error {
+	var appErr *domain.AppError
+	if errors.As(err, &appErr) {
+		return err
+	}
+	return domain.WrapError(err, errcodes.InternalServerError, "failed to get team candidates")
+}
+
 func (s *PullRequestService) Merge(ctx context.Context, prId string) (entity.PullRequest, error) {
-	mergedPR, err := s.prRepo.Merge(ctx, prId)
+	ctx, span := tracing.Tracer().Start(ctx, "PullRequestService.Merge")
+	defer span.End()
+
+	return s.merge(ctx, prId, true)
+}
+
+// MergeFromVCS отражает слияние, уже случившееся в VCS: одобрения команды автора не проверяются.
+func (s *PullRequestService) MergeFromVCS(ctx context.Context, prId string) (entity.PullRequest, error) {
+	ctx, span := tracing.Tracer().Start(ctx, "PullRequestService.MergeFromVCS")
+	defer span.End()
+
+	return s.merge(ctx, prId, false)
+}
+
+func (s *PullRequestService) merge(ctx context.Context, prId string, requireApprovals bool) (entity.PullRequest, error) {
+	pr, err := s.prRepo.Get(ctx, prId)
+	if err != nil {
+		return entity.PullRequest{}, err
+	}
+	if _, err = nextStatus(pr.Status, actionMerge); err != nil {
+		return entity.PullRequest{}, err
+	}
+
+	mergedPR, err := s.prRepo.Merge(ctx, prId, requireApprovals)
 	if err != nil {
 		var appErr *domain.AppError
 		if errors.As(err, &appErr) {
@@ -67,10 +158,16 @@ func (s *PullRequestService) Merge(ctx context.Context, prId string) (entity.Pul
 }
 
 func (s *PullRequestService) Reassign(ctx context.Context, prId string, oldId string) (entity.PullRequest, string, error) {
-	pr, newId, err := s.prRepo.Reassign(ctx, prId, oldId)
+	ctx, span := tracing.Tracer().Start(ctx, "PullRequestService.Reassign")
+	defer span.End()
+
+	pr, newId, err := s.prRepo.Reassign(ctx, prId, oldId, s.selectors.Pick)
 	if err != nil {
 		var appErr *domain.AppError
 		if errors.As(err, &appErr) {
+			if appErr.Code == errcodes.NoCandidate {
+				metrics.ReassignNoCandidate.Inc()
+			}
 			return entity.PullRequest{}, "", err
 		}
 		return entity.PullRequest{}, "", domain.WrapError(err, errcodes.InternalServerError,
@@ -79,30 +176,219 @@ func (s *PullRequestService) Reassign(ctx context.Context, prId string, oldId st
 	return pr, newId, nil
 }
 
-func (s *PullRequestService) GetUserReviews(ctx context.Context, userId string) ([]entity.PullRequest, error) {
-	return s.prRepo.GetUserReviews(ctx, userId)
+// GetUserReviews возвращает страницу PR, где пользователь ревьювер, и курсор следующей страницы.
+func (s *PullRequestService) GetUserReviews(ctx context.Context, filter entity.UserReviewsFilter, cursor string) (
+	[]entity.ReviewAssignment, string, error) {
+	ctx, span := tracing.Tracer().Start(ctx, "PullRequestService.GetUserReviews")
+	defer span.End()
+
+	pageSize, after, err := parsePage(filter.Limit, cursor)
+	if err != nil {
+		return nil, "", err
+	}
+	filter.Limit = pageSize + 1
+	filter.After = after
+
+	reviews, err := s.prRepo.GetUserReviews(ctx, filter)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if len(reviews) <= pageSize {
+		return reviews, "", nil
+	}
+
+	reviews = reviews[:pageSize]
+	last := reviews[len(reviews)-1]
+
+	return reviews, pagination.Cursor{At: last.AssignedAt, ID: last.Id}.Encode(), nil
 }
 
+func (s *PullRequestService) GetPullRequest(ctx context.Context, prId string) (entity.PullRequest, error) {
+	ctx, span := tracing.Tracer().Start(ctx, "PullRequestService.GetPullRequest")
+	defer span.End()
+
+	return s.prRepo.Get(ctx, prId)
+}
+
+// GetHistory возвращает полную историю назначений ревьюверов на PR.
+func (s *PullRequestService) GetHistory(ctx context.Context, prId string) ([]entity.ReviewerAssignment, error) {
+	ctx, span := tracing.Tracer().Start(ctx, "PullRequestService.GetHistory")
+	defer span.End()
+
+	return s.prRepo.History(ctx, prId)
+}
+
+const (
+	defaultPageSize = 50
+	maxPageSize     = 200
+)
+
+// ListPullRequests возвращает страницу PR и курсор следующей страницы (пустой, если страница последняя).
+func (s *PullRequestService) ListPullRequests(ctx context.Context, filter entity.PullRequestFilter, cursor string) (
+	[]entity.PullRequest, string, error) {
+	ctx, span := tracing.Tracer().Start(ctx, "PullRequestService.ListPullRequests")
+	defer span.End()
+
+	pageSize, after, err := parsePage(filter.Limit, cursor)
+	if err != nil {
+		return nil, "", err
+	}
+	filter.Limit = pageSize + 1
+	filter.After = after
+
+	prs, err := s.prRepo.List(ctx, filter)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if len(prs) <= pageSize {
+		return prs, "", nil
+	}
+
+	prs = prs[:pageSize]
+	last := prs[len(prs)-1]
+
+	return prs, pagination.Cursor{At: last.CreatedAt, ID: last.Id}.Encode(), nil
+}
+
+// parsePage проверяет размер страницы и разбирает курсор клиента.
+// Репозиторию передаётся limit+1, чтобы понять, есть ли следующая страница.
+func parsePage(limit int, cursor string) (int, *pagination.Cursor, error) {
+	if limit == 0 {
+		limit = defaultPageSize
+	}
+	if limit < 1 || limit > maxPageSize {
+		return 0, nil, domain.NewError(errcodes.InvalidArgument,
+			fmt.Sprintf("limit must be between 1 and %d", maxPageSize))
+	}
+
+	if cursor == "" {
+		return limit, nil, nil
+	}
+
+	after, err := pagination.Decode(cursor)
+	if err != nil {
+		return 0, nil, domain.WrapError(err, errcodes.InvalidArgument, "malformed cursor")
+	}
+
+	return limit, &after, nil
+}
+
+// StartEventWorker опрашивает outbox и перераспределяет ревью при смене активности пользователей.
+// Несколько реплик могут работать одновременно: события разбираются через SKIP LOCKED.
 func (s *PullRequestService) StartEventWorker(ctx context.Context) {
 	logger(ctx).Info("Starting PR event worker...")
+
+	ticker := time.NewTicker(s.outbox.PollInterval)
+	defer ticker.Stop()
+
 	for {
+		s.heartbeat.Store(time.Now().UnixNano())
+
+		// полная пачка означает, что в очереди, скорее всего, есть ещё события
+		if processed := s.processOutbox(ctx); processed > 0 && processed == s.outbox.BatchSize && ctx.Err() == nil {
+			continue
+		}
+
 		select {
 		case <-ctx.Done():
 			logger(ctx).Info("Stopping PR event worker...")
 			return
-		case job := <-s.eventChan:
-			if job.value {
-				err := s.prRepo.AssignToNeedyPRs(ctx, job.userId)
-				if err != nil {
-					logger(ctx).Error("Failed to assign needy PRs to needy PRs", err.Error())
-				}
-
-			} else {
-				err := s.prRepo.ReassignFromAllPRs(ctx, job.userId)
-				if err != nil {
-					logger(ctx).Error("Failed to reassign from all PRs to needy PRs", err.Error())
-				}
+		case <-ticker.C:
+		}
+	}
+}
+
+// LastHeartbeat возвращает время последней итерации воркера событий или нулевое время, если он не запущен.
+func (s *PullRequestService) LastHeartbeat() time.Time {
+	if nanos := s.heartbeat.Load(); nanos != 0 {
+		return time.Unix(0, nanos)
+	}
+	return time.Time{}
+}
+
+// processOutbox обрабатывает одну пачку событий и возвращает её размер.
+func (s *PullRequestService) processOutbox(ctx context.Context) int {
+	events, err := s.outboxRepo.Claim(ctx, s.outbox.BatchSize, s.outbox.Lease)
+	if err != nil {
+		logger(ctx).Error("Failed to claim outbox events", logx.Error(err))
+		return 0
+	}
+
+	for _, event := range events {
+		startTime := time.Now()
+		err = s.handleEvent(ctx, event)
+		observeOutboxEvent(event.EventType, startTime, err)
+		if err != nil {
+			retryIn := retryBackoff(event.Attempts, s.outbox.MaxBackoff)
+			logger(ctx).Error("Failed to handle outbox event", logx.Error(err),
+				"event_id", event.Id, "event_type", event.EventType, "attempts", event.Attempts, "retry_in", retryIn)
+
+			if markErr := s.outboxRepo.MarkFailed(ctx, event.Id, err.Error(), retryIn); markErr != nil {
+				logger(ctx).Error("Failed to mark outbox event failed", logx.Error(markErr), "event_id", event.Id)
 			}
+			continue
+		}
+
+		if err = s.outboxRepo.MarkDone(ctx, event.Id); err != nil {
+			logger(ctx).Error("Failed to mark outbox event done", logx.Error(err), "event_id", event.Id)
 		}
 	}
+
+	return len(events)
+}
+
+func (s *PullRequestService) handleEvent(ctx context.Context, event entity.OutboxEvent) (err error) {
+	ctx, span := tracing.Tracer().Start(ctx, "PullRequestService.handleEvent", trace.WithAttributes(
+		attribute.Int64("outbox.event_id", event.Id),
+		attribute.String("outbox.event_type", event.EventType),
+	))
+	defer func() {
+		tracing.RecordError(span, err)
+		span.End()
+	}()
+
+	switch event.EventType {
+	case entity.OutboxUserActivityChanged:
+		var payload entity.UserActivityChanged
+		if err := json.Unmarshal(event.Payload, &payload); err != nil {
+			return fmt.Errorf("decode payload: %w", err)
+		}
+		return s.rebalanceReviews(ctx, payload.UserId)
+	case entity.OutboxUserTeamChanged:
+		var payload entity.UserTeamChanged
+		if err := json.Unmarshal(event.Payload, &payload); err != nil {
+			return fmt.Errorf("decode payload: %w", err)
+		}
+		return s.rebalanceReviews(ctx, payload.UserId)
+	case entity.OutboxReviewerReleased:
+		var payload entity.ReviewerReleased
+		if err := json.Unmarshal(event.Payload, &payload); err != nil {
+			return fmt.Errorf("decode payload: %w", err)
+		}
+		return s.rebalanceReviews(ctx, payload.UserId)
+	default:
+		logger(ctx).Warn("Skipping outbox event of unknown type", "event_id", event.Id, "event_type", event.EventType)
+		return nil
+	}
+}
+
+// rebalanceReviews приводит назначения в соответствие с текущей активностью и командой пользователя.
+// Берётся состояние из БД, а не из события, поэтому повторная или запоздалая обработка безопасна.
+func (s *PullRequestService) rebalanceReviews(ctx context.Context, userId string) error {
+	user, err := s.userRepo.GetById(ctx, userId)
+	if err != nil {
+		var appErr *domain.AppError
+		if errors.As(err, &appErr) && appErr.Code == errcodes.NotFound {
+			return nil
+		}
+		return err
+	}
+
+	if user.IsActive {
+		return s.prRepo.AssignToNeedyPRs(ctx, user.Id)
+	}
+
+	return s.prRepo.ReassignFromAllPRs(ctx, user.Id, entity.ReplaceReasonDeactivation, s.selectors.Pick)
 }
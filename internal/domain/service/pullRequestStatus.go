@@ -0,0 +1,117 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"pull_requests_service/internal/domain"
+	"pull_requests_service/internal/domain/entity"
+	"pull_requests_service/pkg/errcodes"
+	"pull_requests_service/pkg/tracing"
+)
+
+// Действия, меняющие статус PR.
+const (
+	actionReady  = "ready"
+	actionMerge  = "merge"
+	actionClose  = "close"
+	actionReopen = "reopen"
+)
+
+// transitions — допустимые переходы статусов PR: DRAFT → OPEN → MERGED,
+// DRAFT и OPEN закрываются, CLOSED открывается заново.
+var transitions = map[string]map[string]string{
+	entity.StatusDraft: {
+		actionReady: entity.StatusOpen,
+		actionClose: entity.StatusClosed,
+	},
+	entity.StatusOpen: {
+		actionMerge: entity.StatusMerged,
+		actionClose: entity.StatusClosed,
+	},
+	entity.StatusClosed: {
+		actionReopen: entity.StatusOpen,
+	},
+	entity.StatusMerged: {
+		// повторное слияние не меняет PR
+		actionMerge: entity.StatusMerged,
+	},
+}
+
+// nextStatus возвращает статус PR после действия или ошибку, если переход недопустим.
+func nextStatus(from, action string) (string, error) {
+	if to, ok := transitions[from][action]; ok {
+		return to, nil
+	}
+	if from == entity.StatusMerged {
+		return "", domain.NewError(errcodes.PrMerged, fmt.Sprintf("cannot %s merged PR", action))
+	}
+	return "", domain.NewError(errcodes.InvalidTransition, fmt.Sprintf("cannot %s PR in status %s", action, from))
+}
+
+// MarkReady переводит черновик в OPEN и назначает ревьюверов, как при создании PR,
+// из команды ревью PR (команды автора или команды, которой PR передан).
+func (s *PullRequestService) MarkReady(ctx context.Context, prId string, changedPaths []string) (entity.PullRequest, error) {
+	ctx, span := tracing.Tracer().Start(ctx, "PullRequestService.MarkReady")
+	defer span.End()
+
+	pr, err := s.prRepo.Get(ctx, prId)
+	if err != nil {
+		return entity.PullRequest{}, err
+	}
+	if _, err = nextStatus(pr.Status, actionReady); err != nil {
+		return entity.PullRequest{}, err
+	}
+
+	pools, err := s.userRepo.GetReviewPools(ctx, prId)
+	if err != nil {
+		return entity.PullRequest{}, poolsError(err)
+	}
+	reviewers, needMore, err := s.pickReviewers(ctx, pools, changedPaths)
+	if err != nil {
+		return entity.PullRequest{}, err
+	}
+
+	return s.prRepo.MarkReady(ctx, prId, reviewers, needMore)
+}
+
+// ClosePullRequest закрывает PR без слияния. Ревьюверы снимаются, воркер событий
+// добирает их на PR, где ревьюверов не хватает.
+func (s *PullRequestService) ClosePullRequest(ctx context.Context, prId string) (entity.PullRequest, error) {
+	ctx, span := tracing.Tracer().Start(ctx, "PullRequestService.ClosePullRequest")
+	defer span.End()
+
+	pr, err := s.prRepo.Get(ctx, prId)
+	if err != nil {
+		return entity.PullRequest{}, err
+	}
+	if _, err = nextStatus(pr.Status, actionClose); err != nil {
+		return entity.PullRequest{}, err
+	}
+
+	return s.prRepo.Close(ctx, prId, pr.Status)
+}
+
+// ReopenPullRequest открывает закрытый PR заново с новым подбором ревьюверов.
+func (s *PullRequestService) ReopenPullRequest(ctx context.Context, prId string) (entity.PullRequest, error) {
+	ctx, span := tracing.Tracer().Start(ctx, "PullRequestService.ReopenPullRequest")
+	defer span.End()
+
+	pr, err := s.prRepo.Get(ctx, prId)
+	if err != nil {
+		return entity.PullRequest{}, err
+	}
+	if _, err = nextStatus(pr.Status, actionReopen); err != nil {
+		return entity.PullRequest{}, err
+	}
+
+	pools, err := s.userRepo.GetReviewPools(ctx, prId)
+	if err != nil {
+		return entity.PullRequest{}, poolsError(err)
+	}
+	reviewers, needMore, err := s.pickReviewers(ctx, pools, nil)
+	if err != nil {
+		return entity.PullRequest{}, err
+	}
+
+	return s.prRepo.Reopen(ctx, prId, reviewers, needMore)
+}
@@ -0,0 +1,184 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"pull_requests_service/internal/domain"
+	"pull_requests_service/internal/domain/entity"
+	"pull_requests_service/pkg/errcodes"
+)
+
+func TestNextStatus(t *testing.T) {
+	tests := []struct {
+		from, action string
+		want         string
+		code         string
+	}{
+		{from: entity.StatusDraft, action: actionReady, want: entity.StatusOpen},
+		{from: entity.StatusDraft, action: actionClose, want: entity.StatusClosed},
+		{from: entity.StatusDraft, action: actionMerge, code: string(errcodes.InvalidTransition)},
+		{from: entity.StatusOpen, action: actionMerge, want: entity.StatusMerged},
+		{from: entity.StatusOpen, action: actionClose, want: entity.StatusClosed},
+		{from: entity.StatusOpen, action: actionReady, code: string(errcodes.InvalidTransition)},
+		{from: entity.StatusClosed, action: actionReopen, want: entity.StatusOpen},
+		{from: entity.StatusClosed, action: actionClose, code: string(errcodes.InvalidTransition)},
+		{from: entity.StatusClosed, action: actionMerge, code: string(errcodes.InvalidTransition)},
+		{from: entity.StatusMerged, action: actionMerge, want: entity.StatusMerged},
+		{from: entity.StatusMerged, action: actionReopen, code: string(errcodes.PrMerged)},
+		{from: entity.StatusMerged, action: actionClose, code: string(errcodes.PrMerged)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.from+"/"+tt.action, func(t *testing.T) {
+			rq := require.New(t)
+
+			got, err := nextStatus(tt.from, tt.action)
+			if tt.code == "" {
+				rq.NoError(err)
+				rq.Equal(tt.want, got)
+				return
+			}
+
+			var appErr *domain.AppError
+			rq.ErrorAs(err, &appErr)
+			rq.Equal(tt.code, string(appErr.Code))
+		})
+	}
+}
+
+type statusPRRepo struct {
+	PullRequestRepository
+	pr     entity.PullRequest
+	closed []string
+	merged []bool
+}
+
+func (r *statusPRRepo) Merge(_ context.Context, _ string, requireApprovals bool) (entity.PullRequest, error) {
+	r.merged = append(r.merged, requireApprovals)
+	return r.pr, nil
+}
+
+func (r *statusPRRepo) Get(_ context.Context, _ string) (entity.PullRequest, error) {
+	return r.pr, nil
+}
+
+func (r *statusPRRepo) Close(_ context.Context, prId, from string) (entity.PullRequest, error) {
+	r.closed = append(r.closed, from)
+	return entity.PullRequest{Id: prId, Status: entity.StatusClosed}, nil
+}
+
+func TestClosePullRequestChecksTransition(t *testing.T) {
+	rq := require.New(t)
+
+	prRepo := &statusPRRepo{pr: entity.PullRequest{Id: "pr-1", Status: entity.StatusMerged}}
+	svc := NewPullRequestService(nil, nil, prRepo, nil, NewReviewerSelectors(), OutboxOptions{})
+
+	_, err := svc.ClosePullRequest(context.Background(), "pr-1")
+	var appErr *domain.AppError
+	rq.ErrorAs(err, &appErr)
+	rq.Equal(errcodes.PrMerged, appErr.Code)
+	rq.Empty(prRepo.closed)
+
+	prRepo.pr.Status = entity.StatusDraft
+	pr, err := svc.ClosePullRequest(context.Background(), "pr-1")
+	rq.NoError(err)
+	rq.Equal(entity.StatusClosed, pr.Status)
+	rq.Equal([]string{entity.StatusDraft}, prRepo.closed)
+}
+
+func TestMergeChecksTransition(t *testing.T) {
+	rq := require.New(t)
+
+	prRepo := &statusPRRepo{pr: entity.PullRequest{Id: "pr-1", Status: entity.StatusClosed}}
+	svc := NewPullRequestService(nil, nil, prRepo, nil, NewReviewerSelectors(), OutboxOptions{})
+
+	_, err := svc.Merge(context.Background(), "pr-1")
+	var appErr *domain.AppError
+	rq.ErrorAs(err, &appErr)
+	rq.Equal(errcodes.InvalidTransition, appErr.Code)
+	rq.Empty(prRepo.merged)
+
+	// слитый PR передаётся репозиторию, который вернёт его без изменений
+	prRepo.pr.Status = entity.StatusMerged
+	pr, err := svc.Merge(context.Background(), "pr-1")
+	rq.NoError(err)
+	rq.Equal(entity.StatusMerged, pr.Status)
+	rq.Equal([]bool{true}, prRepo.merged)
+}
+
+func (r *statusPRRepo) Reopen(_ context.Context, prId string, reviewers []entity.PickedReviewer, needMore bool) (
+	entity.PullRequest, error) {
+
+	return entity.PullRequest{Id: prId, Status: entity.StatusOpen, AssignedReviewers: entity.ReviewerIds(reviewers),
+		NeedMoreReviewers: needMore}, nil
+}
+
+type reviewPoolsUserRepo struct {
+	UserRepository
+	pools map[string][]entity.CandidatePool
+}
+
+func (r *reviewPoolsUserRepo) GetReviewPools(_ context.Context, prID string) ([]entity.CandidatePool, error) {
+	return r.pools[prID], nil
+}
+
+func TestReopenPicksFromReviewPools(t *testing.T) {
+	rq := require.New(t)
+
+	// PR передан команде platform при архивации команды автора, ревьюверы берутся из её пулов
+	userRepo := &reviewPoolsUserRepo{pools: map[string][]entity.CandidatePool{
+		"pr-1": {{
+			Team:       entity.Team{Name: "platform", ReviewerStrategy: entity.StrategyRandom, RequiredReviewers: 2},
+			Candidates: []entity.ReviewerCandidate{{UserId: "u5", Seniority: 1}},
+		}},
+	}}
+	prRepo := &statusPRRepo{pr: entity.PullRequest{Id: "pr-1", AuthorId: "u1", Status: entity.StatusClosed}}
+	svc := NewPullRequestService(userRepo, nil, prRepo, nil, NewReviewerSelectors(), OutboxOptions{})
+
+	pr, err := svc.ReopenPullRequest(context.Background(), "pr-1")
+	rq.NoError(err)
+	rq.Equal([]string{"u5"}, pr.AssignedReviewers)
+	rq.True(pr.NeedMoreReviewers)
+}
+
+type deliveryIntegrationRepo struct {
+	IntegrationRepository
+	delivered []string
+}
+
+func (r *deliveryIntegrationRepo) IsDelivered(context.Context, string, string) (bool, error) {
+	return false, nil
+}
+
+func (r *deliveryIntegrationRepo) MarkDelivered(_ context.Context, _, deliveryId string) error {
+	r.delivered = append(r.delivered, deliveryId)
+	return nil
+}
+
+func TestVCSMergeSkipsApprovals(t *testing.T) {
+	rq := require.New(t)
+
+	prRepo := &statusPRRepo{pr: entity.PullRequest{Id: "github:acme/api#7", Status: entity.StatusOpen}}
+	prService := NewPullRequestService(nil, nil, prRepo, nil, NewReviewerSelectors(), OutboxOptions{})
+	integrationRepo := &deliveryIntegrationRepo{}
+	svc := NewIntegrationService(integrationRepo, prService)
+
+	// PR уже слит в VCS, поэтому требование одобрений команды к нему не применяется
+	err := svc.HandlePullRequestEvent(context.Background(), entity.VCSPullRequestEvent{
+		Provider:   entity.ProviderGitHub,
+		DeliveryId: "d-1",
+		Action:     entity.VCSActionMerged,
+		Repository: "acme/api",
+		Number:     7,
+	})
+	rq.NoError(err)
+	rq.Equal([]bool{false}, prRepo.merged)
+	rq.Equal([]string{"d-1"}, integrationRepo.delivered)
+
+	_, err = prService.Merge(context.Background(), "github:acme/api#7")
+	rq.NoError(err)
+	rq.Equal([]bool{false, true}, prRepo.merged)
+}
@@ -0,0 +1,136 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"pull_requests_service/internal/domain"
+	"pull_requests_service/internal/domain/entity"
+	"pull_requests_service/pkg/errcodes"
+	"pull_requests_service/pkg/pagination"
+)
+
+// pagedPRRepo отдаёт назначения как keyset-запрос: по убыванию (assigned_at, id), строго после курсора.
+type pagedPRRepo struct {
+	PullRequestRepository
+	reviews []entity.ReviewAssignment
+	filters []entity.UserReviewsFilter
+}
+
+func (r *pagedPRRepo) GetUserReviews(_ context.Context, filter entity.UserReviewsFilter) ([]entity.ReviewAssignment, error) {
+	r.filters = append(r.filters, filter)
+
+	var page []entity.ReviewAssignment
+	for _, review := range r.reviews {
+		if filter.Status != "" && review.Status != filter.Status {
+			continue
+		}
+		if filter.After != nil && !review.AssignedAt.Before(filter.After.At) &&
+			!(review.AssignedAt.Equal(filter.After.At) && review.Id < filter.After.ID) {
+			continue
+		}
+		if len(page) == filter.Limit {
+			break
+		}
+		page = append(page, review)
+	}
+	return page, nil
+}
+
+func TestGetUserReviewsPaginates(t *testing.T) {
+	rq := require.New(t)
+
+	start := time.Date(2025, 10, 1, 12, 0, 0, 0, time.UTC)
+	repo := &pagedPRRepo{}
+	// два назначения в одну секунду проверяют сравнение по id внутри одного assigned_at
+	for i, at := range []time.Duration{5, 4, 3, 3, 1} {
+		status := entity.StatusOpen
+		if i == 1 {
+			status = entity.StatusMerged
+		}
+		repo.reviews = append(repo.reviews, entity.ReviewAssignment{
+			PullRequest: entity.PullRequest{Id: fmt.Sprintf("pr-%d", 9-i), Status: status},
+			AssignedAt:  start.Add(at * time.Second),
+		})
+	}
+	svc := NewPullRequestService(nil, nil, repo, nil, NewReviewerSelectors(), OutboxOptions{})
+
+	var ids []string
+	cursor := ""
+	for pages := 0; ; pages++ {
+		rq.Less(pages, 3)
+		reviews, next, err := svc.GetUserReviews(context.Background(), entity.UserReviewsFilter{UserId: "u1", Limit: 2}, cursor)
+		rq.NoError(err)
+		rq.LessOrEqual(len(reviews), 2)
+		for _, review := range reviews {
+			ids = append(ids, review.Id)
+		}
+		if next == "" {
+			break
+		}
+
+		// курсор указывает на последний элемент отданной страницы
+		decoded, err := pagination.Decode(next)
+		rq.NoError(err)
+		rq.Equal(reviews[len(reviews)-1].Id, decoded.ID)
+		rq.True(reviews[len(reviews)-1].AssignedAt.Equal(decoded.At))
+		cursor = next
+	}
+	rq.Equal([]string{"pr-9", "pr-8", "pr-7", "pr-6", "pr-5"}, ids)
+	// репозиторий просят на одну запись больше страницы, чтобы понять, есть ли следующая
+	rq.Equal(3, repo.filters[0].Limit)
+
+	reviews, next, err := svc.GetUserReviews(context.Background(),
+		entity.UserReviewsFilter{UserId: "u1", Status: entity.StatusOpen}, "")
+	rq.NoError(err)
+	rq.Empty(next)
+	rq.Len(reviews, 4)
+	rq.Equal(entity.StatusOpen, repo.filters[len(repo.filters)-1].Status)
+}
+
+func TestGetUserReviewsValidatesPage(t *testing.T) {
+	repo := &pagedPRRepo{}
+	svc := NewPullRequestService(nil, nil, repo, nil, NewReviewerSelectors(), OutboxOptions{})
+
+	tests := []struct {
+		name   string
+		limit  int
+		cursor string
+		valid  bool
+	}{
+		{name: "default limit", valid: true},
+		{name: "min limit", limit: 1, valid: true},
+		{name: "max limit", limit: maxPageSize, valid: true},
+		{name: "negative limit", limit: -1},
+		{name: "limit above max", limit: maxPageSize + 1},
+		{name: "not base64 cursor", cursor: "%%%"},
+		{name: "cursor without id", cursor: pagination.Cursor{At: time.Now()}.Encode()},
+		{name: "valid cursor", cursor: pagination.Cursor{At: time.Now(), ID: "pr-1"}.Encode(), valid: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rq := require.New(t)
+
+			repo.filters = nil
+			_, _, err := svc.GetUserReviews(context.Background(),
+				entity.UserReviewsFilter{UserId: "u1", Limit: tt.limit}, tt.cursor)
+			if tt.valid {
+				rq.NoError(err)
+				rq.Len(repo.filters, 1)
+				return
+			}
+			var appErr *domain.AppError
+			rq.ErrorAs(err, &appErr)
+			rq.Equal(errcodes.InvalidArgument, appErr.Code)
+			rq.Empty(repo.filters)
+		})
+	}
+
+	_, _, err := svc.GetUserReviews(context.Background(), entity.UserReviewsFilter{UserId: "u1"}, "")
+	require.NoError(t, err)
+	require.Equal(t, defaultPageSize+1, repo.filters[0].Limit)
+}
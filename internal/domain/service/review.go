@@ -0,0 +1,22 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"pull_requests_service/internal/domain"
+	"pull_requests_service/internal/domain/entity"
+	"pull_requests_service/pkg/errcodes"
+	"pull_requests_service/pkg/tracing"
+)
+
+// SubmitReview сохраняет ревью текущего ревьювера: одобрение, запрос изменений или комментарий.
+func (s *PullRequestService) SubmitReview(ctx context.Context, prId string, review entity.Review) (entity.PullRequest, error) {
+	ctx, span := tracing.Tracer().Start(ctx, "PullRequestService.SubmitReview")
+	defer span.End()
+
+	if !entity.IsSubmittedReviewState(review.State) {
+		return entity.PullRequest{}, domain.NewError(errcodes.InvalidArgument, fmt.Sprintf("unknown review state '%s'", review.State))
+	}
+
+	return s.prRepo.SubmitReview(ctx, prId, review)
+}
@@ -0,0 +1,41 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"pull_requests_service/internal/domain"
+	"pull_requests_service/internal/domain/entity"
+	"pull_requests_service/pkg/errcodes"
+)
+
+type reviewPRRepo struct {
+	PullRequestRepository
+	submitted []entity.Review
+}
+
+func (r *reviewPRRepo) SubmitReview(_ context.Context, prId string, review entity.Review) (entity.PullRequest, error) {
+	r.submitted = append(r.submitted, review)
+	return entity.PullRequest{Id: prId, Reviews: []entity.Review{review}}, nil
+}
+
+func TestSubmitReviewValidatesState(t *testing.T) {
+	rq := require.New(t)
+
+	prRepo := &reviewPRRepo{}
+	svc := NewPullRequestService(nil, nil, prRepo, nil, NewReviewerSelectors(), OutboxOptions{})
+
+	for _, state := range []string{"", entity.ReviewPending, "lgtm"} {
+		_, err := svc.SubmitReview(context.Background(), "pr-1", entity.Review{ReviewerId: "u2", State: state})
+		var appErr *domain.AppError
+		rq.ErrorAs(err, &appErr)
+		rq.Equal(errcodes.InvalidArgument, appErr.Code)
+	}
+	rq.Empty(prRepo.submitted)
+
+	pr, err := svc.SubmitReview(context.Background(), "pr-1", entity.Review{ReviewerId: "u2", State: entity.ReviewApproved})
+	rq.NoError(err)
+	rq.Equal(1, entity.CountApprovals(pr.Reviews))
+}
@@ -0,0 +1,148 @@
+package service
+
+import (
+	"math/rand/v2"
+	"pull_requests_service/internal/domain/entity"
+	"slices"
+	"strings"
+)
+
+// ReviewerSelector выбирает до n ревьюверов из списка кандидатов.
+type ReviewerSelector interface {
+	Select(candidates []entity.ReviewerCandidate, n int) []string
+}
+
+// RandomSelector — равновероятный выбор, поведение по умолчанию.
+type RandomSelector struct {
+	intN func(n int) int
+}
+
+func (s RandomSelector) Select(candidates []entity.ReviewerCandidate, n int) []string {
+	pool := slices.Clone(candidates)
+	n = min(n, len(pool))
+
+	for i := 0; i < n; i++ {
+		j := i + s.intN(len(pool)-i)
+		pool[i], pool[j] = pool[j], pool[i]
+	}
+
+	return candidateIds(pool[:n])
+}
+
+// LeastLoadedSelector выбирает тех, у кого меньше всего открытых ревью.
+type LeastLoadedSelector struct{}
+
+func (LeastLoadedSelector) Select(candidates []entity.ReviewerCandidate, n int) []string {
+	pool := slices.Clone(candidates)
+	slices.SortFunc(pool, func(a, b entity.ReviewerCandidate) int {
+		if a.OpenReviews != b.OpenReviews {
+			return a.OpenReviews - b.OpenReviews
+		}
+		return compareByLastAssignment(a, b)
+	})
+
+	return candidateIds(pool[:min(n, len(pool))])
+}
+
+// RoundRobinSelector выбирает тех, кого дольше всех не назначали.
+// Очередь по команде восстанавливается из времени последнего назначения,
+// поэтому отдельного состояния не требуется.
+type RoundRobinSelector struct{}
+
+func (RoundRobinSelector) Select(candidates []entity.ReviewerCandidate, n int) []string {
+	pool := slices.Clone(candidates)
+	slices.SortFunc(pool, compareByLastAssignment)
+
+	return candidateIds(pool[:min(n, len(pool))])
+}
+
+// SeniorityWeightedSelector — случайный выбор без повторов с весом, равным seniority.
+type SeniorityWeightedSelector struct {
+	intN func(n int) int
+}
+
+func (s SeniorityWeightedSelector) Select(candidates []entity.ReviewerCandidate, n int) []string {
+	pool := slices.Clone(candidates)
+	selected := make([]string, 0, min(n, len(pool)))
+
+	for len(selected) < n && len(pool) > 0 {
+		total := 0
+		for _, c := range pool {
+			total += max(c.Seniority, 1)
+		}
+
+		point := s.intN(total)
+		for i, c := range pool {
+			point -= max(c.Seniority, 1)
+			if point < 0 {
+				selected = append(selected, c.UserId)
+				pool = slices.Delete(pool, i, i+1)
+				break
+			}
+		}
+	}
+
+	return selected
+}
+
+// ReviewerSelectors сопоставляет стратегию команды с реализацией ReviewerSelector.
+type ReviewerSelectors struct {
+	byStrategy map[string]ReviewerSelector
+}
+
+func NewReviewerSelectors() *ReviewerSelectors {
+	return newReviewerSelectors(rand.IntN)
+}
+
+func newReviewerSelectors(intN func(n int) int) *ReviewerSelectors {
+	return &ReviewerSelectors{
+		byStrategy: map[string]ReviewerSelector{
+			entity.StrategyRandom:            RandomSelector{intN: intN},
+			entity.StrategyLeastLoaded:       LeastLoadedSelector{},
+			entity.StrategyRoundRobin:        RoundRobinSelector{},
+			entity.StrategySeniorityWeighted: SeniorityWeightedSelector{intN: intN},
+		},
+	}
+}
+
+// Supports сообщает, известна ли стратегия.
+func (s *ReviewerSelectors) Supports(strategy string) bool {
+	_, ok := s.byStrategy[strategy]
+	return ok
+}
+
+// Pick реализует entity.ReviewerPicker: неизвестная стратегия трактуется как random.
+func (s *ReviewerSelectors) Pick(team entity.Team, candidates []entity.ReviewerCandidate, n int) []string {
+	if n <= 0 || len(candidates) == 0 {
+		return nil
+	}
+
+	selector, ok := s.byStrategy[team.ReviewerStrategy]
+	if !ok {
+		selector = s.byStrategy[entity.StrategyRandom]
+	}
+
+	return selector.Select(candidates, n)
+}
+
+func compareByLastAssignment(a, b entity.ReviewerCandidate) int {
+	switch {
+	case a.LastAssignedAt == nil && b.LastAssignedAt != nil:
+		return -1
+	case a.LastAssignedAt != nil && b.LastAssignedAt == nil:
+		return 1
+	case a.LastAssignedAt != nil && !a.LastAssignedAt.Equal(*b.LastAssignedAt):
+		return a.LastAssignedAt.Compare(*b.LastAssignedAt)
+	}
+
+	return strings.Compare(a.UserId, b.UserId)
+}
+
+func candidateIds(candidates []entity.ReviewerCandidate) []string {
+	ids := make([]string, len(candidates))
+	for i, c := range candidates {
+		ids[i] = c.UserId
+	}
+
+	return ids
+}
@@ -0,0 +1,91 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"pull_requests_service/internal/domain/entity"
+)
+
+func TestReviewerSelectors(t *testing.T) {
+	rq := require.New(t)
+
+	now := time.Now()
+	hourAgo := now.Add(-time.Hour)
+
+	candidates := []entity.ReviewerCandidate{
+		{UserId: "u1", Seniority: 1, OpenReviews: 3, LastAssignedAt: &now},
+		{UserId: "u2", Seniority: 5, OpenReviews: 0, LastAssignedAt: &hourAgo},
+		{UserId: "u3", Seniority: 1, OpenReviews: 1, LastAssignedAt: nil},
+	}
+
+	// всегда выбираем первый доступный вариант
+	selectors := newReviewerSelectors(func(int) int { return 0 })
+
+	team := func(strategy string) entity.Team {
+		return entity.Team{Name: "backend", ReviewerStrategy: strategy}
+	}
+
+	rq.Equal([]string{"u1", "u2"}, selectors.Pick(team(entity.StrategyRandom), candidates, 2))
+	rq.Equal([]string{"u2", "u3"}, selectors.Pick(team(entity.StrategyLeastLoaded), candidates, 2))
+	rq.Equal([]string{"u3", "u2"}, selectors.Pick(team(entity.StrategyRoundRobin), candidates, 2))
+	rq.Equal([]string{"u1", "u2", "u3"}, selectors.Pick(team(entity.StrategySeniorityWeighted), candidates, 5))
+
+	// unknown strategy falls back to random
+	rq.Equal([]string{"u1"}, selectors.Pick(team("unknown"), candidates, 1))
+
+	rq.Empty(selectors.Pick(team(entity.StrategyRandom), nil, 2))
+	rq.Empty(selectors.Pick(team(entity.StrategyRandom), candidates, 0))
+
+	// входной срез не должен меняться
+	rq.Equal("u1", candidates[0].UserId)
+	rq.True(selectors.Supports(entity.StrategyRoundRobin))
+	rq.False(selectors.Supports("unknown"))
+}
+
+func TestSeniorityWeightedSelector(t *testing.T) {
+	rq := require.New(t)
+
+	candidates := []entity.ReviewerCandidate{
+		{UserId: "junior", Seniority: 1},
+		{UserId: "senior", Seniority: 9},
+	}
+
+	// точка 1 попадает за пределы веса junior (1) и должна выбрать senior
+	selector := SeniorityWeightedSelector{intN: func(int) int { return 1 }}
+	rq.Equal([]string{"senior"}, selector.Select(candidates, 1))
+
+	selector = SeniorityWeightedSelector{intN: func(int) int { return 0 }}
+	rq.Equal([]string{"junior"}, selector.Select(candidates, 1))
+}
+
+func TestPickFromPools(t *testing.T) {
+	rq := require.New(t)
+
+	selectors := newReviewerSelectors(func(int) int { return 0 })
+	pools := []entity.CandidatePool{
+		{Team: entity.Team{Name: "payments", ReviewerStrategy: entity.StrategyRandom}, Candidates: []entity.ReviewerCandidate{
+			{UserId: "u1", Seniority: 1},
+		}},
+		{Team: entity.Team{Name: "backend", ReviewerStrategy: entity.StrategyLeastLoaded}, Candidates: []entity.ReviewerCandidate{
+			{UserId: "u2", Seniority: 1, OpenReviews: 4},
+			{UserId: "u3", Seniority: 1, OpenReviews: 0},
+		}},
+		{Team: entity.Team{Name: "pool", ReviewerStrategy: entity.StrategyRandom}, Candidates: []entity.ReviewerCandidate{
+			{UserId: "u4", Seniority: 1},
+		}},
+	}
+
+	// своя команда первой, недостающие — из резервной по её стратегии, следующий пул не нужен
+	picked := entity.PickFromPools(pools, 2, selectors.Pick)
+	rq.Equal([]entity.PickedReviewer{
+		{ReviewerId: "u1", SourceTeam: "payments"},
+		{ReviewerId: "u3", SourceTeam: "backend"},
+	}, picked)
+
+	rq.Len(entity.PickFromPools(pools, 10, selectors.Pick), 4)
+	rq.Empty(entity.PickFromPools(pools, 0, selectors.Pick))
+	rq.Empty(entity.PickFromPools(nil, 2, selectors.Pick))
+}
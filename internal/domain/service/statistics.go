@@ -3,6 +3,7 @@ package service
 import (
 	"context"
 	"pull_requests_service/internal/domain/entity"
+	"pull_requests_service/pkg/tracing"
 )
 
 type StatisticsService struct {
@@ -14,5 +15,16 @@ func NewStatisticsService(userRepo UserRepository) *StatisticsService {
 }
 
 func (s *StatisticsService) GetUserAssignmentStats(ctx context.Context) ([]entity.UserAssignmentStat, error) {
+	ctx, span := tracing.Tracer().Start(ctx, "StatisticsService.GetUserAssignmentStats")
+	defer span.End()
+
 	return s.userRepo.GetUserAssignmentStats(ctx)
 }
+
+// GetWorkload возвращает открытые ревью пользователей против их лимита; пустой teamName — все команды.
+func (s *StatisticsService) GetWorkload(ctx context.Context, teamName string) ([]entity.UserWorkload, error) {
+	ctx, span := tracing.Tracer().Start(ctx, "StatisticsService.GetWorkload")
+	defer span.End()
+
+	return s.userRepo.GetWorkload(ctx, teamName)
+}
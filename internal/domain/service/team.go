@@ -7,27 +7,70 @@ import (
 	"pull_requests_service/internal/domain"
 	"pull_requests_service/internal/domain/entity"
 	"pull_requests_service/pkg/errcodes"
+	"pull_requests_service/pkg/tracing"
+	"slices"
 )
 
 type TeamRepository interface {
-	Create(ctx context.Context, team entity.Team) (entity.Team, error)
+	Create(ctx context.Context, team entity.Team, members []entity.User, pick entity.ReviewerPicker) (entity.Team, []entity.User, error)
 	Get(ctx context.Context, name string) (entity.Team, error)
+	UpdateSettings(ctx context.Context, team entity.Team, pick entity.ReviewerPicker) (entity.Team, error)
+	AddMember(ctx context.Context, teamName string, user entity.User) (entity.User, error)
+	RemoveMember(ctx context.Context, teamName, userId string, pick entity.ReviewerPicker) (entity.MembershipChange, error)
+	MoveMember(ctx context.Context, userId, teamName string, pick entity.ReviewerPicker) (entity.MembershipChange, error)
+	Archive(ctx context.Context, teamName string, policy entity.TeamRetirePolicy, pick entity.ReviewerPicker) (entity.TeamRetirement, error)
+	Delete(ctx context.Context, teamName string, policy entity.TeamRetirePolicy, pick entity.ReviewerPicker) (entity.TeamRetirement, error)
+	SetCodeOwners(ctx context.Context, owners entity.CodeOwners) (entity.CodeOwners, error)
+	GetCodeOwners(ctx context.Context, teamName string) (entity.CodeOwners, error)
 }
 
 type TeamService struct {
-	teamRepo TeamRepository
-	userRepo UserRepository
+	teamRepo  TeamRepository
+	userRepo  UserRepository
+	selectors *ReviewerSelectors
 }
 
-func NewTeamService(teamRepo TeamRepository, userRepo UserRepository) *TeamService {
+func NewTeamService(teamRepo TeamRepository, userRepo UserRepository, selectors *ReviewerSelectors) *TeamService {
 	return &TeamService{
-		teamRepo: teamRepo,
-		userRepo: userRepo,
+		teamRepo:  teamRepo,
+		userRepo:  userRepo,
+		selectors: selectors,
 	}
 }
 
 func (s *TeamService) TeamCreate(ctx context.Context, team entity.Team, users []entity.User) (entity.Team, []entity.User, error) {
-	createdTeam, err := s.teamRepo.Create(ctx, team)
+	ctx, span := tracing.Tracer().Start(ctx, "TeamService.TeamCreate")
+	defer span.End()
+
+	if team.ReviewerStrategy == "" {
+		team.ReviewerStrategy = entity.StrategyRandom
+	}
+	if team.RequiredReviewers == 0 {
+		team.RequiredReviewers = entity.DefaultRequiredReviewers
+	}
+	if team.FallbackTeams == nil {
+		team.FallbackTeams = []string{}
+	}
+	if err := s.validateSettings(team); err != nil {
+		return entity.Team{}, nil, err
+	}
+	// участников проверяем до создания команды, чтобы не оставить её без части состава
+	for i := range users {
+		if users[i].Seniority == 0 {
+			users[i].Seniority = 1
+		}
+		if users[i].Seniority < 1 {
+			return entity.Team{}, nil, domain.NewError(errcodes.InvalidArgument,
+				fmt.Sprintf("seniority of user '%s' must be at least 1", users[i].Id))
+		}
+		if slices.ContainsFunc(users[:i], func(user entity.User) bool { return user.Id == users[i].Id }) {
+			return entity.Team{}, nil, domain.NewError(errcodes.InvalidArgument,
+				fmt.Sprintf("user '%s' is listed twice", users[i].Id))
+		}
+	}
+
+	// участники других команд переводятся в той же транзакции, их ревью там перераспределяются
+	createdTeam, createdUsers, err := s.teamRepo.Create(ctx, team, users, s.selectors.Pick)
 	if err != nil {
 		var appErr *domain.AppError
 		if errors.As(err, &appErr) {
@@ -35,26 +78,14 @@ func (s *TeamService) TeamCreate(ctx context.Context, team entity.Team, users []
 		}
 		return entity.Team{}, nil, domain.WrapError(err, errcodes.InternalServerError, "failed to create team")
 	}
-	createdUsers := make([]entity.User, len(users))
-	for i, user := range users {
-		user.Team = createdTeam.Name
-
-		createdUser, err := s.userRepo.Create(ctx, user)
-		if err != nil {
-			logger(ctx).Error("failed to create user in team creation process", "user_id", user.Id, "error", err)
-			var appErr *domain.AppError
-			if errors.As(err, &appErr) {
-				return entity.Team{}, nil, err
-			}
-			return entity.Team{}, nil, domain.WrapError(err, errcodes.InternalServerError, fmt.Sprintf("failed to create user '%s'", user.Id))
-		}
-		createdUsers[i] = createdUser
-	}
 
 	return createdTeam, createdUsers, nil
 }
 
 func (s *TeamService) TeamGet(ctx context.Context, name string) (entity.Team, []entity.User, error) {
+	ctx, span := tracing.Tracer().Start(ctx, "TeamService.TeamGet")
+	defer span.End()
+
 	team, err := s.teamRepo.Get(ctx, name)
 	if err != nil {
 		var appErr *domain.AppError
@@ -71,3 +102,137 @@ func (s *TeamService) TeamGet(ctx context.Context, name string) (entity.Team, []
 
 	return team, users, nil
 }
+
+func (s *TeamService) UpdateSettings(ctx context.Context, name string, settings entity.TeamSettings) (entity.Team, error) {
+	ctx, span := tracing.Tracer().Start(ctx, "TeamService.UpdateSettings")
+	defer span.End()
+
+	team, err := s.teamRepo.Get(ctx, name)
+	if err != nil {
+		var appErr *domain.AppError
+		if errors.As(err, &appErr) {
+			return entity.Team{}, err
+		}
+		return entity.Team{}, domain.WrapError(err, errcodes.InternalServerError, "failed to get team")
+	}
+
+	if settings.ReviewerStrategy != nil {
+		team.ReviewerStrategy = *settings.ReviewerStrategy
+	}
+	if settings.RequiredReviewers != nil {
+		team.RequiredReviewers = *settings.RequiredReviewers
+	}
+	if settings.FallbackTeams != nil {
+		team.FallbackTeams = *settings.FallbackTeams
+	}
+	if settings.MaxOpenReviews != nil {
+		team.MaxOpenReviews = settings.MaxOpenReviews
+		if *settings.MaxOpenReviews == 0 {
+			team.MaxOpenReviews = nil
+		}
+	}
+	if settings.RequireApprovals != nil {
+		team.RequireApprovals = *settings.RequireApprovals
+	}
+	if err = s.validateSettings(team); err != nil {
+		return entity.Team{}, err
+	}
+
+	return s.teamRepo.UpdateSettings(ctx, team, s.selectors.Pick)
+}
+
+// AddMember добавляет в команду нового пользователя или пользователя без команды.
+func (s *TeamService) AddMember(ctx context.Context, teamName string, user entity.User) (entity.User, error) {
+	ctx, span := tracing.Tracer().Start(ctx, "TeamService.AddMember")
+	defer span.End()
+
+	if user.Seniority == 0 {
+		user.Seniority = 1
+	}
+	if user.Seniority < 1 {
+		return entity.User{}, domain.NewError(errcodes.InvalidArgument, "seniority must be at least 1")
+	}
+
+	return s.teamRepo.AddMember(ctx, teamName, user)
+}
+
+// RemoveMember выводит пользователя из команды; его открытые ревью в ней переназначаются.
+func (s *TeamService) RemoveMember(ctx context.Context, teamName, userId string) (entity.MembershipChange, error) {
+	ctx, span := tracing.Tracer().Start(ctx, "TeamService.RemoveMember")
+	defer span.End()
+
+	return s.teamRepo.RemoveMember(ctx, teamName, userId, s.selectors.Pick)
+}
+
+// MoveMember переводит пользователя в другую команду; его открытые ревью в прежней команде переназначаются.
+func (s *TeamService) MoveMember(ctx context.Context, userId, teamName string) (entity.MembershipChange, error) {
+	ctx, span := tracing.Tracer().Start(ctx, "TeamService.MoveMember")
+	defer span.End()
+
+	return s.teamRepo.MoveMember(ctx, userId, teamName, s.selectors.Pick)
+}
+
+// ArchiveTeam архивирует команду: участники деактивируются, открытые PR их авторства
+// закрываются или передаются на ревью другой команде по policy.
+func (s *TeamService) ArchiveTeam(ctx context.Context, name string, policy entity.TeamRetirePolicy) (
+	entity.TeamRetirement, error) {
+
+	ctx, span := tracing.Tracer().Start(ctx, "TeamService.ArchiveTeam")
+	defer span.End()
+
+	if err := validateRetirePolicy(name, policy); err != nil {
+		return entity.TeamRetirement{}, err
+	}
+
+	return s.teamRepo.Archive(ctx, name, policy, s.selectors.Pick)
+}
+
+// DeleteTeam удаляет команду: участники остаются без команды, открытые PR их авторства
+// закрываются или передаются на ревью другой команде по policy.
+func (s *TeamService) DeleteTeam(ctx context.Context, name string, policy entity.TeamRetirePolicy) (
+	entity.TeamRetirement, error) {
+
+	ctx, span := tracing.Tracer().Start(ctx, "TeamService.DeleteTeam")
+	defer span.End()
+
+	if err := validateRetirePolicy(name, policy); err != nil {
+		return entity.TeamRetirement{}, err
+	}
+
+	return s.teamRepo.Delete(ctx, name, policy, s.selectors.Pick)
+}
+
+func validateRetirePolicy(name string, policy entity.TeamRetirePolicy) error {
+	switch policy.PullRequests {
+	case entity.RetirePolicyClose:
+		return nil
+	case entity.RetirePolicyReassign:
+		if policy.TargetTeam == "" || policy.TargetTeam == name {
+			return domain.NewError(errcodes.InvalidArgument, "reassign policy requires another target_team")
+		}
+		return nil
+	}
+	return domain.NewError(errcodes.InvalidArgument, fmt.Sprintf("unknown pull request policy '%s'", policy.PullRequests))
+}
+
+func (s *TeamService) validateSettings(team entity.Team) error {
+	if !s.selectors.Supports(team.ReviewerStrategy) {
+		return domain.NewError(errcodes.InvalidArgument,
+			fmt.Sprintf("unknown reviewer strategy '%s'", team.ReviewerStrategy))
+	}
+	if team.RequiredReviewers < 1 {
+		return domain.NewError(errcodes.InvalidArgument, "required_reviewers must be at least 1")
+	}
+	if team.MaxOpenReviews != nil && *team.MaxOpenReviews < 1 {
+		return domain.NewError(errcodes.InvalidArgument, "max_open_reviews must be at least 1")
+	}
+	for i, fallback := range team.FallbackTeams {
+		if fallback == team.Name {
+			return domain.NewError(errcodes.InvalidArgument, "team cannot be its own fallback")
+		}
+		if slices.Contains(team.FallbackTeams[:i], fallback) {
+			return domain.NewError(errcodes.InvalidArgument, fmt.Sprintf("fallback team '%s' is listed twice", fallback))
+		}
+	}
+	return nil
+}
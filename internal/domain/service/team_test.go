@@ -0,0 +1,211 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"pull_requests_service/internal/domain"
+	"pull_requests_service/internal/domain/entity"
+	"pull_requests_service/pkg/errcodes"
+)
+
+type fakeTeamRepo struct {
+	TeamRepository
+	members []entity.User
+}
+
+func (r *fakeTeamRepo) Create(_ context.Context, team entity.Team, members []entity.User, _ entity.ReviewerPicker) (
+	entity.Team, []entity.User, error) {
+	r.members = members
+	return team, members, nil
+}
+
+type fakeUserRepo struct {
+	UserRepository
+}
+
+func TestTeamCreatePassesMembersToRepository(t *testing.T) {
+	rq := require.New(t)
+
+	teamRepo := &fakeTeamRepo{}
+	svc := NewTeamService(teamRepo, &fakeUserRepo{}, NewReviewerSelectors())
+
+	team, users, err := svc.TeamCreate(context.Background(), entity.Team{Name: "payments"}, []entity.User{
+		{Id: "u1", Name: "Alice", IsActive: true},
+		{Id: "u2", Name: "Bob", IsActive: true, Seniority: 3},
+	})
+	rq.NoError(err)
+	rq.Equal(entity.StrategyRandom, team.ReviewerStrategy)
+	rq.Len(users, 2)
+	// создание и перевод участников — одна транзакция репозитория
+	rq.Equal([]entity.User{
+		{Id: "u1", Name: "Alice", IsActive: true, Seniority: 1},
+		{Id: "u2", Name: "Bob", IsActive: true, Seniority: 3},
+	}, teamRepo.members)
+}
+
+func TestTeamCreateRejectsDuplicateMembers(t *testing.T) {
+	rq := require.New(t)
+
+	teamRepo := &createCountingTeamRepo{}
+	svc := NewTeamService(teamRepo, &fakeUserRepo{}, NewReviewerSelectors())
+
+	_, _, err := svc.TeamCreate(context.Background(), entity.Team{Name: "payments"}, []entity.User{
+		{Id: "u1", Name: "Alice", IsActive: true},
+		{Id: "u1", Name: "Alice", IsActive: false},
+	})
+	var appErr *domain.AppError
+	rq.ErrorAs(err, &appErr)
+	rq.Equal(errcodes.InvalidArgument, appErr.Code)
+	rq.Zero(teamRepo.created)
+}
+
+func TestTeamCreateRejectsNegativeSeniority(t *testing.T) {
+	rq := require.New(t)
+
+	teamRepo := &createCountingTeamRepo{}
+	svc := NewTeamService(teamRepo, &fakeUserRepo{}, NewReviewerSelectors())
+
+	_, _, err := svc.TeamCreate(context.Background(), entity.Team{Name: "payments"}, []entity.User{
+		{Id: "u1", Name: "Alice", IsActive: true},
+		{Id: "u2", Name: "Bob", IsActive: true, Seniority: -1},
+	})
+	var appErr *domain.AppError
+	rq.ErrorAs(err, &appErr)
+	rq.Equal(errcodes.InvalidArgument, appErr.Code)
+	rq.Zero(teamRepo.created)
+}
+
+type createCountingTeamRepo struct {
+	fakeTeamRepo
+	created int
+}
+
+func (r *createCountingTeamRepo) Create(ctx context.Context, team entity.Team, members []entity.User,
+	pick entity.ReviewerPicker) (entity.Team, []entity.User, error) {
+	r.created++
+	return r.fakeTeamRepo.Create(ctx, team, members, pick)
+}
+
+func TestRetireTeamValidatesPolicy(t *testing.T) {
+	rq := require.New(t)
+
+	svc := NewTeamService(&fakeTeamRepo{}, &fakeUserRepo{}, NewReviewerSelectors())
+
+	policies := []entity.TeamRetirePolicy{
+		{},
+		{PullRequests: "drop"},
+		{PullRequests: entity.RetirePolicyReassign},
+		{PullRequests: entity.RetirePolicyReassign, TargetTeam: "payments"},
+	}
+	for _, policy := range policies {
+		_, err := svc.ArchiveTeam(context.Background(), "payments", policy)
+		var appErr *domain.AppError
+		rq.ErrorAs(err, &appErr)
+		rq.Equal(errcodes.InvalidArgument, appErr.Code)
+
+		_, err = svc.DeleteTeam(context.Background(), "payments", policy)
+		rq.ErrorAs(err, &appErr)
+		rq.Equal(errcodes.InvalidArgument, appErr.Code)
+	}
+}
+
+func TestValidateSettings(t *testing.T) {
+	svc := NewTeamService(&fakeTeamRepo{}, &fakeUserRepo{}, NewReviewerSelectors())
+	zero, limit := 0, 3
+
+	tests := []struct {
+		name  string
+		team  entity.Team
+		valid bool
+	}{
+		{name: "defaults", team: entity.Team{Name: "a", ReviewerStrategy: entity.StrategyRandom, RequiredReviewers: 2},
+			valid: true},
+		{name: "every strategy", team: entity.Team{Name: "a", ReviewerStrategy: entity.StrategySeniorityWeighted,
+			RequiredReviewers: 1, MaxOpenReviews: &limit, FallbackTeams: []string{"b", "c"}}, valid: true},
+		{name: "unknown strategy", team: entity.Team{Name: "a", ReviewerStrategy: "fastest", RequiredReviewers: 2}},
+		{name: "empty strategy", team: entity.Team{Name: "a", RequiredReviewers: 2}},
+		{name: "zero reviewers", team: entity.Team{Name: "a", ReviewerStrategy: entity.StrategyRandom}},
+		{name: "negative reviewers", team: entity.Team{Name: "a", ReviewerStrategy: entity.StrategyRandom,
+			RequiredReviewers: -1}},
+		{name: "zero review limit", team: entity.Team{Name: "a", ReviewerStrategy: entity.StrategyRandom,
+			RequiredReviewers: 2, MaxOpenReviews: &zero}},
+		{name: "own fallback", team: entity.Team{Name: "a", ReviewerStrategy: entity.StrategyRandom,
+			RequiredReviewers: 2, FallbackTeams: []string{"a"}}},
+		{name: "repeated fallback", team: entity.Team{Name: "a", ReviewerStrategy: entity.StrategyRandom,
+			RequiredReviewers: 2, FallbackTeams: []string{"b", "b"}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rq := require.New(t)
+
+			err := svc.validateSettings(tt.team)
+			if tt.valid {
+				rq.NoError(err)
+				return
+			}
+			var appErr *domain.AppError
+			rq.ErrorAs(err, &appErr)
+			rq.Equal(errcodes.InvalidArgument, appErr.Code)
+		})
+	}
+}
+
+type settingsTeamRepo struct {
+	fakeTeamRepo
+	team    entity.Team
+	updated *entity.Team
+	pick    entity.ReviewerPicker
+}
+
+func (r *settingsTeamRepo) Get(_ context.Context, name string) (entity.Team, error) {
+	if name != r.team.Name {
+		return entity.Team{}, domain.NewError(errcodes.NotFound, "team not found")
+	}
+	return r.team, nil
+}
+
+func (r *settingsTeamRepo) UpdateSettings(_ context.Context, team entity.Team, pick entity.ReviewerPicker) (entity.Team, error) {
+	r.updated = &team
+	r.pick = pick
+	return team, nil
+}
+
+func TestUpdateSettingsMergesAndValidates(t *testing.T) {
+	rq := require.New(t)
+
+	limit := 4
+	repo := &settingsTeamRepo{team: entity.Team{Name: "backend", ReviewerStrategy: entity.StrategyRoundRobin,
+		RequiredReviewers: 2, MaxOpenReviews: &limit, FallbackTeams: []string{"platform"}}}
+	svc := NewTeamService(repo, &fakeUserRepo{}, NewReviewerSelectors())
+
+	// требуемое число ревьюверов уходит в репозиторий, который добирает ревьюверов на открытые PR стратегией команды
+	required := 3
+	team, err := svc.UpdateSettings(context.Background(), "backend", entity.TeamSettings{RequiredReviewers: &required})
+	rq.NoError(err)
+	rq.Equal(3, team.RequiredReviewers)
+	rq.Equal(entity.StrategyRoundRobin, repo.updated.ReviewerStrategy)
+	rq.Equal([]string{"platform"}, repo.updated.FallbackTeams)
+	rq.Equal(&limit, repo.updated.MaxOpenReviews)
+	rq.NotNil(repo.pick)
+
+	// 0 снимает лимит ревью
+	zero := 0
+	team, err = svc.UpdateSettings(context.Background(), "backend", entity.TeamSettings{MaxOpenReviews: &zero})
+	rq.NoError(err)
+	rq.Nil(team.MaxOpenReviews)
+
+	repo.updated = nil
+	invalid := 0
+	_, err = svc.UpdateSettings(context.Background(), "backend", entity.TeamSettings{RequiredReviewers: &invalid})
+	var appErr *domain.AppError
+	rq.ErrorAs(err, &appErr)
+	rq.Equal(errcodes.InvalidArgument, appErr.Code)
+	rq.Nil(repo.updated)
+
+	_, err = svc.UpdateSettings(context.Background(), "frontend", entity.TeamSettings{RequiredReviewers: &required})
+	rq.ErrorAs(err, &appErr)
+	rq.Equal(errcodes.NotFound, appErr.Code)
+}
@@ -2,45 +2,55 @@ package service
 
 import (
 	"context"
+	"pull_requests_service/internal/domain"
 	"pull_requests_service/internal/domain/entity"
+	"pull_requests_service/pkg/errcodes"
+	"pull_requests_service/pkg/tracing"
 )
 
 type UserRepository interface {
-	Create(ctx context.Context, user entity.User) (entity.User, error)
 	GetByTeam(ctx context.Context, team string) ([]entity.User, error)
 	SetIsActive(ctx context.Context, userId string, isActive bool) (entity.User, error)
 	GetById(ctx context.Context, userId string) (entity.User, error)
-	GetActiveTeamCandidatesId(ctx context.Context, authorID string) ([]string, error)
+	GetCandidatePools(ctx context.Context, authorID string) ([]entity.CandidatePool, error)
+	GetReviewPools(ctx context.Context, prID string) ([]entity.CandidatePool, error)
 	GetUserAssignmentStats(ctx context.Context) ([]entity.UserAssignmentStat, error)
-}
-
-type PrWorkerJob struct {
-	userId string
-	value  bool
+	SetMaxOpenReviews(ctx context.Context, userId string, maxOpenReviews *int) (entity.UserWorkload, error)
+	GetWorkload(ctx context.Context, teamName string) ([]entity.UserWorkload, error)
 }
 
 type UserService struct {
 	repository UserRepository
-	eventChan  chan<- PrWorkerJob
 }
 
-func NewUserService(repository UserRepository, eventChan chan<- PrWorkerJob) *UserService {
+func NewUserService(repository UserRepository) *UserService {
 	return &UserService{
 		repository: repository,
-		eventChan:  eventChan,
 	}
 }
 
+// SetIsActive меняет активность пользователя. Перераспределение ревью выполняет воркер
+// по событию из outbox, записанному в той же транзакции.
 func (s *UserService) SetIsActive(ctx context.Context, userId string, isActive bool) (entity.User, error) {
-	user, err := s.repository.SetIsActive(ctx, userId, isActive)
-	if err != nil {
-		return entity.User{}, err
+	ctx, span := tracing.Tracer().Start(ctx, "UserService.SetIsActive")
+	defer span.End()
+
+	return s.repository.SetIsActive(ctx, userId, isActive)
+}
+
+// SetMaxOpenReviews задаёт личный лимит открытых ревью; 0 возвращает лимит команды.
+func (s *UserService) SetMaxOpenReviews(ctx context.Context, userId string, maxOpenReviews int) (entity.UserWorkload, error) {
+	ctx, span := tracing.Tracer().Start(ctx, "UserService.SetMaxOpenReviews")
+	defer span.End()
+
+	if maxOpenReviews < 0 {
+		return entity.UserWorkload{}, domain.NewError(errcodes.InvalidArgument, "max_open_reviews must not be negative")
 	}
-	select {
-	case s.eventChan <- PrWorkerJob{userId, isActive}:
-		return user, nil
-	default:
-		logger(ctx).Warn("Failed to publish user status change event: channel is full", "user_id", user.Id)
+
+	var limit *int
+	if maxOpenReviews > 0 {
+		limit = &maxOpenReviews
 	}
-	return user, nil
+
+	return s.repository.SetMaxOpenReviews(ctx, userId, limit)
 }
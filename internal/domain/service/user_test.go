@@ -0,0 +1,48 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"pull_requests_service/internal/domain"
+	"pull_requests_service/internal/domain/entity"
+	"pull_requests_service/pkg/errcodes"
+)
+
+type capacityUserRepo struct {
+	UserRepository
+	limits map[string]*int
+}
+
+func (r *capacityUserRepo) SetMaxOpenReviews(_ context.Context, userId string, maxOpenReviews *int) (
+	entity.UserWorkload, error) {
+	r.limits[userId] = maxOpenReviews
+	return entity.UserWorkload{UserId: userId, OpenReviews: 3, MaxOpenReviews: maxOpenReviews}, nil
+}
+
+func TestSetMaxOpenReviews(t *testing.T) {
+	rq := require.New(t)
+
+	repo := &capacityUserRepo{limits: map[string]*int{}}
+	svc := NewUserService(repo)
+
+	_, err := svc.SetMaxOpenReviews(context.Background(), "u1", -1)
+	var appErr *domain.AppError
+	rq.ErrorAs(err, &appErr)
+	rq.Equal(errcodes.InvalidArgument, appErr.Code)
+	rq.NotContains(repo.limits, "u1")
+
+	workload, err := svc.SetMaxOpenReviews(context.Background(), "u1", 5)
+	rq.NoError(err)
+	available, ok := workload.Available()
+	rq.True(ok)
+	rq.Equal(2, available)
+
+	workload, err = svc.SetMaxOpenReviews(context.Background(), "u1", 0)
+	rq.NoError(err)
+	rq.Nil(repo.limits["u1"])
+	_, ok = workload.Available()
+	rq.False(ok)
+}
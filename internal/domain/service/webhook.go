@@ -0,0 +1,272 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/url"
+	"pull_requests_service/internal/domain"
+	"pull_requests_service/internal/domain/entity"
+	"pull_requests_service/pkg/errcodes"
+	"pull_requests_service/pkg/logx"
+	"pull_requests_service/pkg/pagination"
+	"pull_requests_service/pkg/tracing"
+	"pull_requests_service/pkg/webhook"
+	"slices"
+	"strconv"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type WebhookRepository interface {
+	Create(ctx context.Context, sub entity.WebhookSubscription) (entity.WebhookSubscription, error)
+	Get(ctx context.Context, id int64) (entity.WebhookSubscription, error)
+	List(ctx context.Context) ([]entity.WebhookSubscription, error)
+	Update(ctx context.Context, sub entity.WebhookSubscription) (entity.WebhookSubscription, error)
+	Delete(ctx context.Context, id int64) error
+	ListDeliveries(ctx context.Context, filter entity.WebhookDeliveryFilter) ([]entity.WebhookDelivery, error)
+	ClaimDeliveries(ctx context.Context, limit int, lease time.Duration) ([]entity.WebhookDeliveryJob, error)
+	MarkDelivered(ctx context.Context, id int64, statusCode int) error
+	MarkFailed(ctx context.Context, id int64, statusCode *int, cause string, retryIn time.Duration, final bool) error
+}
+
+// WebhookClient отправляет подписанное событие и возвращает код ответа подписчика.
+type WebhookClient interface {
+	Send(ctx context.Context, url, secret string, msg webhook.Message) (int, error)
+}
+
+// WebhookOptions — параметры воркера доставки.
+type WebhookOptions struct {
+	PollInterval time.Duration
+	BatchSize    int
+	Lease        time.Duration
+	MaxBackoff   time.Duration
+	// MaxAttempts — после стольких неудач доставка помечается failed.
+	MaxAttempts int
+}
+
+type WebhookService struct {
+	repo   WebhookRepository
+	client WebhookClient
+	opts   WebhookOptions
+}
+
+func NewWebhookService(repo WebhookRepository, client WebhookClient, opts WebhookOptions) *WebhookService {
+	return &WebhookService{
+		repo:   repo,
+		client: client,
+		opts:   opts,
+	}
+}
+
+// CreateWebhook создаёт подписку. Если секрет не задан, он генерируется;
+// вернувшийся Secret — единственный раз, когда его можно увидеть.
+func (s *WebhookService) CreateWebhook(ctx context.Context, sub entity.WebhookSubscription) (entity.WebhookSubscription, error) {
+	ctx, span := tracing.Tracer().Start(ctx, "WebhookService.CreateWebhook")
+	defer span.End()
+
+	if err := validateWebhook(sub); err != nil {
+		return entity.WebhookSubscription{}, err
+	}
+
+	if sub.Secret == "" {
+		secret, err := newWebhookSecret()
+		if err != nil {
+			return entity.WebhookSubscription{}, err
+		}
+		sub.Secret = secret
+	}
+	sub.IsActive = true
+
+	created, err := s.repo.Create(ctx, sub)
+	if err != nil {
+		return entity.WebhookSubscription{}, err
+	}
+	created.Secret = sub.Secret
+
+	return created, nil
+}
+
+func (s *WebhookService) ListWebhooks(ctx context.Context) ([]entity.WebhookSubscription, error) {
+	ctx, span := tracing.Tracer().Start(ctx, "WebhookService.ListWebhooks")
+	defer span.End()
+
+	return s.repo.List(ctx)
+}
+
+func (s *WebhookService) UpdateWebhook(ctx context.Context, id int64, settings entity.WebhookSettings) (
+	entity.WebhookSubscription, error) {
+	ctx, span := tracing.Tracer().Start(ctx, "WebhookService.UpdateWebhook")
+	defer span.End()
+
+	sub, err := s.repo.Get(ctx, id)
+	if err != nil {
+		return entity.WebhookSubscription{}, err
+	}
+
+	if settings.Url != nil {
+		sub.Url = *settings.Url
+	}
+	if settings.Events != nil {
+		sub.Events = settings.Events
+	}
+	if settings.IsActive != nil {
+		sub.IsActive = *settings.IsActive
+	}
+	if err = validateWebhook(sub); err != nil {
+		return entity.WebhookSubscription{}, err
+	}
+
+	return s.repo.Update(ctx, sub)
+}
+
+func (s *WebhookService) DeleteWebhook(ctx context.Context, id int64) error {
+	ctx, span := tracing.Tracer().Start(ctx, "WebhookService.DeleteWebhook")
+	defer span.End()
+
+	return s.repo.Delete(ctx, id)
+}
+
+// ListDeliveries возвращает страницу журнала доставок подписки и курсор следующей страницы.
+func (s *WebhookService) ListDeliveries(ctx context.Context, filter entity.WebhookDeliveryFilter, cursor string) (
+	[]entity.WebhookDelivery, string, error) {
+	ctx, span := tracing.Tracer().Start(ctx, "WebhookService.ListDeliveries")
+	defer span.End()
+
+	if _, err := s.repo.Get(ctx, filter.SubscriptionId); err != nil {
+		return nil, "", err
+	}
+
+	pageSize, after, err := parsePage(filter.Limit, cursor)
+	if err != nil {
+		return nil, "", err
+	}
+	filter.Limit = pageSize + 1
+	filter.After = after
+
+	deliveries, err := s.repo.ListDeliveries(ctx, filter)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if len(deliveries) <= pageSize {
+		return deliveries, "", nil
+	}
+
+	deliveries = deliveries[:pageSize]
+	last := deliveries[len(deliveries)-1]
+
+	return deliveries, pagination.Cursor{At: last.CreatedAt, ID: strconv.FormatInt(last.Id, 10)}.Encode(), nil
+}
+
+func validateWebhook(sub entity.WebhookSubscription) error {
+	u, err := url.Parse(sub.Url)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return domain.NewError(errcodes.InvalidArgument, "url must be an absolute http(s) URL")
+	}
+
+	if len(sub.Events) == 0 {
+		return domain.NewError(errcodes.InvalidArgument, "at least one event is required")
+	}
+	for _, event := range sub.Events {
+		if !entity.IsWebhookEvent(event) {
+			return domain.NewError(errcodes.InvalidArgument, fmt.Sprintf("unknown event '%s'", event))
+		}
+	}
+	if len(slices.Compact(slices.Sorted(slices.Values(sub.Events)))) != len(sub.Events) {
+		return domain.NewError(errcodes.InvalidArgument, "events must not repeat")
+	}
+
+	return nil
+}
+
+func newWebhookSecret() (string, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", domain.WrapError(err, errcodes.InternalServerError, "failed to generate webhook secret")
+	}
+
+	return hex.EncodeToString(buf), nil
+}
+
+// StartDeliveryWorker отправляет накопившиеся доставки и повторяет неудачные с экспоненциальной задержкой.
+func (s *WebhookService) StartDeliveryWorker(ctx context.Context) {
+	logger(ctx).Info("Starting webhook delivery worker...")
+
+	ticker := time.NewTicker(s.opts.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		if processed := s.deliverBatch(ctx); processed > 0 && processed == s.opts.BatchSize && ctx.Err() == nil {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			logger(ctx).Info("Stopping webhook delivery worker...")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// deliverBatch отправляет одну пачку доставок и возвращает её размер.
+func (s *WebhookService) deliverBatch(ctx context.Context) int {
+	jobs, err := s.repo.ClaimDeliveries(ctx, s.opts.BatchSize, s.opts.Lease)
+	if err != nil {
+		logger(ctx).Error("Failed to claim webhook deliveries", logx.Error(err))
+		return 0
+	}
+
+	for _, job := range jobs {
+		s.deliver(ctx, job)
+	}
+
+	return len(jobs)
+}
+
+func (s *WebhookService) deliver(ctx context.Context, job entity.WebhookDeliveryJob) {
+	ctx, span := tracing.Tracer().Start(ctx, "WebhookService.deliver", trace.WithAttributes(
+		attribute.Int64("webhook.delivery_id", job.Id),
+		attribute.String("webhook.event", job.EventType),
+	))
+	defer span.End()
+
+	msg := webhook.Message{
+		DeliveryID: strconv.FormatInt(job.Id, 10),
+		Event:      job.EventType,
+		Body:       job.Payload,
+	}
+
+	statusCode, err := s.client.Send(ctx, job.Url, job.Secret, msg)
+	if err == nil {
+		if markErr := s.repo.MarkDelivered(ctx, job.Id, statusCode); markErr != nil {
+			logger(ctx).Error("Failed to mark webhook delivered", logx.Error(markErr), "delivery_id", job.Id)
+		}
+		return
+	}
+	if errors.Is(err, context.Canceled) {
+		// останов сервиса: аренда истечёт, и доставку подхватят снова
+		return
+	}
+
+	tracing.RecordError(span, err)
+
+	var status *int
+	if statusCode != 0 {
+		status = &statusCode
+	}
+	final := job.Attempts >= s.opts.MaxAttempts
+	retryIn := retryBackoff(job.Attempts, s.opts.MaxBackoff)
+
+	logger(ctx).Warn("Webhook delivery failed", logx.Error(err),
+		"delivery_id", job.Id, "event_type", job.EventType, "attempts", job.Attempts, "final", final)
+
+	if markErr := s.repo.MarkFailed(ctx, job.Id, status, err.Error(), retryIn, final); markErr != nil {
+		logger(ctx).Error("Failed to mark webhook delivery failed", logx.Error(markErr), "delivery_id", job.Id)
+	}
+}
@@ -0,0 +1,96 @@
+package service
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"pull_requests_service/internal/domain/entity"
+	"pull_requests_service/pkg/webhook"
+)
+
+// fakeWebhookRepo отдаёт заранее заданные доставки и запоминает результаты.
+type fakeWebhookRepo struct {
+	WebhookRepository
+
+	jobs      []entity.WebhookDeliveryJob
+	delivered map[int64]int
+	failed    map[int64]bool
+}
+
+func (r *fakeWebhookRepo) ClaimDeliveries(context.Context, int, time.Duration) ([]entity.WebhookDeliveryJob, error) {
+	jobs := r.jobs
+	r.jobs = nil
+	return jobs, nil
+}
+
+func (r *fakeWebhookRepo) MarkDelivered(_ context.Context, id int64, statusCode int) error {
+	r.delivered[id] = statusCode
+	return nil
+}
+
+func (r *fakeWebhookRepo) MarkFailed(_ context.Context, id int64, _ *int, _ string, _ time.Duration, final bool) error {
+	r.failed[id] = final
+	return nil
+}
+
+func TestWebhookDelivery(t *testing.T) {
+	rq := require.New(t)
+
+	const secret = "s3cret"
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if r.URL.Path == "/broken" || !webhook.Verify(secret, body, r.Header.Get(webhook.HeaderSignature)) {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	job := func(id int64, path string, attempts int) entity.WebhookDeliveryJob {
+		return entity.WebhookDeliveryJob{
+			WebhookDelivery: entity.WebhookDelivery{
+				Id: id, EventType: entity.EventPullRequestMerged, Payload: []byte(`{}`), Attempts: attempts,
+			},
+			Url:    srv.URL + path,
+			Secret: secret,
+		}
+	}
+
+	repo := &fakeWebhookRepo{
+		jobs:      []entity.WebhookDeliveryJob{job(1, "/ok", 1), job(2, "/broken", 1), job(3, "/broken", 3)},
+		delivered: map[int64]int{},
+		failed:    map[int64]bool{},
+	}
+	svc := NewWebhookService(repo, webhook.NewClient(srv.Client()),
+		WebhookOptions{BatchSize: 10, MaxBackoff: time.Minute, MaxAttempts: 3})
+
+	rq.Equal(3, svc.deliverBatch(context.Background()))
+	rq.Equal(map[int64]int{1: http.StatusOK}, repo.delivered)
+	// вторая доставка будет повторена, у третьей закончились попытки
+	rq.Equal(map[int64]bool{2: false, 3: true}, repo.failed)
+}
+
+func TestValidateWebhook(t *testing.T) {
+	rq := require.New(t)
+
+	valid := entity.WebhookSubscription{Url: "https://ci.example.com/hook", Events: []string{entity.EventPullRequestCreated}}
+	rq.NoError(validateWebhook(valid))
+
+	invalid := []entity.WebhookSubscription{
+		{Url: "ci.example.com/hook", Events: valid.Events},
+		{Url: "ftp://ci.example.com", Events: valid.Events},
+		{Url: valid.Url},
+		{Url: valid.Url, Events: []string{"pr.unknown"}},
+		{Url: valid.Url, Events: []string{entity.EventPullRequestCreated, entity.EventPullRequestCreated}},
+	}
+	for _, sub := range invalid {
+		rq.Error(validateWebhook(sub), sub)
+	}
+}
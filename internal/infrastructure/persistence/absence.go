@@ -0,0 +1,259 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"pull_requests_service/internal/domain"
+	"pull_requests_service/internal/domain/entity"
+	"pull_requests_service/pkg/errcodes"
+	"strconv"
+
+	"github.com/jmoiron/sqlx"
+)
+
+const absenceColumns = `id, user_id, starts_at, ends_at, reason, deactivated, created_at, started_at, finished_at`
+
+// awayCondition отсекает пользователей, которые отсутствуют сейчас или уйдут в ближайшие сутки:
+// новые ревью им не назначаются, чтобы не переназначать их сразу после ухода.
+const awayCondition = `
+      NOT EXISTS (
+          SELECT 1 FROM user_absences a
+          WHERE a.user_id = u.id
+            AND a.finished_at IS NULL
+            AND a.ends_at > NOW()
+            AND a.starts_at <= NOW() + INTERVAL '1 day'
+      )`
+
+type AbsenceRepository struct {
+	db *sqlx.DB
+}
+
+func NewAbsenceRepository(db *sqlx.DB) *AbsenceRepository {
+	return &AbsenceRepository{db: db}
+}
+
+func (r *AbsenceRepository) Create(ctx context.Context, absence entity.Absence) (entity.Absence, error) {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return entity.Absence{}, domain.WrapError(err, errcodes.InternalServerError, "failed to begin transaction")
+	}
+	defer tx.Rollback()
+
+	if _, err = lockUser(ctx, tx, absence.UserId); err != nil {
+		return entity.Absence{}, err
+	}
+
+	query := `
+        INSERT INTO user_absences (user_id, starts_at, ends_at, reason)
+        VALUES ($1, $2, $3, $4)
+        RETURNING ` + absenceColumns
+
+	var created entity.Absence
+	err = tx.GetContext(ctx, &created, query, absence.UserId, absence.StartsAt, absence.EndsAt, absence.Reason)
+	if err != nil {
+		return entity.Absence{}, domain.WrapError(err, errcodes.InternalServerError, "repository: failed to create absence")
+	}
+
+	err = writeAudit(ctx, tx, entity.AuditAbsenceCreate, entity.AuditEntityAbsence, strconv.FormatInt(created.Id, 10), nil, created)
+	if err != nil {
+		return entity.Absence{}, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return entity.Absence{}, domain.WrapError(err, errcodes.InternalServerError, "failed to commit transaction")
+	}
+
+	return created, nil
+}
+
+// List возвращает отсутствия по фильтру в порядке начала.
+func (r *AbsenceRepository) List(ctx context.Context, filter entity.AbsenceFilter) ([]entity.Absence, error) {
+	query := `
+        SELECT ` + absenceColumns + `
+        FROM user_absences
+        WHERE ($1 = '' OR user_id = $1)
+          AND ($2 OR finished_at IS NULL)
+        ORDER BY starts_at, id`
+
+	absences := []entity.Absence{}
+	if err := r.db.SelectContext(ctx, &absences, query, filter.UserId, filter.IncludeFinished); err != nil {
+		return nil, domain.WrapError(err, errcodes.InternalServerError, "repository: failed to list absences")
+	}
+
+	return absences, nil
+}
+
+// Cancel отменяет отсутствие: запланированное просто завершается, начавшееся заканчивается сейчас
+// с возвратом пользователя, как при плановом окончании.
+func (r *AbsenceRepository) Cancel(ctx context.Context, id int64) (entity.Absence, error) {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return entity.Absence{}, domain.WrapError(err, errcodes.InternalServerError, "failed to begin transaction")
+	}
+	defer tx.Rollback()
+
+	var previous entity.Absence
+	err = tx.GetContext(ctx, &previous, `SELECT `+absenceColumns+` FROM user_absences WHERE id = $1 FOR UPDATE`, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return entity.Absence{}, domain.NewError(errcodes.NotFound, fmt.Sprintf("absence with id %d not found", id))
+		}
+		return entity.Absence{}, domain.WrapError(err, errcodes.InternalServerError, "repository: failed to get absence")
+	}
+	if previous.FinishedAt != nil {
+		return entity.Absence{}, domain.NewError(errcodes.InvalidArgument, fmt.Sprintf("absence with id %d is already finished", id))
+	}
+
+	cancelled := previous
+	if previous.StartedAt != nil {
+		err = tx.GetContext(ctx, &cancelled.EndsAt,
+			`UPDATE user_absences SET ends_at = NOW() WHERE id = $1 RETURNING ends_at`, id)
+		if err != nil {
+			return entity.Absence{}, domain.WrapError(err, errcodes.InternalServerError, "repository: failed to cancel absence")
+		}
+	}
+	if _, err = finishAbsence(ctx, tx, cancelled); err != nil {
+		return entity.Absence{}, err
+	}
+
+	err = tx.GetContext(ctx, &cancelled, `SELECT `+absenceColumns+` FROM user_absences WHERE id = $1`, id)
+	if err != nil {
+		return entity.Absence{}, domain.WrapError(err, errcodes.InternalServerError, "repository: failed to get absence")
+	}
+
+	err = writeAudit(ctx, tx, entity.AuditAbsenceCancel, entity.AuditEntityAbsence, strconv.FormatInt(id, 10), previous, cancelled)
+	if err != nil {
+		return entity.Absence{}, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return entity.Absence{}, domain.WrapError(err, errcodes.InternalServerError, "failed to commit transaction")
+	}
+
+	return cancelled, nil
+}
+
+// ApplyDue начинает и завершает наступившие отсутствия. Строки, занятые другими репликами,
+// пропускаются (SKIP LOCKED). Сначала обрабатываются начала: если новое отсутствие идёт встык
+// к заканчивающемуся, пользователь не включается между ними.
+func (r *AbsenceRepository) ApplyDue(ctx context.Context) (entity.AbsenceTransitions, error) {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return entity.AbsenceTransitions{}, domain.WrapError(err, errcodes.InternalServerError, "failed to begin transaction")
+	}
+	defer tx.Rollback()
+
+	transitions := entity.AbsenceTransitions{Deactivated: []string{}, Reactivated: []string{}}
+
+	var starting []entity.Absence
+	err = tx.SelectContext(ctx, &starting, `
+        SELECT `+absenceColumns+`
+        FROM user_absences
+        WHERE started_at IS NULL AND finished_at IS NULL AND starts_at <= NOW() AND ends_at > NOW()
+        ORDER BY starts_at, id
+        FOR UPDATE SKIP LOCKED`)
+	if err != nil {
+		return entity.AbsenceTransitions{}, domain.WrapError(err, errcodes.InternalServerError, "repository: failed to get starting absences")
+	}
+	for _, absence := range starting {
+		deactivated, startErr := startAbsence(ctx, tx, absence)
+		if startErr != nil {
+			return entity.AbsenceTransitions{}, startErr
+		}
+		if deactivated {
+			transitions.Deactivated = append(transitions.Deactivated, absence.UserId)
+		}
+	}
+
+	var ending []entity.Absence
+	err = tx.SelectContext(ctx, &ending, `
+        SELECT `+absenceColumns+`
+        FROM user_absences
+        WHERE finished_at IS NULL AND ends_at <= NOW()
+        ORDER BY ends_at, id
+        FOR UPDATE SKIP LOCKED`)
+	if err != nil {
+		return entity.AbsenceTransitions{}, domain.WrapError(err, errcodes.InternalServerError, "repository: failed to get ending absences")
+	}
+	for _, absence := range ending {
+		reactivated, finishErr := finishAbsence(ctx, tx, absence)
+		if finishErr != nil {
+			return entity.AbsenceTransitions{}, finishErr
+		}
+		if reactivated {
+			transitions.Reactivated = append(transitions.Reactivated, absence.UserId)
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return entity.AbsenceTransitions{}, domain.WrapError(err, errcodes.InternalServerError, "failed to commit transaction")
+	}
+
+	return transitions, nil
+}
+
+// startAbsence выключает пользователя, если он активен. Уже выключенного вручную или другим
+// отсутствием пользователя это отсутствие по окончании не включает.
+func startAbsence(ctx context.Context, tx *sqlx.Tx, absence entity.Absence) (bool, error) {
+	user, err := lockUser(ctx, tx, absence.UserId)
+	if err != nil {
+		return false, err
+	}
+
+	if user.IsActive {
+		if _, err = updateIsActive(ctx, tx, user, false); err != nil {
+			return false, err
+		}
+	}
+
+	_, err = tx.ExecContext(ctx,
+		`UPDATE user_absences SET started_at = NOW(), deactivated = $2 WHERE id = $1`, absence.Id, user.IsActive)
+	if err != nil {
+		return false, domain.WrapError(err, errcodes.InternalServerError, "repository: failed to start absence")
+	}
+
+	return user.IsActive, nil
+}
+
+// finishAbsence завершает отсутствие и включает пользователя, если его выключило это отсутствие.
+// Если у пользователя идёт другое отсутствие, включение передаётся ему.
+func finishAbsence(ctx context.Context, tx *sqlx.Tx, absence entity.Absence) (bool, error) {
+	_, err := tx.ExecContext(ctx, `UPDATE user_absences SET finished_at = NOW() WHERE id = $1`, absence.Id)
+	if err != nil {
+		return false, domain.WrapError(err, errcodes.InternalServerError, "repository: failed to finish absence")
+	}
+	if !absence.Deactivated {
+		return false, nil
+	}
+
+	result, err := tx.ExecContext(ctx, `
+        UPDATE user_absences SET deactivated = TRUE
+        WHERE id = (
+            SELECT id FROM user_absences
+            WHERE user_id = $1 AND id != $2 AND started_at IS NOT NULL AND finished_at IS NULL
+            ORDER BY ends_at DESC, id
+            LIMIT 1
+        )`, absence.UserId, absence.Id)
+	if err != nil {
+		return false, domain.WrapError(err, errcodes.InternalServerError, "repository: failed to hand over absence")
+	}
+	if handedOver, _ := result.RowsAffected(); handedOver > 0 {
+		return false, nil
+	}
+
+	user, err := lockUser(ctx, tx, absence.UserId)
+	if err != nil {
+		return false, err
+	}
+	// пользователя могли включить вручную раньше срока
+	if user.IsActive {
+		return false, nil
+	}
+	if _, err = updateIsActive(ctx, tx, user, true); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
@@ -0,0 +1,127 @@
+package persistence
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"pull_requests_service/internal/domain"
+	"pull_requests_service/internal/domain/entity"
+	"pull_requests_service/pkg/contextx"
+	"pull_requests_service/pkg/errcodes"
+	"strconv"
+	"strings"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// writeAudit добавляет запись в журнал аудита в транзакции самого изменения.
+// Автор и trace id берутся из контекста; nil в before/after означает отсутствие сущности.
+func writeAudit(ctx context.Context, tx sqlx.ExecerContext, operation, entityType, entityId string, before, after any) error {
+	actor := entity.AuditActorSystem
+	if userID, err := contextx.UserIDFromContext(ctx); err == nil {
+		actor = userID.String()
+	}
+
+	var traceID *string
+	if id, err := contextx.TraceIDFromContext(ctx); err == nil {
+		value := id.String()
+		traceID = &value
+	}
+
+	beforeJSON, err := auditSnapshot(before)
+	if err != nil {
+		return err
+	}
+	afterJSON, err := auditSnapshot(after)
+	if err != nil {
+		return err
+	}
+
+	const query = `
+        INSERT INTO audit_events (actor, operation, entity_type, entity_id, before, after, trace_id)
+        VALUES ($1, $2, $3, $4, $5::jsonb, $6::jsonb, $7)`
+
+	_, err = tx.ExecContext(ctx, query, actor, operation, entityType, entityId, beforeJSON, afterJSON, traceID)
+	if err != nil {
+		return domain.WrapError(err, errcodes.InternalServerError, "repository: failed to write audit event")
+	}
+
+	return nil
+}
+
+func auditSnapshot(v any) (any, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, domain.WrapError(err, errcodes.InternalServerError, "repository: failed to marshal audit snapshot")
+	}
+
+	return string(data), nil
+}
+
+type AuditRepository struct {
+	db *sqlx.DB
+}
+
+func NewAuditRepository(db *sqlx.DB) *AuditRepository {
+	return &AuditRepository{db: db}
+}
+
+// List возвращает записи журнала по фильтру от новых к старым, начиная строго после filter.After.
+func (r *AuditRepository) List(ctx context.Context, filter entity.AuditFilter) ([]entity.AuditEvent, error) {
+	query, args, err := auditListQuery(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	var events []entity.AuditEvent
+	if err = r.db.SelectContext(ctx, &events, query, args...); err != nil {
+		return nil, domain.WrapError(err, errcodes.InternalServerError, "repository: failed to list audit events")
+	}
+
+	return events, nil
+}
+
+func auditListQuery(filter entity.AuditFilter) (string, []any, error) {
+	var (
+		conditions []string
+		args       []any
+	)
+	arg := func(v any) string {
+		args = append(args, v)
+		return fmt.Sprintf("$%d", len(args))
+	}
+
+	if filter.EntityType != "" {
+		conditions = append(conditions, "entity_type = "+arg(filter.EntityType))
+	}
+	if filter.EntityId != "" {
+		conditions = append(conditions, "entity_id = "+arg(filter.EntityId))
+	}
+	if filter.From != nil {
+		conditions = append(conditions, "created_at >= "+arg(*filter.From))
+	}
+	if filter.To != nil {
+		conditions = append(conditions, "created_at < "+arg(*filter.To))
+	}
+	if filter.After != nil {
+		id, err := strconv.ParseInt(filter.After.ID, 10, 64)
+		if err != nil {
+			return "", nil, domain.WrapError(err, errcodes.InvalidArgument, "malformed cursor")
+		}
+		conditions = append(conditions, fmt.Sprintf("(created_at, id) < (%s, %s)", arg(filter.After.At), arg(id)))
+	}
+
+	query := `
+        SELECT id, actor, operation, entity_type, entity_id, before, after, trace_id, created_at
+        FROM audit_events`
+	if len(conditions) > 0 {
+		query += "\n        WHERE " + strings.Join(conditions, "\n          AND ")
+	}
+	query += "\n        ORDER BY created_at DESC, id DESC\n        LIMIT " + arg(filter.Limit)
+
+	return query, args, nil
+}
@@ -0,0 +1,102 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"pull_requests_service/internal/domain"
+	"pull_requests_service/internal/domain/entity"
+	"pull_requests_service/pkg/contextx"
+	"pull_requests_service/pkg/errcodes"
+	"pull_requests_service/pkg/pagination"
+)
+
+type recordingExecer struct {
+	query string
+	args  []any
+}
+
+func (e *recordingExecer) ExecContext(_ context.Context, query string, args ...any) (sql.Result, error) {
+	e.query, e.args = query, args
+	return nil, nil
+}
+
+func TestWriteAudit(t *testing.T) {
+	rq := require.New(t)
+
+	limit := 3
+	before := entity.Team{Name: "backend", ReviewerStrategy: entity.StrategyRandom, RequiredReviewers: 2}
+	after := before
+	after.MaxOpenReviews = &limit
+
+	ctx := contextx.WithUserID(context.Background(), "admin-1")
+	ctx = contextx.WithTraceID(ctx, "trace-1")
+	execer := &recordingExecer{}
+	rq.NoError(writeAudit(ctx, execer, entity.AuditTeamUpdateSettings, entity.AuditEntityTeam, "backend", before, after))
+
+	rq.Contains(execer.query, "INSERT INTO audit_events")
+	rq.Equal("admin-1", execer.args[0])
+	rq.Equal(entity.AuditTeamUpdateSettings, execer.args[1])
+	rq.Equal(entity.AuditEntityTeam, execer.args[2])
+	rq.Equal("backend", execer.args[3])
+	// снимки кодируются json-тегами сущности, как их отдаёт API
+	rq.JSONEq(`{"team_name":"backend","reviewer_strategy":"random","required_reviewers":2,"max_open_reviews":null,
+		"require_approvals":false,"created_at":"0001-01-01T00:00:00Z","archived_at":null,"fallback_teams":null}`,
+		execer.args[4].(string))
+	rq.Contains(execer.args[5].(string), `"max_open_reviews":3`)
+	rq.Equal("trace-1", *execer.args[6].(*string))
+
+	// без пользователя в контексте автор — system, отсутствующий снимок пишется NULL
+	rq.NoError(writeAudit(context.Background(), execer, entity.AuditTeamCreate, entity.AuditEntityTeam, "backend", nil, after))
+	rq.Equal(entity.AuditActorSystem, execer.args[0])
+	rq.Nil(execer.args[4])
+	rq.NotNil(execer.args[5])
+	rq.Nil(execer.args[6])
+
+	err := writeAudit(context.Background(), execer, entity.AuditTeamCreate, entity.AuditEntityTeam, "backend", nil,
+		map[string]any{"bad": make(chan int)})
+	var appErr *domain.AppError
+	rq.ErrorAs(err, &appErr)
+	rq.Equal(errcodes.InternalServerError, appErr.Code)
+}
+
+func TestAuditListQuery(t *testing.T) {
+	rq := require.New(t)
+
+	query, args, err := auditListQuery(entity.AuditFilter{Limit: 51})
+	rq.NoError(err)
+	rq.NotContains(query, "WHERE")
+	rq.True(strings.HasSuffix(query, "ORDER BY created_at DESC, id DESC\n        LIMIT $1"))
+	rq.Equal([]any{51}, args)
+
+	from := time.Date(2025, 10, 1, 0, 0, 0, 0, time.UTC)
+	to := from.Add(24 * time.Hour)
+	at := from.Add(time.Hour)
+	query, args, err = auditListQuery(entity.AuditFilter{
+		EntityType: entity.AuditEntityPullRequest,
+		EntityId:   "pr-1",
+		From:       &from,
+		To:         &to,
+		Limit:      11,
+		After:      &pagination.Cursor{At: at, ID: "42"},
+	})
+	rq.NoError(err)
+	rq.Contains(query, "entity_type = $1")
+	rq.Contains(query, "entity_id = $2")
+	rq.Contains(query, "created_at >= $3")
+	rq.Contains(query, "created_at < $4")
+	rq.Contains(query, "(created_at, id) < ($5, $6)")
+	rq.Contains(query, "LIMIT $7")
+	rq.Equal([]any{entity.AuditEntityPullRequest, "pr-1", from, to, at, int64(42), 11}, args)
+
+	// курсор чужого списка с нечисловым id
+	_, _, err = auditListQuery(entity.AuditFilter{Limit: 11, After: &pagination.Cursor{At: at, ID: "pr-1"}})
+	var appErr *domain.AppError
+	rq.ErrorAs(err, &appErr)
+	rq.Equal(errcodes.InvalidArgument, appErr.Code)
+}
@@ -0,0 +1,336 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"pull_requests_service/internal/domain"
+	"pull_requests_service/internal/domain/entity"
+	"pull_requests_service/pkg/errcodes"
+	"slices"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/samber/lo"
+)
+
+type BulkRepository struct {
+	db *sqlx.DB
+}
+
+func NewBulkRepository(db *sqlx.DB) *BulkRepository {
+	return &BulkRepository{db: db}
+}
+
+// ImportTeams создаёт команды пачки. Результат выровнен по входу: nil — команда создана,
+// *domain.AppError — строка отклонена, в том числе TEAM_EXISTS для существующей команды.
+func (r *BulkRepository) ImportTeams(ctx context.Context, teams []entity.TeamRecord) ([]error, error) {
+	return importBatch(ctx, r.db, teams, importTeam)
+}
+
+// ImportUsers создаёт пользователей пачки, существующие отклоняются с USER_EXISTS.
+func (r *BulkRepository) ImportUsers(ctx context.Context, users []entity.UserRecord) ([]error, error) {
+	return importBatch(ctx, r.db, users, importUser)
+}
+
+// ImportPullRequests создаёт открытые PR пачки с указанными ревьюверами,
+// существующие отклоняются с PR_EXISTS.
+func (r *BulkRepository) ImportPullRequests(ctx context.Context, prs []entity.PullRequestRecord) ([]error, error) {
+	return importBatch(ctx, r.db, prs, importPullRequest)
+}
+
+// importBatch применяет строки пачки в одной транзакции. Каждая строка идёт под точкой сохранения:
+// ошибка строки (*domain.AppError) откатывает только её, остальные ошибки прерывают пачку.
+func importBatch[T any](ctx context.Context, db *sqlx.DB, rows []T,
+	apply func(ctx context.Context, tx *sqlx.Tx, row T) error) ([]error, error) {
+
+	tx, err := db.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, domain.WrapError(err, errcodes.InternalServerError, "failed to begin transaction")
+	}
+	defer tx.Rollback()
+
+	rowErrors := make([]error, len(rows))
+	for i, row := range rows {
+		if _, err = tx.ExecContext(ctx, `SAVEPOINT import_row`); err != nil {
+			return nil, domain.WrapError(err, errcodes.InternalServerError, "repository: failed to create savepoint")
+		}
+
+		rowErr := apply(ctx, tx, row)
+		var appErr *domain.AppError
+		if rowErr != nil && (!errors.As(rowErr, &appErr) || appErr.Code == errcodes.InternalServerError) {
+			return nil, rowErr
+		}
+
+		releaseQuery := `RELEASE SAVEPOINT import_row`
+		if rowErr != nil {
+			releaseQuery = `ROLLBACK TO SAVEPOINT import_row`
+		}
+		if _, err = tx.ExecContext(ctx, releaseQuery); err != nil {
+			return nil, domain.WrapError(err, errcodes.InternalServerError, "repository: failed to release savepoint")
+		}
+		rowErrors[i] = rowErr
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, domain.WrapError(err, errcodes.InternalServerError, "failed to commit transaction")
+	}
+
+	return rowErrors, nil
+}
+
+func importTeam(ctx context.Context, tx *sqlx.Tx, record entity.TeamRecord) error {
+	var team entity.Team
+	err := tx.GetContext(ctx, &team, `
+        INSERT INTO teams (name, reviewer_strategy, required_reviewers, max_open_reviews, require_approvals, archived_at)
+        VALUES ($1, $2, $3, $4, $5, CASE WHEN $6 THEN NOW() END)
+        ON CONFLICT (name) DO NOTHING
+        RETURNING `+teamColumns,
+		record.TeamName, record.ReviewerStrategy, record.RequiredReviewers, record.MaxOpenReviews,
+		record.RequireApprovals, record.Archived)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.NewError(errcodes.TeamAlreadyExists, fmt.Sprintf("team with name '%s' already exists", record.TeamName))
+		}
+		return domain.WrapError(err, errcodes.InternalServerError, "repository: failed to import team")
+	}
+
+	// у новой команды резервных ещё нет; архивные допустимы, как в выгрузке
+	if err = addFallbackTeams(ctx, tx, team.Name, record.FallbackTeams, shareTeam); err != nil {
+		return err
+	}
+	team.FallbackTeams = record.FallbackTeams
+
+	return writeAudit(ctx, tx, entity.AuditTeamCreate, entity.AuditEntityTeam, team.Name, nil, team)
+}
+
+func importUser(ctx context.Context, tx *sqlx.Tx, record entity.UserRecord) error {
+	if record.TeamName != "" {
+		if _, err := shareTeam(ctx, tx, record.TeamName); err != nil {
+			return err
+		}
+	}
+
+	var user entity.User
+	err := tx.GetContext(ctx, &user, `
+        INSERT INTO users (id, name, is_active, team_id, seniority, max_open_reviews)
+        VALUES ($1, $2, $3, NULLIF($4, ''), $5, $6)
+        ON CONFLICT (id) DO NOTHING
+        RETURNING `+userColumns,
+		record.UserId, record.Username, lo.FromPtr(record.IsActive), record.TeamName, record.Seniority, record.MaxOpenReviews)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.NewError(errcodes.UserAlreadyExists, fmt.Sprintf("user with id '%s' already exists", record.UserId))
+		}
+		return domain.WrapError(err, errcodes.InternalServerError, "repository: failed to import user")
+	}
+
+	return writeAudit(ctx, tx, entity.AuditUserUpsert, entity.AuditEntityUser, user.Id, nil, user)
+}
+
+func importPullRequest(ctx context.Context, tx *sqlx.Tx, record entity.PullRequestRecord) error {
+	author, err := lockUser(ctx, tx, record.AuthorId)
+	if err != nil {
+		return err
+	}
+
+	reviewers := make([]entity.PickedReviewer, len(record.AssignedReviewers))
+	for i, reviewerId := range record.AssignedReviewers {
+		if reviewerId == author.Id {
+			return domain.NewError(errcodes.InvalidArgument, "author cannot review own pull request")
+		}
+		if slices.Contains(record.AssignedReviewers[:i], reviewerId) {
+			return domain.NewError(errcodes.InvalidArgument, fmt.Sprintf("reviewer '%s' is listed twice", reviewerId))
+		}
+		reviewer, lockErr := lockUser(ctx, tx, reviewerId)
+		if lockErr != nil {
+			return lockErr
+		}
+		if !reviewer.IsActive {
+			return domain.NewError(errcodes.InvalidArgument, fmt.Sprintf("reviewer '%s' is not active", reviewerId))
+		}
+		reviewers[i] = entity.PickedReviewer{ReviewerId: reviewer.Id, SourceTeam: reviewer.Team}
+	}
+
+	// ревьюверов не хватает относительно команды автора — их доберёт воркер при появлении кандидатов
+	requiredReviewers := 0
+	if author.Team != "" {
+		team, teamErr := shareTeam(ctx, tx, author.Team)
+		if teamErr != nil {
+			return teamErr
+		}
+		requiredReviewers = team.RequiredReviewers
+	}
+
+	pr := entity.PullRequest{
+		Id:                record.PullRequestId,
+		Name:              record.PullRequestName,
+		AuthorId:          author.Id,
+		AssignedReviewers: entity.ReviewerIds(reviewers),
+	}
+	err = tx.GetContext(ctx, &pr, `
+        INSERT INTO pull_requests (id, name, author_id, need_more_reviewers, status)
+        VALUES ($1, $2, $3, $4, $5)
+        ON CONFLICT (id) DO NOTHING
+        RETURNING id, name, author_id, status, need_more_reviewers, created_at, merged_at, closed_at`,
+		pr.Id, pr.Name, pr.AuthorId, len(reviewers) < requiredReviewers, entity.StatusOpen)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.NewError(errcodes.PullRequestExists, fmt.Sprintf("pull request with id '%s' already exists", pr.Id))
+		}
+		return domain.WrapError(err, errcodes.InternalServerError, "repository: failed to import pull request")
+	}
+
+	pr.Reviews = make([]entity.Review, len(reviewers))
+	for i, reviewer := range reviewers {
+		if _, err = tx.ExecContext(ctx, assignReviewerQuery, pr.Id, reviewer.ReviewerId, reviewer.SourceTeam); err != nil {
+			return domain.WrapError(err, errcodes.InternalServerError, "repository: failed to assign imported reviewer")
+		}
+		pr.Reviews[i] = entity.Review{ReviewerId: reviewer.ReviewerId, State: entity.ReviewPending}
+	}
+
+	if err = writeAudit(ctx, tx, entity.AuditPullRequestCreate, entity.AuditEntityPullRequest, pr.Id, nil, pr); err != nil {
+		return err
+	}
+	if err = enqueueWebhook(ctx, tx, entity.EventPullRequestCreated, pr); err != nil {
+		return err
+	}
+	for _, reviewer := range reviewers {
+		event := entity.ReviewerAssigned{PullRequestId: pr.Id, ReviewerId: reviewer.ReviewerId}
+		if err = enqueueWebhook(ctx, tx, entity.EventReviewerAssigned, event); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ExportTeams возвращает все команды, включая архивные, с резервными командами.
+func (r *BulkRepository) ExportTeams(ctx context.Context) ([]entity.TeamRecord, error) {
+	var teams []entity.Team
+	if err := r.db.SelectContext(ctx, &teams, `SELECT `+teamColumns+` FROM teams ORDER BY created_at, name`); err != nil {
+		return nil, domain.WrapError(err, errcodes.InternalServerError, "repository: failed to export teams")
+	}
+
+	var fallbacks []struct {
+		TeamName     string `db:"team_name"`
+		FallbackTeam string `db:"fallback_team"`
+	}
+	err := r.db.SelectContext(ctx, &fallbacks, `SELECT team_name, fallback_team FROM team_fallbacks ORDER BY team_name, priority`)
+	if err != nil {
+		return nil, domain.WrapError(err, errcodes.InternalServerError, "repository: failed to export fallback teams")
+	}
+	fallbacksByTeam := make(map[string][]string, len(teams))
+	for _, fallback := range fallbacks {
+		fallbacksByTeam[fallback.TeamName] = append(fallbacksByTeam[fallback.TeamName], fallback.FallbackTeam)
+	}
+
+	records := make([]entity.TeamRecord, 0, len(teams))
+	for _, team := range fallbacksFirst(teams, fallbacksByTeam) {
+		records = append(records, entity.TeamRecord{
+			TeamName:          team.Name,
+			ReviewerStrategy:  team.ReviewerStrategy,
+			RequiredReviewers: team.RequiredReviewers,
+			MaxOpenReviews:    team.MaxOpenReviews,
+			RequireApprovals:  team.RequireApprovals,
+			FallbackTeams:     fallbacksByTeam[team.Name],
+			Archived:          team.ArchivedAt != nil,
+		})
+	}
+
+	return records, nil
+}
+
+// fallbacksFirst ставит резервные команды раньше ссылающихся на них, чтобы выгрузку можно было
+// импортировать обратно. Циклы резервных команд так не разорвать: строка из цикла при импорте отклонится.
+func fallbacksFirst(teams []entity.Team, fallbacksByTeam map[string][]string) []entity.Team {
+	byName := make(map[string]entity.Team, len(teams))
+	for _, team := range teams {
+		byName[team.Name] = team
+	}
+
+	ordered := make([]entity.Team, 0, len(teams))
+	visited := make(map[string]bool, len(teams))
+	var visit func(name string)
+	visit = func(name string) {
+		if visited[name] {
+			return
+		}
+		visited[name] = true
+		for _, fallback := range fallbacksByTeam[name] {
+			visit(fallback)
+		}
+		ordered = append(ordered, byName[name])
+	}
+	for _, team := range teams {
+		visit(team.Name)
+	}
+
+	return ordered
+}
+
+func (r *BulkRepository) ExportUsers(ctx context.Context) ([]entity.UserRecord, error) {
+	var users []entity.User
+	if err := r.db.SelectContext(ctx, &users, `SELECT `+userColumns+` FROM users ORDER BY created_at, id`); err != nil {
+		return nil, domain.WrapError(err, errcodes.InternalServerError, "repository: failed to export users")
+	}
+
+	records := make([]entity.UserRecord, len(users))
+	for i, user := range users {
+		records[i] = entity.UserRecord{
+			UserId:         user.Id,
+			Username:       user.Name,
+			TeamName:       user.Team,
+			IsActive:       lo.ToPtr(user.IsActive),
+			Seniority:      user.Seniority,
+			MaxOpenReviews: user.MaxOpenReviews,
+		}
+	}
+
+	return records, nil
+}
+
+// ExportPullRequests возвращает открытые PR с текущими ревьюверами: импорт принимает только их,
+// поэтому черновики, слитые и закрытые PR в выгрузку не попадают.
+func (r *BulkRepository) ExportPullRequests(ctx context.Context) ([]entity.PullRequestRecord, error) {
+	var prs []entity.PullRequest
+	err := r.db.SelectContext(ctx, &prs, `
+        SELECT id, name, author_id, status, need_more_reviewers, created_at, merged_at, closed_at
+        FROM pull_requests
+        WHERE status = $1
+        ORDER BY created_at, id`, entity.StatusOpen)
+	if err != nil {
+		return nil, domain.WrapError(err, errcodes.InternalServerError, "repository: failed to export pull requests")
+	}
+
+	var links []struct {
+		PRID       string `db:"pull_request_id"`
+		ReviewerID string `db:"reviewer_id"`
+	}
+	err = r.db.SelectContext(ctx, &links, `
+        SELECT r.pull_request_id, r.reviewer_id
+        FROM pr_reviewers r
+        JOIN pull_requests pr ON pr.id = r.pull_request_id
+        WHERE r.is_current AND pr.status = $1
+        ORDER BY r.assigned_at, r.id`, entity.StatusOpen)
+	if err != nil {
+		return nil, domain.WrapError(err, errcodes.InternalServerError, "repository: failed to export reviewers")
+	}
+	reviewersByPR := make(map[string][]string, len(prs))
+	for _, link := range links {
+		reviewersByPR[link.PRID] = append(reviewersByPR[link.PRID], link.ReviewerID)
+	}
+
+	records := make([]entity.PullRequestRecord, len(prs))
+	for i, pr := range prs {
+		records[i] = entity.PullRequestRecord{
+			PullRequestId:     pr.Id,
+			PullRequestName:   pr.Name,
+			AuthorId:          pr.AuthorId,
+			Status:            pr.Status,
+			AssignedReviewers: reviewersByPR[pr.Id],
+		}
+	}
+
+	return records, nil
+}
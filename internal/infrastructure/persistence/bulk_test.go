@@ -0,0 +1,213 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/require"
+
+	"pull_requests_service/internal/domain"
+	"pull_requests_service/internal/domain/entity"
+	"pull_requests_service/pkg/errcodes"
+)
+
+// scriptedStep — ожидаемый запрос (по подстроке) и ответ на него.
+type scriptedStep struct {
+	query   string
+	columns []string
+	rows    [][]driver.Value
+}
+
+// scriptedConn отвечает на запросы строго по сценарию и запоминает их аргументы,
+// чтобы проверять код репозиториев без Postgres.
+type scriptedConn struct {
+	t     *testing.T
+	steps []scriptedStep
+	args  [][]any
+}
+
+func newScriptedDB(t *testing.T, steps ...scriptedStep) (*sqlx.DB, *scriptedConn) {
+	conn := &scriptedConn{t: t, steps: steps}
+	db := sqlx.NewDb(sql.OpenDB(conn), "postgres")
+	t.Cleanup(func() {
+		db.Close()
+		require.Empty(t, conn.steps, "not all scripted queries were executed")
+	})
+	return db, conn
+}
+
+func (c *scriptedConn) Connect(context.Context) (driver.Conn, error) { return c, nil }
+func (c *scriptedConn) Driver() driver.Driver                        { return nil }
+func (c *scriptedConn) Close() error                                 { return nil }
+func (c *scriptedConn) Begin() (driver.Tx, error)                    { return c, nil }
+func (c *scriptedConn) Commit() error                                { return nil }
+func (c *scriptedConn) Rollback() error                              { return nil }
+func (c *scriptedConn) CheckNamedValue(*driver.NamedValue) error     { return nil }
+
+func (c *scriptedConn) Prepare(string) (driver.Stmt, error) {
+	c.t.Fatal("unexpected prepared statement")
+	return nil, nil
+}
+
+func (c *scriptedConn) next(query string, args []driver.NamedValue) scriptedStep {
+	c.t.Helper()
+	if len(c.steps) == 0 {
+		c.t.Fatalf("unexpected query: %s", query)
+	}
+	step := c.steps[0]
+	c.steps = c.steps[1:]
+	if !strings.Contains(query, step.query) {
+		c.t.Fatalf("expected query with %q, got: %s", step.query, query)
+	}
+
+	values := make([]any, len(args))
+	for i, arg := range args {
+		values[i] = arg.Value
+	}
+	c.args = append(c.args, values)
+
+	return step
+}
+
+func (c *scriptedConn) ExecContext(_ context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	c.next(query, args)
+	return driver.RowsAffected(1), nil
+}
+
+func (c *scriptedConn) QueryContext(_ context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	step := c.next(query, args)
+	return &scriptedRows{columns: step.columns, rows: step.rows}, nil
+}
+
+type scriptedRows struct {
+	columns []string
+	rows    [][]driver.Value
+}
+
+func (r *scriptedRows) Columns() []string { return r.columns }
+func (r *scriptedRows) Close() error      { return nil }
+
+func (r *scriptedRows) Next(dest []driver.Value) error {
+	if len(r.rows) == 0 {
+		return io.EOF
+	}
+	copy(dest, r.rows[0])
+	r.rows = r.rows[1:]
+	return nil
+}
+
+var teamColumnNames = []string{"name", "reviewer_strategy", "required_reviewers", "created_at", "archived_at",
+	"max_open_reviews", "require_approvals"}
+
+func teamRow(name string, archivedAt any) []driver.Value {
+	return []driver.Value{name, entity.StrategyRandom, int64(2), time.Now(), archivedAt, nil, false}
+}
+
+func TestImportTeamsAcceptsArchivedFallbacks(t *testing.T) {
+	rq := require.New(t)
+
+	archivedAt := time.Now()
+	db, conn := newScriptedDB(t,
+		scriptedStep{query: "SAVEPOINT import_row"},
+		scriptedStep{query: "INSERT INTO teams", columns: teamColumnNames, rows: [][]driver.Value{teamRow("backend", nil)}},
+		// архивная резервная команда из выгрузки принимается, а не отклоняется как в lockTeam
+		scriptedStep{query: "FROM teams WHERE name = $1 FOR SHARE", columns: teamColumnNames,
+			rows: [][]driver.Value{teamRow("legacy", archivedAt)}},
+		scriptedStep{query: "INSERT INTO team_fallbacks"},
+		scriptedStep{query: "INSERT INTO audit_events"},
+		scriptedStep{query: "RELEASE SAVEPOINT import_row"},
+	)
+
+	rowErrors, err := NewBulkRepository(db).ImportTeams(context.Background(), []entity.TeamRecord{
+		{TeamName: "backend", ReviewerStrategy: entity.StrategyRandom, RequiredReviewers: 2, FallbackTeams: []string{"legacy"}},
+	})
+	rq.NoError(err)
+	rq.Equal([]error{nil}, rowErrors)
+	rq.Equal([]any{"backend", "legacy", 0}, conn.args[3])
+}
+
+func TestImportTeamsRejectsMissingFallback(t *testing.T) {
+	rq := require.New(t)
+
+	db, _ := newScriptedDB(t,
+		scriptedStep{query: "SAVEPOINT import_row"},
+		scriptedStep{query: "INSERT INTO teams", columns: teamColumnNames, rows: [][]driver.Value{teamRow("backend", nil)}},
+		scriptedStep{query: "FROM teams WHERE name = $1 FOR SHARE", columns: teamColumnNames},
+		scriptedStep{query: "ROLLBACK TO SAVEPOINT import_row"},
+	)
+
+	rowErrors, err := NewBulkRepository(db).ImportTeams(context.Background(), []entity.TeamRecord{
+		{TeamName: "backend", ReviewerStrategy: entity.StrategyRandom, RequiredReviewers: 2, FallbackTeams: []string{"ghost"}},
+	})
+	rq.NoError(err)
+	rq.Len(rowErrors, 1)
+	var appErr *domain.AppError
+	rq.ErrorAs(rowErrors[0], &appErr)
+	rq.Equal(errcodes.NotFound, appErr.Code)
+}
+
+func TestImportUsersAcceptsArchivedTeam(t *testing.T) {
+	rq := require.New(t)
+
+	userColumnNames := []string{"id", "name", "is_active", "team_id", "seniority", "max_open_reviews", "created_at"}
+	db, conn := newScriptedDB(t,
+		scriptedStep{query: "SAVEPOINT import_row"},
+		scriptedStep{query: "FROM teams WHERE name = $1 FOR SHARE", columns: teamColumnNames,
+			rows: [][]driver.Value{teamRow("legacy", time.Now())}},
+		scriptedStep{query: "INSERT INTO users", columns: userColumnNames,
+			rows: [][]driver.Value{{"u1", "Alice", false, "legacy", int64(2), nil, time.Now()}}},
+		scriptedStep{query: "INSERT INTO audit_events"},
+		scriptedStep{query: "RELEASE SAVEPOINT import_row"},
+	)
+
+	inactive := false
+	rowErrors, err := NewBulkRepository(db).ImportUsers(context.Background(), []entity.UserRecord{
+		{UserId: "u1", Username: "Alice", TeamName: "legacy", IsActive: &inactive, Seniority: 2},
+	})
+	rq.NoError(err)
+	rq.Equal([]error{nil}, rowErrors)
+	rq.Equal("legacy", conn.args[2][3])
+}
+
+func TestExportPullRequestsOnlyOpen(t *testing.T) {
+	rq := require.New(t)
+
+	prColumnNames := []string{"id", "name", "author_id", "status", "need_more_reviewers", "created_at", "merged_at", "closed_at"}
+	db, conn := newScriptedDB(t,
+		scriptedStep{query: "FROM pull_requests", columns: prColumnNames,
+			rows: [][]driver.Value{{"pr-1", "Add search", "u1", entity.StatusOpen, false, time.Now(), nil, nil}}},
+		scriptedStep{query: "FROM pr_reviewers r", columns: []string{"pull_request_id", "reviewer_id"},
+			rows: [][]driver.Value{{"pr-1", "u2"}, {"pr-1", "u3"}}},
+	)
+
+	records, err := NewBulkRepository(db).ExportPullRequests(context.Background())
+	rq.NoError(err)
+	rq.Equal([]entity.PullRequestRecord{{PullRequestId: "pr-1", PullRequestName: "Add search", AuthorId: "u1",
+		Status: entity.StatusOpen, AssignedReviewers: []string{"u2", "u3"}}}, records)
+	// импорт отклоняет PR не в OPEN, поэтому выгрузка их не содержит
+	rq.Equal([]any{entity.StatusOpen}, conn.args[0])
+	rq.Equal([]any{entity.StatusOpen}, conn.args[1])
+}
+
+func TestFallbacksFirst(t *testing.T) {
+	rq := require.New(t)
+
+	teams := []entity.Team{{Name: "backend"}, {Name: "legacy"}, {Name: "platform"}}
+	ordered := fallbacksFirst(teams, map[string][]string{
+		"backend": {"legacy", "platform"},
+		"legacy":  {"platform"},
+	})
+
+	// резервные команды, в том числе архивные, выгружаются раньше ссылающихся на них
+	names := make([]string, len(ordered))
+	for i, team := range ordered {
+		names[i] = team.Name
+	}
+	rq.Equal([]string{"platform", "legacy", "backend"}, names)
+}
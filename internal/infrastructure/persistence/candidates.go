@@ -0,0 +1,158 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"pull_requests_service/internal/domain"
+	"pull_requests_service/internal/domain/entity"
+	"pull_requests_service/pkg/errcodes"
+	"slices"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// candidatesQuery возвращает активных пользователей вместе с нагрузкой, нужной стратегиям выбора.
+// Пользователи, исчерпавшие лимит открытых ревью (личный или команды) или скоро уходящие
+// в запланированное отсутствие, не возвращаются.
+// Вызывающий дописывает свои условия после WHERE через filter.
+const candidatesQuery = `
+    SELECT
+        u.id,
+        u.seniority,
+        COUNT(r.id) FILTER (WHERE p.status = 'OPEN' AND r.is_current) AS open_reviews,
+        MAX(r.assigned_at) AS last_assigned_at
+    FROM users u
+    LEFT JOIN teams member_team ON member_team.name = u.team_id
+    LEFT JOIN pr_reviewers r ON r.reviewer_id = u.id
+    LEFT JOIN pull_requests p ON p.id = r.pull_request_id
+    WHERE u.is_active = TRUE
+      AND` + awayCondition + `
+      AND %s
+    GROUP BY u.id, u.seniority, u.max_open_reviews, member_team.max_open_reviews
+    HAVING COALESCE(u.max_open_reviews, member_team.max_open_reviews) IS NULL
+        OR COUNT(r.id) FILTER (WHERE p.status = 'OPEN' AND r.is_current)
+            < COALESCE(u.max_open_reviews, member_team.max_open_reviews)
+    ORDER BY u.id`
+
+// workloadQuery возвращает открытые ревью пользователей и действующий лимит.
+// Вызывающий дописывает свои условия после WHERE через filter.
+const workloadQuery = `
+    SELECT
+        u.id AS user_id,
+        u.name AS username,
+        COALESCE(u.team_id, '') AS team_name,
+        u.is_active,
+        COUNT(r.id) FILTER (WHERE p.status = 'OPEN' AND r.is_current) AS open_reviews,
+        COALESCE(u.max_open_reviews, member_team.max_open_reviews) AS max_open_reviews
+    FROM users u
+    LEFT JOIN teams member_team ON member_team.name = u.team_id
+    LEFT JOIN pr_reviewers r ON r.reviewer_id = u.id
+    LEFT JOIN pull_requests p ON p.id = r.pull_request_id
+    WHERE %s
+    GROUP BY u.id, u.name, u.team_id, u.is_active, u.max_open_reviews, member_team.max_open_reviews
+    ORDER BY open_reviews DESC, u.id`
+
+func selectCandidates(ctx context.Context, q sqlx.QueryerContext, filter string, args ...any) (
+	[]entity.ReviewerCandidate, error) {
+
+	var candidates []entity.ReviewerCandidate
+	if err := sqlx.SelectContext(ctx, q, &candidates, fmt.Sprintf(candidatesQuery, filter), args...); err != nil {
+		return nil, domain.WrapError(err, errcodes.InternalServerError, "repository: failed to get reviewer candidates")
+	}
+
+	return candidates, nil
+}
+
+// teamOfUser возвращает команду пользователя вместе с её настройками назначения.
+func teamOfUser(ctx context.Context, q sqlx.QueryerContext, userId string) (entity.Team, error) {
+	const query = `
+        SELECT t.name, t.reviewer_strategy, t.required_reviewers, t.created_at
+        FROM teams t
+        JOIN users u ON u.team_id = t.name
+        WHERE u.id = $1`
+
+	var team entity.Team
+	if err := sqlx.GetContext(ctx, q, &team, query, userId); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return entity.Team{}, domain.NewError(errcodes.NotFound, fmt.Sprintf("team of user '%s' not found", userId))
+		}
+		return entity.Team{}, domain.WrapError(err, errcodes.InternalServerError, "repository: failed to get team of user")
+	}
+
+	return team, nil
+}
+
+// reviewTeamOf возвращает команду, из пулов которой добираются ревьюверы PR: команду, которой PR
+// передан при расформировании команды автора, иначе команду автора.
+func reviewTeamOf(ctx context.Context, q sqlx.QueryerContext, prId string) (entity.Team, error) {
+	const query = `
+        SELECT t.name, t.reviewer_strategy, t.required_reviewers, t.created_at, t.archived_at
+        FROM pull_requests pr
+        JOIN users author ON author.id = pr.author_id
+        JOIN teams t ON t.name = COALESCE(pr.review_team, author.team_id)
+        WHERE pr.id = $1`
+
+	var team entity.Team
+	if err := sqlx.GetContext(ctx, q, &team, query, prId); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return entity.Team{}, domain.NewError(errcodes.NotFound, fmt.Sprintf("review team of pull request '%s' not found", prId))
+		}
+		return entity.Team{}, domain.WrapError(err, errcodes.InternalServerError, "repository: failed to get review team of pull request")
+	}
+
+	return team, nil
+}
+
+// fallbackTeamNames возвращает резервные команды в порядке приоритета, включая архивные.
+func fallbackTeamNames(ctx context.Context, q sqlx.QueryerContext, teamName string) ([]string, error) {
+	const query = `SELECT fallback_team FROM team_fallbacks WHERE team_name = $1 ORDER BY priority`
+
+	names := []string{}
+	if err := sqlx.SelectContext(ctx, q, &names, query, teamName); err != nil {
+		return nil, domain.WrapError(err, errcodes.InternalServerError, "repository: failed to get fallback teams")
+	}
+
+	return names, nil
+}
+
+// candidatePools собирает пулы кандидатов: сама команда, затем её неархивные резервные команды.
+// filter дописывается к условиям каждого пула, имя команды пула передаётся последним параметром.
+func candidatePools(ctx context.Context, q sqlx.QueryerContext, team entity.Team, filter string, args ...any) (
+	[]entity.CandidatePool, error) {
+
+	const fallbacksQuery = `
+        SELECT t.name, t.reviewer_strategy, t.required_reviewers, t.created_at, t.archived_at
+        FROM team_fallbacks f
+        JOIN teams t ON t.name = f.fallback_team
+        WHERE f.team_name = $1 AND t.archived_at IS NULL
+        ORDER BY f.priority`
+
+	var fallbacks []entity.Team
+	if err := sqlx.SelectContext(ctx, q, &fallbacks, fallbacksQuery, team.Name); err != nil {
+		return nil, domain.WrapError(err, errcodes.InternalServerError, "repository: failed to get fallback teams")
+	}
+
+	teamFilter := fmt.Sprintf("u.team_id = $%d AND %s", len(args)+1, filter)
+	pools := make([]entity.CandidatePool, 0, len(fallbacks)+1)
+	for _, poolTeam := range append([]entity.Team{team}, fallbacks...) {
+		poolArgs := append(slices.Clone(args), poolTeam.Name)
+		candidates, err := selectCandidates(ctx, q, teamFilter, poolArgs...)
+		if err != nil {
+			return nil, err
+		}
+		pools = append(pools, entity.CandidatePool{Team: poolTeam, Candidates: candidates})
+	}
+
+	return pools, nil
+}
+
+func selectWorkload(ctx context.Context, q sqlx.QueryerContext, filter string, args ...any) ([]entity.UserWorkload, error) {
+	workload := []entity.UserWorkload{}
+	if err := sqlx.SelectContext(ctx, q, &workload, fmt.Sprintf(workloadQuery, filter), args...); err != nil {
+		return nil, domain.WrapError(err, errcodes.InternalServerError, "repository: failed to get reviewer workload")
+	}
+
+	return workload, nil
+}
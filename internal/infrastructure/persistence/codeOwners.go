@@ -0,0 +1,70 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"pull_requests_service/internal/domain"
+	"pull_requests_service/internal/domain/entity"
+	"pull_requests_service/pkg/errcodes"
+)
+
+// SetCodeOwners сохраняет файл владельцев команды, заменяя предыдущий.
+func (r *TeamRepository) SetCodeOwners(ctx context.Context, owners entity.CodeOwners) (entity.CodeOwners, error) {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return entity.CodeOwners{}, domain.WrapError(err, errcodes.InternalServerError, "failed to begin transaction")
+	}
+	defer tx.Rollback()
+
+	if _, err = lockTeam(ctx, tx, owners.Team); err != nil {
+		return entity.CodeOwners{}, err
+	}
+
+	var before any
+	var previous entity.CodeOwners
+	err = tx.GetContext(ctx, &previous,
+		`SELECT team_name, content, updated_at FROM team_code_owners WHERE team_name = $1 FOR UPDATE`, owners.Team)
+	switch {
+	case err == nil:
+		before = previous
+	case !errors.Is(err, sql.ErrNoRows):
+		return entity.CodeOwners{}, domain.WrapError(err, errcodes.InternalServerError, "repository: failed to get code owners")
+	}
+
+	query := `
+        INSERT INTO team_code_owners (team_name, content)
+        VALUES ($1, $2)
+        ON CONFLICT (team_name) DO UPDATE SET content = EXCLUDED.content, updated_at = NOW()
+        RETURNING team_name, content, updated_at`
+
+	var saved entity.CodeOwners
+	if err = tx.GetContext(ctx, &saved, query, owners.Team, owners.Content); err != nil {
+		return entity.CodeOwners{}, domain.WrapError(err, errcodes.InternalServerError, "repository: failed to save code owners")
+	}
+
+	if err = writeAudit(ctx, tx, entity.AuditTeamSetCodeOwners, entity.AuditEntityTeam, saved.Team, before, saved); err != nil {
+		return entity.CodeOwners{}, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return entity.CodeOwners{}, domain.WrapError(err, errcodes.InternalServerError, "failed to commit transaction")
+	}
+
+	return saved, nil
+}
+
+func (r *TeamRepository) GetCodeOwners(ctx context.Context, teamName string) (entity.CodeOwners, error) {
+	query := `SELECT team_name, content, updated_at FROM team_code_owners WHERE team_name = $1`
+
+	var owners entity.CodeOwners
+	if err := r.db.GetContext(ctx, &owners, query, teamName); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return entity.CodeOwners{}, domain.NewError(errcodes.NotFound, fmt.Sprintf("code owners of team '%s' not found", teamName))
+		}
+		return entity.CodeOwners{}, domain.WrapError(err, errcodes.InternalServerError, "repository: failed to get code owners")
+	}
+
+	return owners, nil
+}
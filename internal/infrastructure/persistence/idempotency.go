@@ -0,0 +1,98 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"pull_requests_service/internal/domain"
+	"pull_requests_service/internal/domain/entity"
+	"pull_requests_service/pkg/errcodes"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+)
+
+const idempotencyColumns = `scope, key, fingerprint, status_code, content_type, response_body, created_at, expires_at`
+
+type IdempotencyRepository struct {
+	db *sqlx.DB
+}
+
+func NewIdempotencyRepository(db *sqlx.DB) *IdempotencyRepository {
+	return &IdempotencyRepository{db: db}
+}
+
+// Reserve занимает ключ на время выполнения запроса (lock). Истёкшая запись, в том числе
+// брошенная упавшим запросом, занимается заново. Если ключ занят, возвращается его запись и false.
+func (r *IdempotencyRepository) Reserve(ctx context.Context, scope, key, fingerprint string, lock time.Duration) (
+	entity.IdempotencyRecord, bool, error) {
+
+	query := `
+        INSERT INTO idempotency_keys (scope, key, fingerprint, expires_at)
+        VALUES ($1, $2, $3, NOW() + $4 * INTERVAL '1 millisecond')
+        ON CONFLICT (scope, key) DO UPDATE
+        SET fingerprint = EXCLUDED.fingerprint,
+            status_code = NULL,
+            content_type = NULL,
+            response_body = NULL,
+            created_at = NOW(),
+            expires_at = EXCLUDED.expires_at
+        WHERE idempotency_keys.expires_at <= NOW()
+        RETURNING ` + idempotencyColumns
+
+	var record entity.IdempotencyRecord
+	err := r.db.GetContext(ctx, &record, query, scope, key, fingerprint, lock.Milliseconds())
+	if err == nil {
+		return record, true, nil
+	}
+	if !errors.Is(err, sql.ErrNoRows) {
+		return entity.IdempotencyRecord{}, false, domain.WrapError(err, errcodes.InternalServerError, "repository: failed to reserve idempotency key")
+	}
+
+	err = r.db.GetContext(ctx, &record, `SELECT `+idempotencyColumns+` FROM idempotency_keys WHERE scope = $1 AND key = $2`, scope, key)
+	if err != nil {
+		// запись удалили между запросами — считаем, что ключ всё ещё занят
+		if errors.Is(err, sql.ErrNoRows) {
+			return entity.IdempotencyRecord{Scope: scope, Key: key, Fingerprint: fingerprint}, false, nil
+		}
+		return entity.IdempotencyRecord{}, false, domain.WrapError(err, errcodes.InternalServerError, "repository: failed to get idempotency key")
+	}
+
+	return record, false, nil
+}
+
+// Complete сохраняет ответ на запрос и продлевает запись на ttl.
+func (r *IdempotencyRepository) Complete(ctx context.Context, record entity.IdempotencyRecord, ttl time.Duration) error {
+	_, err := r.db.ExecContext(ctx, `
+        UPDATE idempotency_keys
+        SET status_code = $3, content_type = $4, response_body = $5, expires_at = NOW() + $6 * INTERVAL '1 millisecond'
+        WHERE scope = $1 AND key = $2`,
+		record.Scope, record.Key, record.StatusCode, record.ContentType, record.ResponseBody, ttl.Milliseconds())
+	if err != nil {
+		return domain.WrapError(err, errcodes.InternalServerError, "repository: failed to save idempotent response")
+	}
+
+	return nil
+}
+
+// Release освобождает ключ, ответ на который сохранять нельзя, чтобы запрос можно было повторить.
+func (r *IdempotencyRepository) Release(ctx context.Context, scope, key string) error {
+	_, err := r.db.ExecContext(ctx,
+		`DELETE FROM idempotency_keys WHERE scope = $1 AND key = $2 AND status_code IS NULL`, scope, key)
+	if err != nil {
+		return domain.WrapError(err, errcodes.InternalServerError, "repository: failed to release idempotency key")
+	}
+
+	return nil
+}
+
+// DeleteExpired удаляет истёкшие записи и возвращает их число.
+func (r *IdempotencyRepository) DeleteExpired(ctx context.Context) (int64, error) {
+	result, err := r.db.ExecContext(ctx, `DELETE FROM idempotency_keys WHERE expires_at <= NOW()`)
+	if err != nil {
+		return 0, domain.WrapError(err, errcodes.InternalServerError, "repository: failed to delete expired idempotency keys")
+	}
+
+	deleted, _ := result.RowsAffected()
+	return deleted, nil
+}
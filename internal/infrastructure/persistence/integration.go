@@ -0,0 +1,151 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"pull_requests_service/internal/domain"
+	"pull_requests_service/internal/domain/entity"
+	"pull_requests_service/pkg/errcodes"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jmoiron/sqlx"
+)
+
+type IntegrationRepository struct {
+	db *sqlx.DB
+}
+
+func NewIntegrationRepository(db *sqlx.DB) *IntegrationRepository {
+	return &IntegrationRepository{db: db}
+}
+
+// LinkIdentity привязывает логин VCS к пользователю, перезаписывая прежнюю привязку логина.
+func (r *IntegrationRepository) LinkIdentity(ctx context.Context, identity entity.ExternalIdentity) (entity.ExternalIdentity, error) {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return entity.ExternalIdentity{}, domain.WrapError(err, errcodes.InternalServerError, "failed to begin transaction")
+	}
+	defer tx.Rollback()
+
+	var before any
+	previous, err := getIdentity(ctx, tx, identity.Provider, identity.Login)
+	switch {
+	case err == nil:
+		before = previous
+	case !isNotFound(err):
+		return entity.ExternalIdentity{}, err
+	}
+
+	query := `
+        INSERT INTO external_identities (provider, login, user_id)
+        VALUES ($1, $2, $3)
+        ON CONFLICT (provider, login) DO UPDATE SET user_id = EXCLUDED.user_id
+        RETURNING provider, login, user_id, created_at`
+
+	var linked entity.ExternalIdentity
+	if err = tx.GetContext(ctx, &linked, query, identity.Provider, identity.Login, identity.UserId); err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23503" {
+			return entity.ExternalIdentity{}, domain.NewError(errcodes.NotFound, fmt.Sprintf("user with id '%s' not found", identity.UserId))
+		}
+		return entity.ExternalIdentity{}, domain.WrapError(err, errcodes.InternalServerError, "repository: failed to link identity")
+	}
+
+	err = writeAudit(ctx, tx, entity.AuditIdentityLink, entity.AuditEntityIdentity, linked.Provider+":"+linked.Login, before, linked)
+	if err != nil {
+		return entity.ExternalIdentity{}, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return entity.ExternalIdentity{}, domain.WrapError(err, errcodes.InternalServerError, "failed to commit transaction")
+	}
+
+	return linked, nil
+}
+
+func (r *IntegrationRepository) UnlinkIdentity(ctx context.Context, provider, login string) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return domain.WrapError(err, errcodes.InternalServerError, "failed to begin transaction")
+	}
+	defer tx.Rollback()
+
+	previous, err := getIdentity(ctx, tx, provider, login)
+	if err != nil {
+		return err
+	}
+
+	if _, err = tx.ExecContext(ctx, `DELETE FROM external_identities WHERE provider = $1 AND login = $2`, provider, login); err != nil {
+		return domain.WrapError(err, errcodes.InternalServerError, "repository: failed to unlink identity")
+	}
+
+	if err = writeAudit(ctx, tx, entity.AuditIdentityUnlink, entity.AuditEntityIdentity, provider+":"+login, previous, nil); err != nil {
+		return err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return domain.WrapError(err, errcodes.InternalServerError, "failed to commit transaction")
+	}
+
+	return nil
+}
+
+// ResolveIdentity возвращает id пользователя, привязанного к логину VCS.
+func (r *IntegrationRepository) ResolveIdentity(ctx context.Context, provider, login string) (string, error) {
+	identity, err := getIdentity(ctx, r.db, provider, login)
+	if err != nil {
+		return "", err
+	}
+
+	return identity.UserId, nil
+}
+
+func getIdentity(ctx context.Context, q sqlx.QueryerContext, provider, login string) (entity.ExternalIdentity, error) {
+	const query = `
+        SELECT provider, login, user_id, created_at
+        FROM external_identities
+        WHERE provider = $1 AND login = $2`
+
+	var identity entity.ExternalIdentity
+	if err := sqlx.GetContext(ctx, q, &identity, query, provider, login); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return entity.ExternalIdentity{}, domain.NewError(errcodes.NotFound,
+				fmt.Sprintf("%s login '%s' is not linked to any user", provider, login))
+		}
+		return entity.ExternalIdentity{}, domain.WrapError(err, errcodes.InternalServerError, "repository: failed to get identity")
+	}
+
+	return identity, nil
+}
+
+// IsDelivered сообщает, обрабатывалась ли уже доставка с этим id.
+func (r *IntegrationRepository) IsDelivered(ctx context.Context, provider, deliveryId string) (bool, error) {
+	const query = `SELECT EXISTS (SELECT 1 FROM inbound_deliveries WHERE provider = $1 AND delivery_id = $2)`
+
+	var delivered bool
+	if err := r.db.GetContext(ctx, &delivered, query, provider, deliveryId); err != nil {
+		return false, domain.WrapError(err, errcodes.InternalServerError, "repository: failed to check inbound delivery")
+	}
+
+	return delivered, nil
+}
+
+func (r *IntegrationRepository) MarkDelivered(ctx context.Context, provider, deliveryId string) error {
+	const query = `
+        INSERT INTO inbound_deliveries (provider, delivery_id)
+        VALUES ($1, $2)
+        ON CONFLICT (provider, delivery_id) DO NOTHING`
+
+	if _, err := r.db.ExecContext(ctx, query, provider, deliveryId); err != nil {
+		return domain.WrapError(err, errcodes.InternalServerError, "repository: failed to record inbound delivery")
+	}
+
+	return nil
+}
+
+func isNotFound(err error) bool {
+	var appErr *domain.AppError
+	return errors.As(err, &appErr) && appErr.Code == errcodes.NotFound
+}
@@ -0,0 +1,58 @@
+package persistence
+
+import (
+	"context"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const statsQueryTimeout = 5 * time.Second
+
+// StatsCollector отдаёт метрики, которые дешевле посчитать в БД в момент сбора,
+// чем поддерживать счётчиками в памяти каждой реплики.
+type StatsCollector struct {
+	db                *sqlx.DB
+	outboxPending     *prometheus.Desc
+	needMoreReviewers *prometheus.Desc
+}
+
+func NewStatsCollector(db *sqlx.DB) *StatsCollector {
+	return &StatsCollector{
+		db: db,
+		outboxPending: prometheus.NewDesc("pr_service_outbox_pending_events",
+			"Number of unprocessed outbox events.", nil, nil),
+		needMoreReviewers: prometheus.NewDesc("pr_service_pull_requests_need_more_reviewers",
+			"Number of open pull requests waiting for more reviewers.", nil, nil),
+	}
+}
+
+func (c *StatsCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.outboxPending
+	ch <- c.needMoreReviewers
+}
+
+func (c *StatsCollector) Collect(ch chan<- prometheus.Metric) {
+	ctx, cancel := context.WithTimeout(context.Background(), statsQueryTimeout)
+	defer cancel()
+
+	var stats struct {
+		OutboxPending     int64 `db:"outbox_pending"`
+		NeedMoreReviewers int64 `db:"need_more_reviewers"`
+	}
+
+	const query = `
+        SELECT
+            (SELECT COUNT(*) FROM outbox_events WHERE processed_at IS NULL) AS outbox_pending,
+            (SELECT COUNT(*) FROM pull_requests WHERE status = 'OPEN' AND need_more_reviewers) AS need_more_reviewers`
+
+	if err := c.db.GetContext(ctx, &stats, query); err != nil {
+		ch <- prometheus.NewInvalidMetric(c.outboxPending, err)
+		ch <- prometheus.NewInvalidMetric(c.needMoreReviewers, err)
+		return
+	}
+
+	ch <- prometheus.MustNewConstMetric(c.outboxPending, prometheus.GaugeValue, float64(stats.OutboxPending))
+	ch <- prometheus.MustNewConstMetric(c.needMoreReviewers, prometheus.GaugeValue, float64(stats.NeedMoreReviewers))
+}
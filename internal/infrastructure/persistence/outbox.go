@@ -0,0 +1,87 @@
+package persistence
+
+import (
+	"context"
+	"encoding/json"
+	"pull_requests_service/internal/domain"
+	"pull_requests_service/internal/domain/entity"
+	"pull_requests_service/pkg/errcodes"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// enqueueOutbox записывает событие в outbox в транзакции самого изменения,
+// поэтому оно не теряется ни при падении процесса, ни при переполнении очередей.
+func enqueueOutbox(ctx context.Context, tx sqlx.ExecerContext, eventType string, payload any) error {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return domain.WrapError(err, errcodes.InternalServerError, "repository: failed to marshal outbox payload")
+	}
+
+	const query = `INSERT INTO outbox_events (event_type, payload) VALUES ($1, $2::jsonb)`
+	if _, err = tx.ExecContext(ctx, query, eventType, string(data)); err != nil {
+		return domain.WrapError(err, errcodes.InternalServerError, "repository: failed to enqueue outbox event")
+	}
+
+	return nil
+}
+
+type OutboxRepository struct {
+	db *sqlx.DB
+}
+
+func NewOutboxRepository(db *sqlx.DB) *OutboxRepository {
+	return &OutboxRepository{db: db}
+}
+
+// Claim забирает до limit готовых к обработке событий. Строки, занятые другими репликами,
+// пропускаются (SKIP LOCKED), а взятым сдвигается next_attempt_at на lease: если обработчик
+// упадёт, событие вернётся в работу после истечения аренды.
+func (r *OutboxRepository) Claim(ctx context.Context, limit int, lease time.Duration) ([]entity.OutboxEvent, error) {
+	const query = `
+        UPDATE outbox_events
+        SET attempts = attempts + 1,
+            next_attempt_at = NOW() + $2 * INTERVAL '1 millisecond'
+        WHERE id IN (
+            SELECT id
+            FROM outbox_events
+            WHERE processed_at IS NULL
+              AND next_attempt_at <= NOW()
+            ORDER BY next_attempt_at, id
+            LIMIT $1
+            FOR UPDATE SKIP LOCKED
+        )
+        RETURNING id, event_type, payload, attempts, created_at`
+
+	var events []entity.OutboxEvent
+	if err := r.db.SelectContext(ctx, &events, query, limit, lease.Milliseconds()); err != nil {
+		return nil, domain.WrapError(err, errcodes.InternalServerError, "repository: failed to claim outbox events")
+	}
+
+	return events, nil
+}
+
+// MarkDone помечает событие обработанным.
+func (r *OutboxRepository) MarkDone(ctx context.Context, id int64) error {
+	const query = `UPDATE outbox_events SET processed_at = NOW(), last_error = NULL WHERE id = $1`
+	if _, err := r.db.ExecContext(ctx, query, id); err != nil {
+		return domain.WrapError(err, errcodes.InternalServerError, "repository: failed to mark outbox event done")
+	}
+
+	return nil
+}
+
+// MarkFailed сохраняет ошибку и откладывает следующую попытку на retryIn.
+func (r *OutboxRepository) MarkFailed(ctx context.Context, id int64, cause string, retryIn time.Duration) error {
+	const query = `
+        UPDATE outbox_events
+        SET last_error = $2,
+            next_attempt_at = NOW() + $3 * INTERVAL '1 millisecond'
+        WHERE id = $1`
+	if _, err := r.db.ExecContext(ctx, query, id, cause, retryIn.Milliseconds()); err != nil {
+		return domain.WrapError(err, errcodes.InternalServerError, "repository: failed to mark outbox event failed")
+	}
+
+	return nil
+}
@@ -11,8 +11,18 @@ import (
 	"pull_requests_service/internal/domain"
 	"pull_requests_service/internal/domain/entity"
 	"pull_requests_service/pkg/errcodes"
+	"strings"
 )
 
+// replaceReviewerQuery снимает текущего ревьювера с PR, оставляя запись в истории.
+const replaceReviewerQuery = `
+    UPDATE pr_reviewers
+    SET is_current = FALSE, replaced_at = NOW(), replace_reason = $3
+    WHERE pull_request_id = $1 AND reviewer_id = $2 AND is_current`
+
+// assignReviewerQuery назначает ревьювера на PR, запоминая команду, из пула которой он взят.
+const assignReviewerQuery = `INSERT INTO pr_reviewers (pull_request_id, reviewer_id, source_team) VALUES ($1, $2, $3)`
+
 type PullRequestRepository struct {
 	db *sqlx.DB
 }
@@ -21,7 +31,7 @@ func NewPullRequestRepository(db *sqlx.DB) *PullRequestRepository {
 	return &PullRequestRepository{db: db}
 }
 
-func (r *PullRequestRepository) CreateWithReviewers(ctx context.Context, pr *entity.PullRequest, reviewerIDs []string) error {
+func (r *PullRequestRepository) CreateWithReviewers(ctx context.Context, pr *entity.PullRequest, reviewers []entity.PickedReviewer) error {
 	tx, err := r.db.BeginTxx(ctx, nil)
 	if err != nil {
 		return domain.WrapError(err, errcodes.InternalServerError, "failed to begin transaction")
@@ -31,9 +41,9 @@ func (r *PullRequestRepository) CreateWithReviewers(ctx context.Context, pr *ent
 	prQuery := `
         INSERT INTO pull_requests (id, name, author_id,need_more_reviewers, status)
         VALUES ($1, $2, $3, $4, $5)
-        RETURNING id, name, author_id, status, created_at, merged_at;
+        RETURNING id, name, author_id, status, need_more_reviewers, created_at, merged_at, closed_at;
     `
-	err = tx.GetContext(ctx, pr, prQuery, pr.Id, pr.Name, pr.AuthorId, len(reviewerIDs) < 2, pr.Status)
+	err = tx.GetContext(ctx, pr, prQuery, pr.Id, pr.Name, pr.AuthorId, pr.NeedMoreReviewers, pr.Status)
 	if err != nil {
 		var pgErr *pgconn.PgError
 		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
@@ -42,21 +52,25 @@ func (r *PullRequestRepository) CreateWithReviewers(ctx context.Context, pr *ent
 		return domain.WrapError(err, errcodes.InternalServerError, "repository: failed to create team")
 	}
 
-	if len(reviewerIDs) > 0 {
+	if len(reviewers) > 0 {
 		type reviewerLink struct {
 			PRID       string `db:"pr_id"`
 			ReviewerID string `db:"reviewer_id"`
+			SourceTeam string `db:"source_team"`
 		}
 
-		links := make([]reviewerLink, len(reviewerIDs))
-		for i, reviewerID := range reviewerIDs {
+		links := make([]reviewerLink, len(reviewers))
+		for i, reviewer := range reviewers {
 			links[i] = reviewerLink{
 				PRID:       pr.Id,
-				ReviewerID: reviewerID,
+				ReviewerID: reviewer.ReviewerId,
+				SourceTeam: reviewer.SourceTeam,
 			}
 		}
 
-		assignQuery := `INSERT INTO pr_reviewers (pull_request_id, reviewer_id) VALUES (:pr_id, :reviewer_id)`
+		assignQuery := `
+            INSERT INTO pr_reviewers (pull_request_id, reviewer_id, source_team)
+            VALUES (:pr_id, :reviewer_id, :source_team)`
 		_, err = tx.NamedExecContext(ctx, assignQuery, links)
 		if err != nil {
 			var pgErr *pq.Error
@@ -67,14 +81,76 @@ func (r *PullRequestRepository) CreateWithReviewers(ctx context.Context, pr *ent
 		}
 	}
 
+	pr.AssignedReviewers = entity.ReviewerIds(reviewers)
+	pr.Reviews = make([]entity.Review, len(reviewers))
+	for i, reviewer := range reviewers {
+		pr.Reviews[i] = entity.Review{ReviewerId: reviewer.ReviewerId, State: entity.ReviewPending}
+	}
+	if err = writeAudit(ctx, tx, entity.AuditPullRequestCreate, entity.AuditEntityPullRequest, pr.Id, nil, *pr); err != nil {
+		return err
+	}
+
+	if err = enqueueWebhook(ctx, tx, entity.EventPullRequestCreated, *pr); err != nil {
+		return err
+	}
+	for _, reviewer := range reviewers {
+		event := entity.ReviewerAssigned{PullRequestId: pr.Id, ReviewerId: reviewer.ReviewerId}
+		if err = enqueueWebhook(ctx, tx, entity.EventReviewerAssigned, event); err != nil {
+			return err
+		}
+	}
+
 	if err = tx.Commit(); err != nil {
 		return domain.WrapError(err, errcodes.InternalServerError, "failed to commit transaction")
 	}
-	pr.AssignedReviewers = reviewerIDs
 	return nil
 }
 
-func (r *PullRequestRepository) Merge(ctx context.Context, prId string) (entity.PullRequest, error) {
+// Merge сливает PR; при requireApprovals слияние блокируется, пока команде автора не хватает одобрений.
+func (r *PullRequestRepository) Merge(ctx context.Context, prId string, requireApprovals bool) (entity.PullRequest, error) {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return entity.PullRequest{}, domain.WrapError(err, errcodes.InternalServerError, "failed to begin transaction")
+	}
+	defer tx.Rollback()
+
+	var before entity.PullRequest
+	prQuery := `
+		SELECT id, name, author_id, status, need_more_reviewers, created_at, merged_at, closed_at
+		FROM pull_requests
+		WHERE id = $1
+		FOR UPDATE`
+	if err = tx.GetContext(ctx, &before, prQuery, prId); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return entity.PullRequest{}, domain.NewError(errcodes.NotFound,
+				fmt.Sprintf("pull request with id '%s' not found", prId))
+		}
+		return entity.PullRequest{}, domain.WrapError(err, errcodes.InternalServerError,
+			"repository: failed to get pull request for merge")
+	}
+
+	// статус мог измениться после проверки перехода в сервисе
+	if before.Status == entity.StatusDraft || before.Status == entity.StatusClosed {
+		return entity.PullRequest{}, domain.NewError(errcodes.InvalidTransition,
+			fmt.Sprintf("cannot merge PR in status %s", before.Status))
+	}
+
+	if before.Reviews, err = currentReviews(ctx, tx, prId); err != nil {
+		return entity.PullRequest{}, err
+	}
+	before.AssignedReviewers = reviewerIdsOf(before.Reviews)
+
+	// повторное слияние ничего не меняет: merged_at, аудит и вебхук остаются от первого
+	if before.Status == entity.StatusMerged {
+		return before, nil
+	}
+
+	if requireApprovals {
+		if err = checkApprovals(ctx, tx, before); err != nil {
+			return entity.PullRequest{}, err
+		}
+	}
+
 	var pr entity.PullRequest
 
 	queryUpdate := `
@@ -85,30 +161,31 @@ func (r *PullRequestRepository) Merge(ctx context.Context, prId string) (entity.
 			merged_at = NOW(),
 			need_more_reviewers = FALSE 
 		WHERE id = $2
-		RETURNING id, name, author_id, status, need_more_reviewers, created_at, merged_at;`
-
-	err := r.db.QueryRowxContext(ctx, queryUpdate, entity.StatusMerged, prId).StructScan(&pr)
-
-	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
-			return entity.PullRequest{}, domain.NewError(errcodes.NotFound,
-				fmt.Sprintf("pull request with id '%s' not found", prId))
-		}
+		RETURNING id, name, author_id, status, need_more_reviewers, created_at, merged_at, closed_at;`
 
+	if err = tx.QueryRowxContext(ctx, queryUpdate, entity.StatusMerged, prId).StructScan(&pr); err != nil {
 		return entity.PullRequest{}, domain.WrapError(err, errcodes.InternalServerError,
 			"repository: failed to execute merge update")
 	}
+	pr.AssignedReviewers = before.AssignedReviewers
+	pr.Reviews = before.Reviews
 
-	reviewersQuery := `SELECT reviewer_id FROM pr_reviewers WHERE pull_request_id = $1`
-	err = r.db.SelectContext(ctx, &pr.AssignedReviewers, reviewersQuery, prId)
-	if err != nil {
-		return entity.PullRequest{}, domain.WrapError(err, errcodes.InternalServerError,
-			"repository: failed to fetch reviewers for merged PR")
+	if err = writeAudit(ctx, tx, entity.AuditPullRequestMerge, entity.AuditEntityPullRequest, pr.Id, before, pr); err != nil {
+		return entity.PullRequest{}, err
+	}
+
+	if err = enqueueWebhook(ctx, tx, entity.EventPullRequestMerged, pr); err != nil {
+		return entity.PullRequest{}, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return entity.PullRequest{}, domain.WrapError(err, errcodes.InternalServerError, "failed to commit transaction")
 	}
 
 	return pr, nil
 }
-func (r *PullRequestRepository) Reassign(ctx context.Context, prId, oldReviewerId string) (
+
+func (r *PullRequestRepository) Reassign(ctx context.Context, prId, oldReviewerId string, pick entity.ReviewerPicker) (
 	entity.PullRequest, string, error) {
 
 	tx, err := r.db.BeginTxx(ctx, nil)
@@ -118,7 +195,7 @@ func (r *PullRequestRepository) Reassign(ctx context.Context, prId, oldReviewerI
 	defer tx.Rollback()
 
 	var pr entity.PullRequest
-	prQuery := `SELECT id, name, author_id, status, created_at, merged_at FROM pull_requests WHERE id = $1 FOR UPDATE`
+	prQuery := `SELECT id, name, author_id, status, need_more_reviewers, created_at, merged_at, closed_at FROM pull_requests WHERE id = $1 FOR UPDATE`
 	err = tx.GetContext(ctx, &pr, prQuery, prId)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
@@ -132,7 +209,8 @@ func (r *PullRequestRepository) Reassign(ctx context.Context, prId, oldReviewerI
 	}
 
 	var currentReviewers []string
-	err = tx.SelectContext(ctx, &currentReviewers, `SELECT reviewer_id FROM pr_reviewers WHERE pull_request_id = $1`, prId)
+	err = tx.SelectContext(ctx, &currentReviewers,
+		`SELECT reviewer_id FROM pr_reviewers WHERE pull_request_id = $1 AND is_current`, prId)
 	if err != nil {
 		return entity.PullRequest{}, "", domain.WrapError(err, errcodes.InternalServerError, "failed to get current reviewers")
 	}
@@ -148,37 +226,34 @@ func (r *PullRequestRepository) Reassign(ctx context.Context, prId, oldReviewerI
 		return entity.PullRequest{}, "", domain.NewError(errcodes.NotAssigned, "old reviewer is not assigned to this pull request")
 	}
 
-	var newReviewerId string
-	findReviewerQuery := `
-        SELECT u.id
-        FROM users u
-        WHERE u.team_id = (
-            SELECT team_id FROM users WHERE id = $2
-        )
-        AND u.is_active = TRUE
-        AND u.id != $2  
+	team, err := teamOfUser(ctx, tx, oldReviewerId)
+	if err != nil {
+		return entity.PullRequest{}, "", err
+	}
+
+	pools, err := candidatePools(ctx, tx, team, `
+        u.id != $2
         AND u.id NOT IN (
-            SELECT reviewer_id FROM pr_reviewers WHERE pull_request_id = $1
+            SELECT reviewer_id FROM pr_reviewers WHERE pull_request_id = $1 AND is_current
             UNION
             SELECT author_id FROM pull_requests WHERE id = $1
-        )
-        ORDER BY RANDOM()
-        LIMIT 1`
-
-	err = tx.GetContext(ctx, &newReviewerId, findReviewerQuery, prId, oldReviewerId)
+        )`, prId, oldReviewerId)
 	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
-			return entity.PullRequest{}, "", domain.NewError(errcodes.NoCandidate, "no replacement candidate found")
-		}
-		return entity.PullRequest{}, "", domain.WrapError(err, errcodes.InternalServerError, "failed to find replacement")
+		return entity.PullRequest{}, "", err
 	}
 
-	_, err = tx.ExecContext(ctx, `DELETE FROM pr_reviewers WHERE pull_request_id = $1 AND reviewer_id = $2`, prId, oldReviewerId)
+	picked := entity.PickFromPools(pools, 1, pick)
+	if len(picked) == 0 {
+		return entity.PullRequest{}, "", domain.NewError(errcodes.NoCandidate, "no replacement candidate found")
+	}
+	newReviewerId := picked[0].ReviewerId
+
+	_, err = tx.ExecContext(ctx, replaceReviewerQuery, prId, oldReviewerId, entity.ReplaceReasonManual)
 	if err != nil {
 		return entity.PullRequest{}, "", domain.WrapError(err, errcodes.InternalServerError, "failed to remove old reviewer")
 	}
 
-	_, err = tx.ExecContext(ctx, `INSERT INTO pr_reviewers (pull_request_id, reviewer_id) VALUES ($1, $2)`, prId, newReviewerId)
+	_, err = tx.ExecContext(ctx, assignReviewerQuery, prId, newReviewerId, picked[0].SourceTeam)
 	if err != nil {
 		return entity.PullRequest{}, "", domain.WrapError(err, errcodes.InternalServerError, "failed to assign new reviewer")
 	}
@@ -190,7 +265,27 @@ func (r *PullRequestRepository) Reassign(ctx context.Context, prId, oldReviewerI
 		}
 	}
 	updatedReviewers = append(updatedReviewers, newReviewerId)
+
+	before := pr
+	before.AssignedReviewers = currentReviewers
 	pr.AssignedReviewers = updatedReviewers
+	if pr.Reviews, err = currentReviews(ctx, tx, prId); err != nil {
+		return entity.PullRequest{}, "", err
+	}
+
+	if err = writeAudit(ctx, tx, entity.AuditPullRequestReassign, entity.AuditEntityPullRequest, pr.Id, before, pr); err != nil {
+		return entity.PullRequest{}, "", err
+	}
+
+	event := entity.ReviewerReplaced{
+		PullRequestId: pr.Id,
+		OldReviewerId: oldReviewerId,
+		NewReviewerId: newReviewerId,
+		Reason:        entity.ReplaceReasonManual,
+	}
+	if err = enqueueWebhook(ctx, tx, entity.EventReviewerReplaced, event); err != nil {
+		return entity.PullRequest{}, "", err
+	}
 
 	_, err = tx.ExecContext(ctx, `UPDATE pull_requests SET updated_at = CURRENT_TIMESTAMP WHERE id = $1`, prId)
 	if err != nil {
@@ -204,26 +299,45 @@ func (r *PullRequestRepository) Reassign(ctx context.Context, prId, oldReviewerI
 	return pr, newReviewerId, nil
 }
 
-func (r *PullRequestRepository) GetUserReviews(ctx context.Context, userId string) ([]entity.PullRequest, error) {
-	const query = `
+// GetUserReviews возвращает PR, где пользователь назначен ревьювером,
+// от последних назначений к ранним, начиная строго после filter.After.
+func (r *PullRequestRepository) GetUserReviews(ctx context.Context, filter entity.UserReviewsFilter) (
+	[]entity.ReviewAssignment, error) {
+
+	query := `
         SELECT
             pr.id,
             pr.name,
             pr.author_id,
-            pr.status
+            pr.status,
+            r.assigned_at
         FROM
-            pull_requests pr
+            pr_reviewers r
+        JOIN
+            pull_requests pr ON pr.id = r.pull_request_id
         WHERE
-            EXISTS (
-                SELECT 1
-                FROM pr_reviewers r
-                WHERE r.pull_request_id = pr.id AND r.reviewer_id = $1
-            )
-    `
+            r.reviewer_id = $1
+            AND r.is_current`
+	args := []any{filter.UserId}
+
+	if filter.Status != "" {
+		args = append(args, filter.Status)
+		query += fmt.Sprintf("\n            AND pr.status = $%d", len(args))
+	}
+	if filter.After != nil {
+		args = append(args, filter.After.At, filter.After.ID)
+		query += fmt.Sprintf("\n            AND (r.assigned_at, pr.id) < ($%d, $%d)", len(args)-1, len(args))
+	}
 
-	var reviews []entity.PullRequest
+	args = append(args, filter.Limit)
+	query += fmt.Sprintf(`
+        ORDER BY
+            r.assigned_at DESC, pr.id DESC
+        LIMIT $%d`, len(args))
 
-	err := r.db.SelectContext(ctx, &reviews, query, userId)
+	var reviews []entity.ReviewAssignment
+
+	err := r.db.SelectContext(ctx, &reviews, query, args...)
 	if err != nil {
 		return nil, domain.WrapError(err, errcodes.InternalServerError, "repository: failed to get user reviews")
 	}
@@ -240,44 +354,72 @@ func (r *PullRequestRepository) AssignToNeedyPRs(ctx context.Context, userID str
 
 	const findPRsQuery = `
         SELECT
-            pr.id
+            pr.id,
+            t.required_reviewers,
+            reviewer.team_id AS source_team
         FROM
             pull_requests pr
+        JOIN users author ON author.id = pr.author_id
+        JOIN teams t ON t.name = COALESCE(pr.review_team, author.team_id)
+        JOIN users reviewer ON reviewer.id = $1
         WHERE
             pr.status = 'OPEN'
             AND pr.need_more_reviewers = TRUE
             AND pr.author_id != $1 
-            AND pr.id IN (
-                SELECT p.id
-                FROM pull_requests p
-                JOIN users author ON p.author_id = author.id
-                WHERE author.team_id = (SELECT team_id FROM users WHERE id = $1)
+            AND (
+                t.name = reviewer.team_id
+                OR EXISTS (
+                    SELECT 1 FROM team_fallbacks f
+                    WHERE f.team_name = t.name AND f.fallback_team = reviewer.team_id
+                )
             )
             AND NOT EXISTS (
-                SELECT 1 FROM pr_reviewers r WHERE r.pull_request_id = pr.id AND r.reviewer_id = $1
+                SELECT 1 FROM pr_reviewers r WHERE r.pull_request_id = pr.id AND r.reviewer_id = $1 AND r.is_current
             )
-        FOR UPDATE; 
+        ORDER BY pr.created_at, pr.id
+        FOR UPDATE OF pr; 
     `
 
-	var prIDsToProcess []string
-	if err = tx.SelectContext(ctx, &prIDsToProcess, findPRsQuery, userID); err != nil {
+	type needyPR struct {
+		ID                string `db:"id"`
+		RequiredReviewers int    `db:"required_reviewers"`
+		SourceTeam        string `db:"source_team"`
+	}
+
+	var prsToProcess []needyPR
+	if err = tx.SelectContext(ctx, &prsToProcess, findPRsQuery, userID); err != nil {
 		return domain.WrapError(err, errcodes.InternalServerError, "worker: failed to find needy pull requests")
 	}
 
-	if len(prIDsToProcess) == 0 {
+	if len(prsToProcess) == 0 {
+		return tx.Commit()
+	}
+
+	// вернувшийся, но скоро снова уходящий пользователь на новые PR не добирается
+	workload, err := selectWorkload(ctx, tx, `u.id = $1 AND`+awayCondition, userID)
+	if err != nil {
+		return err
+	}
+	if len(workload) == 0 {
 		return tx.Commit()
 	}
+	openReviews, limit := workload[0].OpenReviews, workload[0].MaxOpenReviews
 
-	const maxReviewers = 2
+	for _, needy := range prsToProcess {
+		prID := needy.ID
+
+		// ревьювер добирается на PR, начиная с давних, пока не упрётся в свой лимит
+		if limit != nil && openReviews >= *limit {
+			break
+		}
 
-	for _, prID := range prIDsToProcess {
 		var currentReviewerCount int
-		countQuery := `SELECT COUNT(*) FROM pr_reviewers WHERE pull_request_id = $1`
+		countQuery := `SELECT COUNT(*) FROM pr_reviewers WHERE pull_request_id = $1 AND is_current`
 		if err = tx.GetContext(ctx, &currentReviewerCount, countQuery, prID); err != nil {
 			return domain.WrapError(err, errcodes.InternalServerError, "worker: failed to count current reviewers")
 		}
 
-		if currentReviewerCount >= maxReviewers {
+		if currentReviewerCount >= needy.RequiredReviewers {
 			updateFlagQuery := `UPDATE pull_requests SET need_more_reviewers = FALSE, updated_at = NOW() WHERE id = $1`
 			if _, updateErr := tx.ExecContext(ctx, updateFlagQuery, prID); updateErr != nil {
 				return domain.WrapError(updateErr, errcodes.InternalServerError, "worker: failed to fix flag on full PR")
@@ -285,77 +427,314 @@ func (r *PullRequestRepository) AssignToNeedyPRs(ctx context.Context, userID str
 			continue
 		}
 
-		assignQuery := `INSERT INTO pr_reviewers (pull_request_id, reviewer_id) VALUES ($1, $2)`
-		if _, insertErr := tx.ExecContext(ctx, assignQuery, prID, userID); insertErr != nil {
+		before, err := pullRequestSnapshot(ctx, tx, prID)
+		if err != nil {
+			return err
+		}
+
+		if _, insertErr := tx.ExecContext(ctx, assignReviewerQuery, prID, userID, needy.SourceTeam); insertErr != nil {
 			return domain.WrapError(insertErr, errcodes.InternalServerError, "worker: failed to assign new reviewer")
 		}
 
+		event := entity.ReviewerAssigned{PullRequestId: prID, ReviewerId: userID}
+		if err = enqueueWebhook(ctx, tx, entity.EventReviewerAssigned, event); err != nil {
+			return err
+		}
+
+		openReviews++
 		newReviewerCount := currentReviewerCount + 1
-		needsMore := newReviewerCount < maxReviewers
+		needsMore := newReviewerCount < needy.RequiredReviewers
 
 		updateFlagQuery := `UPDATE pull_requests SET need_more_reviewers = $1, updated_at = NOW() WHERE id = $2`
 		if _, updateErr := tx.ExecContext(ctx, updateFlagQuery, needsMore, prID); updateErr != nil {
 			return domain.WrapError(updateErr, errcodes.InternalServerError, "worker: failed to update PR flag")
 		}
+
+		if err = auditReviewersChange(ctx, tx, before); err != nil {
+			return err
+		}
 	}
 
 	return tx.Commit()
 }
 
-func (r *PullRequestRepository) ReassignFromAllPRs(ctx context.Context, userID string) error {
+// ReassignFromAllPRs снимает пользователя со всех открытых PR с указанной причиной
+// и подбирает ему замену из команды ревью каждого PR.
+func (r *PullRequestRepository) ReassignFromAllPRs(ctx context.Context, userID, reason string, pick entity.ReviewerPicker) error {
 	tx, err := r.db.BeginTxx(ctx, nil)
 	if err != nil {
 		return domain.WrapError(err, errcodes.InternalServerError, "failed to begin transaction")
 	}
 	defer tx.Rollback()
 
-	const deleteAndGetPRsQuery = `
-        DELETE FROM pr_reviewers
-        WHERE reviewer_id = $1
-          AND pull_request_id IN (
-              SELECT id FROM pull_requests WHERE status = 'OPEN'
-          )
-        RETURNING pull_request_id;
-    `
-	var affectedPRs []string
-	if err = tx.SelectContext(ctx, &affectedPRs, deleteAndGetPRsQuery, userID); err != nil {
-		return domain.WrapError(err, errcodes.InternalServerError, "worker: failed to delete assignments and get affected PRs")
+	if _, err = replaceOnOpenPRs(ctx, tx, userID, reason, "", pick); err != nil {
+		return err
 	}
 
-	if len(affectedPRs) == 0 {
-		return tx.Commit()
+	return tx.Commit()
+}
+
+// replaceOnOpenPRs снимает пользователя с открытых PR и подбирает замену из команды ревью PR
+// (см. reviewTeamOf). Непустой reviewTeam ограничивает PR, ревьюверы которых набираются из этой команды.
+// Возвращает id затронутых PR.
+func replaceOnOpenPRs(ctx context.Context, tx *sqlx.Tx, userID, reason, reviewTeam string, pick entity.ReviewerPicker) (
+	[]string, error) {
+
+	const affectedPRsQuery = `
+        SELECT pr.id
+        FROM pull_requests pr
+        JOIN pr_reviewers r ON r.pull_request_id = pr.id
+        JOIN users author ON author.id = pr.author_id
+        WHERE r.reviewer_id = $1
+          AND r.is_current
+          AND pr.status = 'OPEN'
+          AND ($2 = '' OR COALESCE(pr.review_team, author.team_id) = $2)
+        ORDER BY pr.id
+        FOR UPDATE OF pr
+    `
+	var affectedPRs []string
+	if err := tx.SelectContext(ctx, &affectedPRs, affectedPRsQuery, userID, reviewTeam); err != nil {
+		return nil, domain.WrapError(err, errcodes.InternalServerError, "repository: failed to get affected PRs")
 	}
 
 	for _, prID := range affectedPRs {
-		findReviewerQuery := `
-            SELECT u.id FROM users u
-            WHERE u.is_active = TRUE
-              AND u.team_id = (SELECT author.team_id FROM users author JOIN pull_requests pr ON author.id = pr.author_id WHERE pr.id = $1)
-              AND u.id != (SELECT author_id FROM pull_requests WHERE id = $1)
-              AND u.id NOT IN (SELECT reviewer_id FROM pr_reviewers WHERE pull_request_id = $1)
-            ORDER BY RANDOM() LIMIT 1
-        `
-		var newReviewerId string
-		err = tx.GetContext(ctx, &newReviewerId, findReviewerQuery, prID)
-
+		before, err := pullRequestSnapshot(ctx, tx, prID)
 		if err != nil {
-			if errors.Is(err, sql.ErrNoRows) {
-				updateFlagQuery := `UPDATE pull_requests SET need_more_reviewers = TRUE, updated_at = NOW() WHERE id = $1`
-				if _, updateErr := tx.ExecContext(ctx, updateFlagQuery, prID); updateErr != nil {
-					return domain.WrapError(updateErr, errcodes.InternalServerError, "worker: failed to mark PR as needy")
-				}
-			} else {
-				return domain.WrapError(err, errcodes.InternalServerError, "worker: failed to find replacement")
+			return nil, err
+		}
+		authorID := before.AuthorId
+
+		if _, err = tx.ExecContext(ctx, replaceReviewerQuery, prID, userID, reason); err != nil {
+			return nil, domain.WrapError(err, errcodes.InternalServerError, "repository: failed to replace assignment")
+		}
+
+		// у PR автора без команды, не переданного другой команде, замену искать негде,
+		// PR просто остаётся без ревьювера
+		var picked []entity.PickedReviewer
+		team, teamErr := reviewTeamOf(ctx, tx, prID)
+		var appErr *domain.AppError
+		switch {
+		case teamErr == nil:
+			// снимаемый ревьювер мог перейти в резервную команду и попасть в её пул
+			pools, poolsErr := candidatePools(ctx, tx, team, `
+              u.id != $2
+              AND u.id != $3
+              AND u.id NOT IN (SELECT reviewer_id FROM pr_reviewers WHERE pull_request_id = $1 AND is_current)`,
+				prID, authorID, userID)
+			if poolsErr != nil {
+				return nil, poolsErr
+			}
+			picked = entity.PickFromPools(pools, 1, pick)
+		case !errors.As(teamErr, &appErr) || appErr.Code != errcodes.NotFound:
+			return nil, teamErr
+		}
+
+		if len(picked) == 0 {
+			updateFlagQuery := `UPDATE pull_requests SET need_more_reviewers = TRUE, updated_at = NOW() WHERE id = $1`
+			if _, updateErr := tx.ExecContext(ctx, updateFlagQuery, prID); updateErr != nil {
+				return nil, domain.WrapError(updateErr, errcodes.InternalServerError, "repository: failed to mark PR as needy")
 			}
 		} else {
-			assignQuery := `INSERT INTO pr_reviewers (pull_request_id, reviewer_id) VALUES ($1, $2)`
-			if _, insertErr := tx.ExecContext(ctx, assignQuery, prID, newReviewerId); insertErr != nil {
-				return domain.WrapError(insertErr, errcodes.InternalServerError, "worker: failed to assign new reviewer")
+			if _, insertErr := tx.ExecContext(ctx, assignReviewerQuery, prID, picked[0].ReviewerId, picked[0].SourceTeam); insertErr != nil {
+				return nil, domain.WrapError(insertErr, errcodes.InternalServerError, "repository: failed to assign new reviewer")
 			}
 			if _, updateErr := tx.ExecContext(ctx, `UPDATE pull_requests SET updated_at = NOW() WHERE id = $1`, prID); updateErr != nil {
-				return domain.WrapError(updateErr, errcodes.InternalServerError, "worker: failed to touch PR on reassign")
+				return nil, domain.WrapError(updateErr, errcodes.InternalServerError, "repository: failed to touch PR on reassign")
 			}
 		}
+
+		event := entity.ReviewerReplaced{PullRequestId: prID, OldReviewerId: userID, Reason: reason}
+		if len(picked) > 0 {
+			event.NewReviewerId = picked[0].ReviewerId
+		}
+		if err = enqueueWebhook(ctx, tx, entity.EventReviewerReplaced, event); err != nil {
+			return nil, err
+		}
+
+		if err = auditReviewersChange(ctx, tx, before); err != nil {
+			return nil, err
+		}
 	}
-	return tx.Commit()
+
+	return affectedPRs, nil
+}
+
+// pullRequestSnapshot читает PR с текущими ревьюверами для журнала аудита.
+func pullRequestSnapshot(ctx context.Context, q sqlx.QueryerContext, prId string) (entity.PullRequest, error) {
+	var pr entity.PullRequest
+	err := sqlx.GetContext(ctx, q, &pr, `
+        SELECT id, name, author_id, status, need_more_reviewers, created_at, merged_at, closed_at
+        FROM pull_requests
+        WHERE id = $1`, prId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return entity.PullRequest{}, domain.NewError(errcodes.NotFound, fmt.Sprintf("pull request with id '%s' not found", prId))
+		}
+		return entity.PullRequest{}, domain.WrapError(err, errcodes.InternalServerError, "repository: failed to get pull request")
+	}
+
+	if pr.Reviews, err = currentReviews(ctx, q, prId); err != nil {
+		return entity.PullRequest{}, err
+	}
+	pr.AssignedReviewers = reviewerIdsOf(pr.Reviews)
+
+	return pr, nil
+}
+
+// auditReviewersChange пишет в журнал смену ревьюверов PR, сделанную без прямого запроса
+// на переназначение: добор воркером, переходы участников между командами, расформирование команды.
+func auditReviewersChange(ctx context.Context, tx *sqlx.Tx, before entity.PullRequest) error {
+	after, err := pullRequestSnapshot(ctx, tx, before.Id)
+	if err != nil {
+		return err
+	}
+
+	return writeAudit(ctx, tx, entity.AuditPullRequestReassign, entity.AuditEntityPullRequest, before.Id, before, after)
+}
+
+func (r *PullRequestRepository) Get(ctx context.Context, prId string) (entity.PullRequest, error) {
+	const query = `
+        SELECT id, name, author_id, status, need_more_reviewers, created_at, merged_at, closed_at
+        FROM pull_requests
+        WHERE id = $1`
+
+	var pr entity.PullRequest
+	if err := r.db.GetContext(ctx, &pr, query, prId); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return entity.PullRequest{}, domain.NewError(errcodes.NotFound,
+				fmt.Sprintf("pull request with id '%s' not found", prId))
+		}
+		return entity.PullRequest{}, domain.WrapError(err, errcodes.InternalServerError, "repository: failed to get pull request")
+	}
+
+	prs := []entity.PullRequest{pr}
+	if err := r.attachReviewers(ctx, prs); err != nil {
+		return entity.PullRequest{}, err
+	}
+
+	return prs[0], nil
+}
+
+// List возвращает PR по фильтру в порядке (created_at, id) по убыванию,
+// начиная строго после filter.After.
+func (r *PullRequestRepository) List(ctx context.Context, filter entity.PullRequestFilter) ([]entity.PullRequest, error) {
+	var (
+		conditions []string
+		args       []any
+	)
+	arg := func(v any) string {
+		args = append(args, v)
+		return fmt.Sprintf("$%d", len(args))
+	}
+
+	if filter.Status != "" {
+		conditions = append(conditions, "pr.status = "+arg(filter.Status))
+	}
+	if filter.AuthorId != "" {
+		conditions = append(conditions, "pr.author_id = "+arg(filter.AuthorId))
+	}
+	if filter.TeamName != "" {
+		conditions = append(conditions, "author.team_id = "+arg(filter.TeamName))
+	}
+	if filter.ReviewerId != "" {
+		conditions = append(conditions,
+			"EXISTS (SELECT 1 FROM pr_reviewers r WHERE r.pull_request_id = pr.id AND r.is_current AND r.reviewer_id = "+arg(filter.ReviewerId)+")")
+	}
+	if filter.NeedMoreReviewers != nil {
+		conditions = append(conditions, "pr.need_more_reviewers = "+arg(*filter.NeedMoreReviewers))
+	}
+	if filter.CreatedFrom != nil {
+		conditions = append(conditions, "pr.created_at >= "+arg(*filter.CreatedFrom))
+	}
+	if filter.CreatedTo != nil {
+		conditions = append(conditions, "pr.created_at < "+arg(*filter.CreatedTo))
+	}
+	if filter.After != nil {
+		conditions = append(conditions, fmt.Sprintf("(pr.created_at, pr.id) < (%s, %s)", arg(filter.After.At), arg(filter.After.ID)))
+	}
+
+	query := `
+        SELECT pr.id, pr.name, pr.author_id, pr.status, pr.need_more_reviewers, pr.created_at, pr.merged_at, pr.closed_at
+        FROM pull_requests pr
+        JOIN users author ON author.id = pr.author_id`
+	if len(conditions) > 0 {
+		query += "\n        WHERE " + strings.Join(conditions, "\n          AND ")
+	}
+	query += "\n        ORDER BY pr.created_at DESC, pr.id DESC\n        LIMIT " + arg(filter.Limit)
+
+	var prs []entity.PullRequest
+	if err := r.db.SelectContext(ctx, &prs, query, args...); err != nil {
+		return nil, domain.WrapError(err, errcodes.InternalServerError, "repository: failed to list pull requests")
+	}
+
+	if err := r.attachReviewers(ctx, prs); err != nil {
+		return nil, err
+	}
+
+	return prs, nil
+}
+
+// attachReviewers одним запросом подтягивает назначенных ревьюверов для набора PR.
+func (r *PullRequestRepository) attachReviewers(ctx context.Context, prs []entity.PullRequest) error {
+	if len(prs) == 0 {
+		return nil
+	}
+
+	ids := make([]string, len(prs))
+	for i, pr := range prs {
+		ids[i] = pr.Id
+	}
+
+	const query = `
+        SELECT pull_request_id, reviewer_id, review_state, review_comment, reviewed_at
+        FROM pr_reviewers
+        WHERE pull_request_id = ANY($1) AND is_current
+        ORDER BY assigned_at, id`
+
+	var links []struct {
+		PRID string `db:"pull_request_id"`
+		entity.Review
+	}
+	if err := r.db.SelectContext(ctx, &links, query, ids); err != nil {
+		return domain.WrapError(err, errcodes.InternalServerError, "repository: failed to get reviewers")
+	}
+
+	byPR := make(map[string][]entity.Review, len(prs))
+	for _, link := range links {
+		byPR[link.PRID] = append(byPR[link.PRID], link.Review)
+	}
+
+	for i := range prs {
+		prs[i].Reviews = byPR[prs[i].Id]
+		if prs[i].Reviews == nil {
+			prs[i].Reviews = []entity.Review{}
+		}
+		prs[i].AssignedReviewers = reviewerIdsOf(prs[i].Reviews)
+	}
+
+	return nil
+}
+
+// History возвращает все назначения ревьюверов на PR, включая снятых, в порядке назначения.
+func (r *PullRequestRepository) History(ctx context.Context, prId string) ([]entity.ReviewerAssignment, error) {
+	var exists bool
+	if err := r.db.GetContext(ctx, &exists, `SELECT EXISTS (SELECT 1 FROM pull_requests WHERE id = $1)`, prId); err != nil {
+		return nil, domain.WrapError(err, errcodes.InternalServerError, "repository: failed to check pull request")
+	}
+	if !exists {
+		return nil, domain.NewError(errcodes.NotFound, fmt.Sprintf("pull request with id '%s' not found", prId))
+	}
+
+	const query = `
+        SELECT reviewer_id, assigned_at, replaced_at, replace_reason, source_team, is_current, review_state
+        FROM pr_reviewers
+        WHERE pull_request_id = $1
+        ORDER BY assigned_at, id`
+
+	history := []entity.ReviewerAssignment{}
+	if err := r.db.SelectContext(ctx, &history, query, prId); err != nil {
+		return nil, domain.WrapError(err, errcodes.InternalServerError, "repository: failed to get reviewer history")
+	}
+
+	return history, nil
 }
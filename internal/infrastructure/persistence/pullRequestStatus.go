@@ -0,0 +1,145 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"pull_requests_service/internal/domain"
+	"pull_requests_service/internal/domain/entity"
+	"pull_requests_service/pkg/errcodes"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// MarkReady переводит черновик в OPEN и назначает подобранных ревьюверов.
+func (r *PullRequestRepository) MarkReady(ctx context.Context, prId string, reviewers []entity.PickedReviewer,
+	needMore bool) (entity.PullRequest, error) {
+
+	return r.open(ctx, prId, entity.StatusDraft, reviewers, needMore, entity.AuditPullRequestReady, entity.EventPullRequestReady)
+}
+
+// Reopen открывает закрытый PR заново и назначает подобранных ревьюверов.
+func (r *PullRequestRepository) Reopen(ctx context.Context, prId string, reviewers []entity.PickedReviewer,
+	needMore bool) (entity.PullRequest, error) {
+
+	return r.open(ctx, prId, entity.StatusClosed, reviewers, needMore, entity.AuditPullRequestReopen, entity.EventPullRequestReopen)
+}
+
+// Close закрывает PR в статусе from без слияния и снимает с него ревьюверов.
+func (r *PullRequestRepository) Close(ctx context.Context, prId, from string) (entity.PullRequest, error) {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return entity.PullRequest{}, domain.WrapError(err, errcodes.InternalServerError, "failed to begin transaction")
+	}
+	defer tx.Rollback()
+
+	before, err := lockPullRequest(ctx, tx, prId, from)
+	if err != nil {
+		return entity.PullRequest{}, err
+	}
+
+	closed, err := closePullRequests(ctx, tx, []string{prId})
+	if err != nil {
+		return entity.PullRequest{}, err
+	}
+	pr := closed[0]
+	pr.Reviews = []entity.Review{}
+	pr.AssignedReviewers = []string{}
+
+	if err = writeAudit(ctx, tx, entity.AuditPullRequestClose, entity.AuditEntityPullRequest, prId, before, pr); err != nil {
+		return entity.PullRequest{}, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return entity.PullRequest{}, domain.WrapError(err, errcodes.InternalServerError, "failed to commit transaction")
+	}
+
+	return pr, nil
+}
+
+func (r *PullRequestRepository) open(ctx context.Context, prId, from string, reviewers []entity.PickedReviewer,
+	needMore bool, auditOp, event string) (entity.PullRequest, error) {
+
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return entity.PullRequest{}, domain.WrapError(err, errcodes.InternalServerError, "failed to begin transaction")
+	}
+	defer tx.Rollback()
+
+	before, err := lockPullRequest(ctx, tx, prId, from)
+	if err != nil {
+		return entity.PullRequest{}, err
+	}
+
+	var pr entity.PullRequest
+	err = tx.GetContext(ctx, &pr, `
+        UPDATE pull_requests
+        SET status = $2, closed_at = NULL, need_more_reviewers = $3, updated_at = NOW()
+        WHERE id = $1
+        RETURNING id, name, author_id, status, need_more_reviewers, created_at, merged_at, closed_at`,
+		prId, entity.StatusOpen, needMore)
+	if err != nil {
+		return entity.PullRequest{}, domain.WrapError(err, errcodes.InternalServerError, "repository: failed to open pull request")
+	}
+
+	for _, reviewer := range reviewers {
+		if _, err = tx.ExecContext(ctx, assignReviewerQuery, prId, reviewer.ReviewerId, reviewer.SourceTeam); err != nil {
+			return entity.PullRequest{}, domain.WrapError(err, errcodes.InternalServerError, "repository: failed to assign reviewer")
+		}
+	}
+
+	if pr.Reviews, err = currentReviews(ctx, tx, prId); err != nil {
+		return entity.PullRequest{}, err
+	}
+	pr.AssignedReviewers = reviewerIdsOf(pr.Reviews)
+
+	if err = writeAudit(ctx, tx, auditOp, entity.AuditEntityPullRequest, prId, before, pr); err != nil {
+		return entity.PullRequest{}, err
+	}
+
+	if err = enqueueWebhook(ctx, tx, event, pr); err != nil {
+		return entity.PullRequest{}, err
+	}
+	for _, reviewer := range reviewers {
+		assigned := entity.ReviewerAssigned{PullRequestId: prId, ReviewerId: reviewer.ReviewerId}
+		if err = enqueueWebhook(ctx, tx, entity.EventReviewerAssigned, assigned); err != nil {
+			return entity.PullRequest{}, err
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return entity.PullRequest{}, domain.WrapError(err, errcodes.InternalServerError, "failed to commit transaction")
+	}
+
+	return pr, nil
+}
+
+// lockPullRequest блокирует PR с его текущими ревьюверами. Если статус успел измениться
+// после проверки в сервисе, переход отклоняется.
+func lockPullRequest(ctx context.Context, tx *sqlx.Tx, prId, from string) (entity.PullRequest, error) {
+	var pr entity.PullRequest
+	err := tx.GetContext(ctx, &pr, `
+        SELECT id, name, author_id, status, need_more_reviewers, created_at, merged_at, closed_at
+        FROM pull_requests
+        WHERE id = $1
+        FOR UPDATE`, prId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return entity.PullRequest{}, domain.NewError(errcodes.NotFound, fmt.Sprintf("pull request with id '%s' not found", prId))
+		}
+		return entity.PullRequest{}, domain.WrapError(err, errcodes.InternalServerError, "repository: failed to get pull request")
+	}
+
+	if pr.Status != from {
+		return entity.PullRequest{}, domain.NewError(errcodes.InvalidTransition,
+			fmt.Sprintf("pull request status changed from %s to %s", from, pr.Status))
+	}
+
+	if pr.Reviews, err = currentReviews(ctx, tx, prId); err != nil {
+		return entity.PullRequest{}, err
+	}
+	pr.AssignedReviewers = reviewerIdsOf(pr.Reviews)
+
+	return pr, nil
+}
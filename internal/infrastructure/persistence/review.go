@@ -0,0 +1,142 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"pull_requests_service/internal/domain"
+	"pull_requests_service/internal/domain/entity"
+	"pull_requests_service/pkg/errcodes"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// SubmitReview сохраняет ревью текущего ревьювера открытого PR, повторное ревью заменяет предыдущее.
+func (r *PullRequestRepository) SubmitReview(ctx context.Context, prId string, review entity.Review) (entity.PullRequest, error) {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return entity.PullRequest{}, domain.WrapError(err, errcodes.InternalServerError, "failed to begin transaction")
+	}
+	defer tx.Rollback()
+
+	var pr entity.PullRequest
+	err = tx.GetContext(ctx, &pr, `
+        SELECT id, name, author_id, status, need_more_reviewers, created_at, merged_at, closed_at
+        FROM pull_requests
+        WHERE id = $1
+        FOR UPDATE`, prId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return entity.PullRequest{}, domain.NewError(errcodes.NotFound, fmt.Sprintf("pull request with id '%s' not found", prId))
+		}
+		return entity.PullRequest{}, domain.WrapError(err, errcodes.InternalServerError, "repository: failed to get pull request")
+	}
+
+	switch pr.Status {
+	case entity.StatusMerged:
+		return entity.PullRequest{}, domain.NewError(errcodes.PrMerged, "cannot review merged PR")
+	case entity.StatusClosed:
+		return entity.PullRequest{}, domain.NewError(errcodes.InvalidTransition, "cannot review closed PR")
+	}
+
+	before := pr
+	if before.Reviews, err = currentReviews(ctx, tx, prId); err != nil {
+		return entity.PullRequest{}, err
+	}
+	before.AssignedReviewers = reviewerIdsOf(before.Reviews)
+
+	result, err := tx.ExecContext(ctx, `
+        UPDATE pr_reviewers
+        SET review_state = $3, review_comment = $4, reviewed_at = NOW()
+        WHERE pull_request_id = $1 AND reviewer_id = $2 AND is_current`,
+		prId, review.ReviewerId, review.State, review.Comment)
+	if err != nil {
+		return entity.PullRequest{}, domain.WrapError(err, errcodes.InternalServerError, "repository: failed to save review")
+	}
+	if updated, _ := result.RowsAffected(); updated == 0 {
+		return entity.PullRequest{}, domain.NewError(errcodes.NotAssigned, "reviewer is not assigned to this pull request")
+	}
+
+	_, err = tx.ExecContext(ctx, `UPDATE pull_requests SET updated_at = NOW() WHERE id = $1`, prId)
+	if err != nil {
+		return entity.PullRequest{}, domain.WrapError(err, errcodes.InternalServerError, "failed to update pull request timestamp")
+	}
+
+	if pr.Reviews, err = currentReviews(ctx, tx, prId); err != nil {
+		return entity.PullRequest{}, err
+	}
+	pr.AssignedReviewers = reviewerIdsOf(pr.Reviews)
+
+	if err = writeAudit(ctx, tx, entity.AuditPullRequestReview, entity.AuditEntityPullRequest, pr.Id, before, pr); err != nil {
+		return entity.PullRequest{}, err
+	}
+
+	event := entity.ReviewSubmitted{
+		PullRequestId: pr.Id,
+		ReviewerId:    review.ReviewerId,
+		State:         review.State,
+		Comment:       review.Comment,
+	}
+	if err = enqueueWebhook(ctx, tx, entity.EventReviewSubmitted, event); err != nil {
+		return entity.PullRequest{}, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return entity.PullRequest{}, domain.WrapError(err, errcodes.InternalServerError, "failed to commit transaction")
+	}
+
+	return pr, nil
+}
+
+// checkApprovals не даёт слить PR, пока команда ревью PR (см. reviewTeamOf) требует одобрений
+// и их меньше required_reviewers. У PR без такой команды одобрения не требуются.
+func checkApprovals(ctx context.Context, q sqlx.QueryerContext, pr entity.PullRequest) error {
+	var team entity.Team
+	err := sqlx.GetContext(ctx, q, &team, `
+        SELECT t.name, t.required_reviewers, t.require_approvals
+        FROM pull_requests p
+        JOIN users u ON u.id = p.author_id
+        JOIN teams t ON t.name = COALESCE(p.review_team, u.team_id)
+        WHERE p.id = $1`, pr.Id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil
+		}
+		return domain.WrapError(err, errcodes.InternalServerError, "repository: failed to get review team")
+	}
+
+	if !team.RequireApprovals {
+		return nil
+	}
+	if approvals := entity.CountApprovals(pr.Reviews); approvals < team.RequiredReviewers {
+		return domain.NewError(errcodes.NotApproved,
+			fmt.Sprintf("pull request has %d of %d required approvals", approvals, team.RequiredReviewers))
+	}
+
+	return nil
+}
+
+// currentReviews возвращает ревью текущих ревьюверов PR в порядке назначения.
+func currentReviews(ctx context.Context, q sqlx.QueryerContext, prId string) ([]entity.Review, error) {
+	reviews := []entity.Review{}
+	err := sqlx.SelectContext(ctx, q, &reviews, `
+        SELECT reviewer_id, review_state, review_comment, reviewed_at
+        FROM pr_reviewers
+        WHERE pull_request_id = $1 AND is_current
+        ORDER BY assigned_at, id`, prId)
+	if err != nil {
+		return nil, domain.WrapError(err, errcodes.InternalServerError, "repository: failed to get reviews")
+	}
+
+	return reviews, nil
+}
+
+func reviewerIdsOf(reviews []entity.Review) []string {
+	ids := make([]string, len(reviews))
+	for i, review := range reviews {
+		ids[i] = review.ReviewerId
+	}
+
+	return ids
+}
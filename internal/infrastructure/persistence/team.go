@@ -12,6 +12,9 @@ import (
 	"pull_requests_service/pkg/errcodes"
 )
 
+// teamColumns — колонки entity.Team, кроме резервных команд, которые лежат в отдельной таблице.
+const teamColumns = `name, reviewer_strategy, required_reviewers, created_at, archived_at, max_open_reviews, require_approvals`
+
 type TeamRepository struct {
 	db *sqlx.DB
 }
@@ -20,28 +23,58 @@ func NewTeamRepository(db *sqlx.DB) *TeamRepository {
 	return &TeamRepository{db: db}
 }
 
-func (r *TeamRepository) Create(ctx context.Context, team entity.Team) (entity.Team, error) {
+// Create создаёт команду вместе с участниками в одной транзакции. Новые пользователи создаются,
+// существующие переводятся в команду так же, как MoveMember; их остальные поля не меняются.
+func (r *TeamRepository) Create(ctx context.Context, team entity.Team, members []entity.User, pick entity.ReviewerPicker) (
+	entity.Team, []entity.User, error) {
+
 	query := `
-        INSERT INTO teams (name)
-        VALUES ($1)
-        RETURNING name, created_at;
-    `
+        INSERT INTO teams (name, reviewer_strategy, required_reviewers, max_open_reviews, require_approvals)
+        VALUES ($1, $2, $3, $4, $5)
+        RETURNING ` + teamColumns
 	var createdTeam entity.Team
 
-	err := r.db.GetContext(ctx, &createdTeam, query, team.Name)
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return entity.Team{}, nil, domain.WrapError(err, errcodes.InternalServerError, "failed to begin transaction")
+	}
+	defer tx.Rollback()
+
+	err = tx.GetContext(ctx, &createdTeam, query, team.Name, team.ReviewerStrategy, team.RequiredReviewers, team.MaxOpenReviews,
+		team.RequireApprovals)
 	if err != nil {
 		var pgErr *pgconn.PgError
 		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
-			return entity.Team{}, domain.NewError(errcodes.TeamAlreadyExists, fmt.Sprintf("team with name '%s' already exists", team.Name))
+			return entity.Team{}, nil, domain.NewError(errcodes.TeamAlreadyExists, fmt.Sprintf("team with name '%s' already exists", team.Name))
+		}
+		return entity.Team{}, nil, domain.WrapError(err, errcodes.InternalServerError, "repository: failed to create team")
+	}
+
+	if err = replaceFallbackTeams(ctx, tx, createdTeam.Name, team.FallbackTeams); err != nil {
+		return entity.Team{}, nil, err
+	}
+	createdTeam.FallbackTeams = team.FallbackTeams
+
+	if err = writeAudit(ctx, tx, entity.AuditTeamCreate, entity.AuditEntityTeam, createdTeam.Name, nil, createdTeam); err != nil {
+		return entity.Team{}, nil, err
+	}
+
+	createdMembers := make([]entity.User, len(members))
+	for i, member := range members {
+		if createdMembers[i], err = joinTeam(ctx, tx, createdTeam.Name, member, pick); err != nil {
+			return entity.Team{}, nil, err
 		}
-		return entity.Team{}, domain.WrapError(err, errcodes.InternalServerError, "repository: failed to create team")
 	}
 
-	return createdTeam, nil
+	if err = tx.Commit(); err != nil {
+		return entity.Team{}, nil, domain.WrapError(err, errcodes.InternalServerError, "failed to commit transaction")
+	}
+
+	return createdTeam, createdMembers, nil
 }
 
 func (r *TeamRepository) Get(ctx context.Context, name string) (entity.Team, error) {
-	query := `SELECT name, created_at FROM teams WHERE name = $1`
+	query := `SELECT ` + teamColumns + ` FROM teams WHERE name = $1`
 
 	var foundTeam entity.Team
 
@@ -52,5 +85,165 @@ func (r *TeamRepository) Get(ctx context.Context, name string) (entity.Team, err
 		}
 		return entity.Team{}, domain.WrapError(err, errcodes.InternalServerError, "repository: failed to get team")
 	}
+
+	if foundTeam.FallbackTeams, err = fallbackTeamNames(ctx, r.db, name); err != nil {
+		return entity.Team{}, err
+	}
 	return foundTeam, nil
 }
+
+// UpdateSettings меняет настройки назначения, пересчитывает need_more_reviewers у открытых PR,
+// ревьюверы которых набираются из команды (см. reviewTeamOf), и сразу добирает недостающих,
+// если required_reviewers вырос.
+func (r *TeamRepository) UpdateSettings(ctx context.Context, team entity.Team, pick entity.ReviewerPicker) (entity.Team, error) {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return entity.Team{}, domain.WrapError(err, errcodes.InternalServerError, "failed to begin transaction")
+	}
+	defer tx.Rollback()
+
+	var previousTeam entity.Team
+	err = tx.GetContext(ctx, &previousTeam,
+		`SELECT `+teamColumns+` FROM teams WHERE name = $1 FOR UPDATE`, team.Name)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return entity.Team{}, domain.NewError(errcodes.NotFound, fmt.Sprintf("team with name '%s' not found", team.Name))
+		}
+		return entity.Team{}, domain.WrapError(err, errcodes.InternalServerError, "repository: failed to get team")
+	}
+	if previousTeam.FallbackTeams, err = fallbackTeamNames(ctx, tx, team.Name); err != nil {
+		return entity.Team{}, err
+	}
+
+	query := `
+        UPDATE teams
+        SET reviewer_strategy = $2, required_reviewers = $3, max_open_reviews = $4, require_approvals = $5
+        WHERE name = $1
+        RETURNING ` + teamColumns
+	var updatedTeam entity.Team
+	err = tx.GetContext(ctx, &updatedTeam, query, team.Name, team.ReviewerStrategy, team.RequiredReviewers, team.MaxOpenReviews,
+		team.RequireApprovals)
+	if err != nil {
+		return entity.Team{}, domain.WrapError(err, errcodes.InternalServerError, "repository: failed to update team settings")
+	}
+
+	if err = replaceFallbackTeams(ctx, tx, team.Name, team.FallbackTeams); err != nil {
+		return entity.Team{}, err
+	}
+	updatedTeam.FallbackTeams = team.FallbackTeams
+
+	recalcQuery := `
+        UPDATE pull_requests pr
+        SET need_more_reviewers = (
+                SELECT COUNT(*) FROM pr_reviewers r WHERE r.pull_request_id = pr.id AND r.is_current
+            ) < $2,
+            updated_at = NOW()
+        FROM users author
+        WHERE author.id = pr.author_id
+          AND COALESCE(pr.review_team, author.team_id) = $1
+          AND pr.status = 'OPEN'`
+	if _, err = tx.ExecContext(ctx, recalcQuery, team.Name, team.RequiredReviewers); err != nil {
+		return entity.Team{}, domain.WrapError(err, errcodes.InternalServerError, "repository: failed to recalculate reviewer demand")
+	}
+
+	if err = staffNeedyPRs(ctx, tx, updatedTeam, pick); err != nil {
+		return entity.Team{}, err
+	}
+
+	err = writeAudit(ctx, tx, entity.AuditTeamUpdateSettings, entity.AuditEntityTeam, updatedTeam.Name, previousTeam, updatedTeam)
+	if err != nil {
+		return entity.Team{}, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return entity.Team{}, domain.WrapError(err, errcodes.InternalServerError, "failed to commit transaction")
+	}
+
+	return updatedTeam, nil
+}
+
+// staffNeedyPRs добирает ревьюверов на открытые PR команды до её required_reviewers.
+// PR, на которые кандидатов не хватило, остаются need_more_reviewers и ждут воркера событий.
+func staffNeedyPRs(ctx context.Context, tx *sqlx.Tx, team entity.Team, pick entity.ReviewerPicker) error {
+	var prIDs []string
+	err := tx.SelectContext(ctx, &prIDs, `
+        SELECT pr.id
+        FROM pull_requests pr
+        JOIN users author ON author.id = pr.author_id
+        WHERE COALESCE(pr.review_team, author.team_id) = $1
+          AND pr.status = 'OPEN'
+          AND pr.need_more_reviewers
+        ORDER BY pr.created_at, pr.id
+        FOR UPDATE OF pr`, team.Name)
+	if err != nil {
+		return domain.WrapError(err, errcodes.InternalServerError, "repository: failed to get needy pull requests of team")
+	}
+
+	for _, prID := range prIDs {
+		before, err := pullRequestSnapshot(ctx, tx, prID)
+		if err != nil {
+			return err
+		}
+
+		current := len(before.AssignedReviewers)
+		pools, err := candidatePools(ctx, tx, team, `
+              u.id != $2
+              AND u.id NOT IN (SELECT reviewer_id FROM pr_reviewers WHERE pull_request_id = $1 AND is_current)`,
+			prID, before.AuthorId)
+		if err != nil {
+			return err
+		}
+		picked := entity.PickFromPools(pools, team.RequiredReviewers-current, pick)
+		if len(picked) == 0 {
+			continue
+		}
+
+		for _, reviewer := range picked {
+			if _, err = tx.ExecContext(ctx, assignReviewerQuery, prID, reviewer.ReviewerId, reviewer.SourceTeam); err != nil {
+				return domain.WrapError(err, errcodes.InternalServerError, "repository: failed to assign new reviewer")
+			}
+			event := entity.ReviewerAssigned{PullRequestId: prID, ReviewerId: reviewer.ReviewerId}
+			if err = enqueueWebhook(ctx, tx, entity.EventReviewerAssigned, event); err != nil {
+				return err
+			}
+		}
+
+		updateQuery := `UPDATE pull_requests SET need_more_reviewers = $2, updated_at = NOW() WHERE id = $1`
+		if _, err = tx.ExecContext(ctx, updateQuery, prID, current+len(picked) < team.RequiredReviewers); err != nil {
+			return domain.WrapError(err, errcodes.InternalServerError, "repository: failed to update PR after staffing")
+		}
+
+		if err = auditReviewersChange(ctx, tx, before); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// replaceFallbackTeams заменяет список резервных команд; порядок в списке задаёт приоритет.
+func replaceFallbackTeams(ctx context.Context, tx *sqlx.Tx, teamName string, fallbacks []string) error {
+	if _, err := tx.ExecContext(ctx, `DELETE FROM team_fallbacks WHERE team_name = $1`, teamName); err != nil {
+		return domain.WrapError(err, errcodes.InternalServerError, "repository: failed to clear fallback teams")
+	}
+
+	return addFallbackTeams(ctx, tx, teamName, fallbacks, lockTeam)
+}
+
+// addFallbackTeams добавляет резервные команды, проверяя каждую через getTeam (lockTeam или shareTeam).
+func addFallbackTeams(ctx context.Context, tx *sqlx.Tx, teamName string, fallbacks []string,
+	getTeam func(ctx context.Context, tx *sqlx.Tx, teamName string) (entity.Team, error)) error {
+
+	for priority, fallback := range fallbacks {
+		if _, err := getTeam(ctx, tx, fallback); err != nil {
+			return err
+		}
+
+		insertQuery := `INSERT INTO team_fallbacks (team_name, fallback_team, priority) VALUES ($1, $2, $3)`
+		if _, err := tx.ExecContext(ctx, insertQuery, teamName, fallback, priority); err != nil {
+			return domain.WrapError(err, errcodes.InternalServerError, "repository: failed to add fallback team")
+		}
+	}
+
+	return nil
+}
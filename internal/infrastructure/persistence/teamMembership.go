@@ -0,0 +1,265 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"pull_requests_service/internal/domain"
+	"pull_requests_service/internal/domain/entity"
+	"pull_requests_service/pkg/errcodes"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// AddMember добавляет в команду нового пользователя или пользователя без команды.
+// Участника другой команды нужно переводить через MoveMember, чтобы перераспределить его ревью.
+func (r *TeamRepository) AddMember(ctx context.Context, teamName string, user entity.User) (entity.User, error) {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return entity.User{}, domain.WrapError(err, errcodes.InternalServerError, "failed to begin transaction")
+	}
+	defer tx.Rollback()
+
+	if _, err = lockTeam(ctx, tx, teamName); err != nil {
+		return entity.User{}, err
+	}
+
+	var before any
+	previousUser, err := lockUser(ctx, tx, user.Id)
+	var appErr *domain.AppError
+	switch {
+	case err == nil:
+		if previousUser.Team != "" && previousUser.Team != teamName {
+			return entity.User{}, domain.NewError(errcodes.UserAlreadyExists,
+				fmt.Sprintf("user '%s' already belongs to team '%s'", user.Id, previousUser.Team))
+		}
+		before = previousUser
+	case !errors.As(err, &appErr) || appErr.Code != errcodes.NotFound:
+		return entity.User{}, err
+	}
+
+	query := `
+        INSERT INTO users (id, name, is_active, team_id, seniority)
+        VALUES ($1, $2, $3, $4, $5)
+        ON CONFLICT (id) DO UPDATE SET
+            name = EXCLUDED.name,
+            is_active = EXCLUDED.is_active,
+            team_id = EXCLUDED.team_id,
+            seniority = EXCLUDED.seniority
+        RETURNING ` + userColumns
+
+	var addedUser entity.User
+	err = tx.GetContext(ctx, &addedUser, query, user.Id, user.Name, user.IsActive, teamName, user.Seniority)
+	if err != nil {
+		return entity.User{}, domain.WrapError(err, errcodes.InternalServerError, "repository: failed to add team member")
+	}
+
+	if err = writeAudit(ctx, tx, entity.AuditTeamAddMember, entity.AuditEntityUser, addedUser.Id, before, addedUser); err != nil {
+		return entity.User{}, err
+	}
+
+	// новый участник может закрыть нехватку ревьюверов у открытых PR команды
+	event := entity.UserTeamChanged{UserId: addedUser.Id, Team: teamName}
+	if err = enqueueOutbox(ctx, tx, entity.OutboxUserTeamChanged, event); err != nil {
+		return entity.User{}, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return entity.User{}, domain.WrapError(err, errcodes.InternalServerError, "failed to commit transaction")
+	}
+
+	return addedUser, nil
+}
+
+// RemoveMember выводит пользователя из команды и в той же транзакции снимает его
+// с открытых PR авторов этой команды, подбирая замену.
+func (r *TeamRepository) RemoveMember(ctx context.Context, teamName, userId string, pick entity.ReviewerPicker) (
+	entity.MembershipChange, error) {
+
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return entity.MembershipChange{}, domain.WrapError(err, errcodes.InternalServerError, "failed to begin transaction")
+	}
+	defer tx.Rollback()
+
+	previousUser, err := lockUser(ctx, tx, userId)
+	if err != nil {
+		return entity.MembershipChange{}, err
+	}
+	if previousUser.Team != teamName {
+		return entity.MembershipChange{}, domain.NewError(errcodes.NotFound,
+			fmt.Sprintf("user '%s' is not a member of team '%s'", userId, teamName))
+	}
+
+	change, err := changeTeam(ctx, tx, previousUser, "", pick)
+	if err != nil {
+		return entity.MembershipChange{}, err
+	}
+
+	if err = writeAudit(ctx, tx, entity.AuditTeamRemoveMember, entity.AuditEntityUser, userId, previousUser, change.User); err != nil {
+		return entity.MembershipChange{}, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return entity.MembershipChange{}, domain.WrapError(err, errcodes.InternalServerError, "failed to commit transaction")
+	}
+
+	return change, nil
+}
+
+// MoveMember переводит пользователя в другую команду и в той же транзакции снимает его
+// с открытых PR авторов прежней команды. Перевод в текущую команду ничего не меняет.
+func (r *TeamRepository) MoveMember(ctx context.Context, userId, teamName string, pick entity.ReviewerPicker) (
+	entity.MembershipChange, error) {
+
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return entity.MembershipChange{}, domain.WrapError(err, errcodes.InternalServerError, "failed to begin transaction")
+	}
+	defer tx.Rollback()
+
+	if _, err = lockTeam(ctx, tx, teamName); err != nil {
+		return entity.MembershipChange{}, err
+	}
+
+	previousUser, err := lockUser(ctx, tx, userId)
+	if err != nil {
+		return entity.MembershipChange{}, err
+	}
+	if previousUser.Team == teamName {
+		return entity.MembershipChange{User: previousUser, ReassignedPullRequests: []string{}}, nil
+	}
+
+	change, err := changeTeam(ctx, tx, previousUser, teamName, pick)
+	if err != nil {
+		return entity.MembershipChange{}, err
+	}
+
+	if err = writeAudit(ctx, tx, entity.AuditTeamMoveMember, entity.AuditEntityUser, userId, previousUser, change.User); err != nil {
+		return entity.MembershipChange{}, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return entity.MembershipChange{}, domain.WrapError(err, errcodes.InternalServerError, "failed to commit transaction")
+	}
+
+	return change, nil
+}
+
+// joinTeam добавляет участника создаваемой команды: нового пользователя создаёт, существующего переводит
+// через changeTeam, чтобы его ревью в прежней команде перераспределились.
+func joinTeam(ctx context.Context, tx *sqlx.Tx, teamName string, member entity.User, pick entity.ReviewerPicker) (
+	entity.User, error) {
+
+	previousUser, err := lockUser(ctx, tx, member.Id)
+	var appErr *domain.AppError
+	switch {
+	case err == nil:
+		change, changeErr := changeTeam(ctx, tx, previousUser, teamName, pick)
+		if changeErr != nil {
+			return entity.User{}, changeErr
+		}
+		operation := entity.AuditTeamMoveMember
+		if previousUser.Team == "" {
+			operation = entity.AuditTeamAddMember
+		}
+		if err = writeAudit(ctx, tx, operation, entity.AuditEntityUser, member.Id, previousUser, change.User); err != nil {
+			return entity.User{}, err
+		}
+		return change.User, nil
+	case !errors.As(err, &appErr) || appErr.Code != errcodes.NotFound:
+		return entity.User{}, err
+	}
+
+	var createdUser entity.User
+	err = tx.GetContext(ctx, &createdUser, `
+        INSERT INTO users (id, name, is_active, team_id, seniority)
+        VALUES ($1, $2, $3, $4, $5)
+        RETURNING `+userColumns,
+		member.Id, member.Name, member.IsActive, teamName, member.Seniority)
+	if err != nil {
+		return entity.User{}, domain.WrapError(err, errcodes.InternalServerError, "repository: failed to create team member")
+	}
+
+	if err = writeAudit(ctx, tx, entity.AuditTeamAddMember, entity.AuditEntityUser, createdUser.Id, nil, createdUser); err != nil {
+		return entity.User{}, err
+	}
+
+	// новый участник может закрыть нехватку ревьюверов у PR команд, для которых эта команда резервная
+	event := entity.UserTeamChanged{UserId: createdUser.Id, Team: teamName}
+	if err = enqueueOutbox(ctx, tx, entity.OutboxUserTeamChanged, event); err != nil {
+		return entity.User{}, err
+	}
+
+	return createdUser, nil
+}
+
+// changeTeam меняет команду пользователя (пустая строка — без команды) и перераспределяет
+// его ревью в прежней команде. Назначение на PR новой команды делает воркер по событию из outbox.
+func changeTeam(ctx context.Context, tx *sqlx.Tx, user entity.User, teamName string, pick entity.ReviewerPicker) (
+	entity.MembershipChange, error) {
+
+	var updatedUser entity.User
+	err := tx.GetContext(ctx, &updatedUser,
+		`UPDATE users SET team_id = NULLIF($2, '') WHERE id = $1 RETURNING `+userColumns, user.Id, teamName)
+	if err != nil {
+		return entity.MembershipChange{}, domain.WrapError(err, errcodes.InternalServerError, "repository: failed to change user team")
+	}
+
+	reassigned := []string{}
+	if user.Team != "" {
+		affected, replaceErr := replaceOnOpenPRs(ctx, tx, user.Id, entity.ReplaceReasonTeamMove, user.Team, pick)
+		if replaceErr != nil {
+			return entity.MembershipChange{}, replaceErr
+		}
+		reassigned = append(reassigned, affected...)
+	}
+
+	event := entity.UserTeamChanged{UserId: user.Id, Team: teamName}
+	if err = enqueueOutbox(ctx, tx, entity.OutboxUserTeamChanged, event); err != nil {
+		return entity.MembershipChange{}, err
+	}
+
+	return entity.MembershipChange{User: updatedUser, ReassignedPullRequests: reassigned}, nil
+}
+
+// lockTeam проверяет, что команда существует и не в архиве, и не даёт удалить её до конца транзакции.
+func lockTeam(ctx context.Context, tx *sqlx.Tx, teamName string) (entity.Team, error) {
+	team, err := shareTeam(ctx, tx, teamName)
+	if err != nil {
+		return entity.Team{}, err
+	}
+	if team.ArchivedAt != nil {
+		return entity.Team{}, domain.NewError(errcodes.InvalidArgument, fmt.Sprintf("team '%s' is archived", teamName))
+	}
+
+	return team, nil
+}
+
+// shareTeam как lockTeam, но пропускает архивные команды: импорт восстанавливает выгрузку вместе с ними.
+func shareTeam(ctx context.Context, tx *sqlx.Tx, teamName string) (entity.Team, error) {
+	var team entity.Team
+	err := tx.GetContext(ctx, &team, `SELECT `+teamColumns+` FROM teams WHERE name = $1 FOR SHARE`, teamName)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return entity.Team{}, domain.NewError(errcodes.NotFound, fmt.Sprintf("team with name '%s' not found", teamName))
+		}
+		return entity.Team{}, domain.WrapError(err, errcodes.InternalServerError, "repository: failed to get team")
+	}
+
+	return team, nil
+}
+
+func lockUser(ctx context.Context, tx *sqlx.Tx, userId string) (entity.User, error) {
+	var user entity.User
+	err := tx.GetContext(ctx, &user, `SELECT `+userColumns+` FROM users WHERE id = $1 FOR UPDATE`, userId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return entity.User{}, domain.NewError(errcodes.NotFound, fmt.Sprintf("user with id '%s' not found", userId))
+		}
+		return entity.User{}, domain.WrapError(err, errcodes.InternalServerError, "repository: failed to get user")
+	}
+
+	return user, nil
+}
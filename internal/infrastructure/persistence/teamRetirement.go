@@ -0,0 +1,275 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"pull_requests_service/internal/domain"
+	"pull_requests_service/internal/domain/entity"
+	"pull_requests_service/pkg/errcodes"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/samber/lo"
+)
+
+// Archive архивирует команду: участники деактивируются и снимаются с открытых ревью,
+// открытые PR их авторства закрываются или передаются на ревью в другую команду по policy.
+func (r *TeamRepository) Archive(ctx context.Context, teamName string, policy entity.TeamRetirePolicy,
+	pick entity.ReviewerPicker) (entity.TeamRetirement, error) {
+
+	return r.retire(ctx, teamName, policy, pick, false)
+}
+
+// Delete удаляет команду: участники остаются без команды и снимаются с открытых ревью,
+// открытые PR их авторства обрабатываются так же, как при архивации.
+func (r *TeamRepository) Delete(ctx context.Context, teamName string, policy entity.TeamRetirePolicy,
+	pick entity.ReviewerPicker) (entity.TeamRetirement, error) {
+
+	return r.retire(ctx, teamName, policy, pick, true)
+}
+
+func (r *TeamRepository) retire(ctx context.Context, teamName string, policy entity.TeamRetirePolicy,
+	pick entity.ReviewerPicker, remove bool) (entity.TeamRetirement, error) {
+
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return entity.TeamRetirement{}, domain.WrapError(err, errcodes.InternalServerError, "failed to begin transaction")
+	}
+	defer tx.Rollback()
+
+	var previousTeam entity.Team
+	err = tx.GetContext(ctx, &previousTeam, `SELECT `+teamColumns+` FROM teams WHERE name = $1 FOR UPDATE`, teamName)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return entity.TeamRetirement{}, domain.NewError(errcodes.NotFound, fmt.Sprintf("team with name '%s' not found", teamName))
+		}
+		return entity.TeamRetirement{}, domain.WrapError(err, errcodes.InternalServerError, "repository: failed to get team")
+	}
+	if !remove && previousTeam.ArchivedAt != nil {
+		return entity.TeamRetirement{}, domain.NewError(errcodes.InvalidArgument, fmt.Sprintf("team '%s' is already archived", teamName))
+	}
+
+	var targetTeam entity.Team
+	if policy.PullRequests == entity.RetirePolicyReassign {
+		if targetTeam, err = lockTeam(ctx, tx, policy.TargetTeam); err != nil {
+			return entity.TeamRetirement{}, err
+		}
+	}
+
+	summary := entity.TeamRetirement{
+		Team:                   teamName,
+		Members:                []string{},
+		ClosedPullRequests:     []string{},
+		ReassignedPullRequests: []string{},
+	}
+	err = tx.SelectContext(ctx, &summary.Members, `SELECT id FROM users WHERE team_id = $1 ORDER BY id FOR UPDATE`, teamName)
+	if err != nil {
+		return entity.TeamRetirement{}, domain.WrapError(err, errcodes.InternalServerError, "repository: failed to get team members")
+	}
+
+	reason := entity.ReplaceReasonDeactivation
+	if remove {
+		reason = entity.ReplaceReasonTeamMove
+	}
+
+	type memberPR struct {
+		Id     string `db:"id"`
+		Status string `db:"status"`
+	}
+	// PR, переданные команде раньше при расформировании других команд, обрабатываются наравне с PR участников
+	var activePRs []memberPR
+	err = tx.SelectContext(ctx, &activePRs, `
+        SELECT id, status FROM pull_requests
+        WHERE status IN ('OPEN', 'DRAFT') AND (author_id = ANY($1) OR review_team = $2)
+        ORDER BY id
+        FOR UPDATE`, summary.Members, teamName)
+	if err != nil {
+		return entity.TeamRetirement{}, domain.WrapError(err, errcodes.InternalServerError, "repository: failed to get open PRs of team")
+	}
+
+	switch policy.PullRequests {
+	case entity.RetirePolicyClose:
+		prIDs := lo.Map(activePRs, func(pr memberPR, _ int) string { return pr.Id })
+		if _, err = closePullRequests(ctx, tx, prIDs); err != nil {
+			return entity.TeamRetirement{}, err
+		}
+		summary.ClosedPullRequests = append(summary.ClosedPullRequests, prIDs...)
+	case entity.RetirePolicyReassign:
+		// черновики остаются без ревьюверов, их подберут из targetTeam при переводе в OPEN
+		for _, pr := range activePRs {
+			if pr.Status != entity.StatusOpen {
+				_, err = tx.ExecContext(ctx, `UPDATE pull_requests SET review_team = $2, updated_at = NOW() WHERE id = $1`,
+					pr.Id, targetTeam.Name)
+				if err != nil {
+					return entity.TeamRetirement{}, domain.WrapError(err, errcodes.InternalServerError,
+						"repository: failed to hand over draft PR")
+				}
+				continue
+			}
+			if err = handOverReviews(ctx, tx, pr.Id, summary.Members, targetTeam, reason, pick); err != nil {
+				return entity.TeamRetirement{}, err
+			}
+			summary.ReassignedPullRequests = append(summary.ReassignedPullRequests, pr.Id)
+		}
+	}
+
+	membersQuery := `UPDATE users SET is_active = FALSE WHERE team_id = $1`
+	if remove {
+		membersQuery = `UPDATE users SET team_id = NULL WHERE team_id = $1`
+	}
+	if _, err = tx.ExecContext(ctx, membersQuery, teamName); err != nil {
+		return entity.TeamRetirement{}, domain.WrapError(err, errcodes.InternalServerError, "repository: failed to update team members")
+	}
+
+	// бывшие участники больше не кандидаты, их ревью в других командах тоже переназначаются
+	for _, memberID := range summary.Members {
+		affected, replaceErr := replaceOnOpenPRs(ctx, tx, memberID, reason, "", pick)
+		if replaceErr != nil {
+			return entity.TeamRetirement{}, replaceErr
+		}
+		summary.ReassignedPullRequests = append(summary.ReassignedPullRequests, affected...)
+	}
+	summary.ReassignedPullRequests = lo.Uniq(summary.ReassignedPullRequests)
+
+	var after any
+	operation := entity.AuditTeamDelete
+	if remove {
+		if _, err = tx.ExecContext(ctx, `DELETE FROM teams WHERE name = $1`, teamName); err != nil {
+			return entity.TeamRetirement{}, domain.WrapError(err, errcodes.InternalServerError, "repository: failed to delete team")
+		}
+	} else {
+		var archivedTeam entity.Team
+		err = tx.GetContext(ctx, &archivedTeam,
+			`UPDATE teams SET archived_at = NOW() WHERE name = $1 RETURNING `+teamColumns, teamName)
+		if err != nil {
+			return entity.TeamRetirement{}, domain.WrapError(err, errcodes.InternalServerError, "repository: failed to archive team")
+		}
+		after = archivedTeam
+		operation = entity.AuditTeamArchive
+	}
+
+	if err = writeAudit(ctx, tx, operation, entity.AuditEntityTeam, teamName, previousTeam, after); err != nil {
+		return entity.TeamRetirement{}, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return entity.TeamRetirement{}, domain.WrapError(err, errcodes.InternalServerError, "failed to commit transaction")
+	}
+
+	return summary, nil
+}
+
+// closePullRequests закрывает PR без слияния и снимает с них текущих ревьюверов: записи остаются
+// в истории, а освободившиеся ревьюверы добираются воркером событий на другие PR.
+func closePullRequests(ctx context.Context, tx *sqlx.Tx, prIDs []string) ([]entity.PullRequest, error) {
+	if len(prIDs) == 0 {
+		return nil, nil
+	}
+
+	var closed []entity.PullRequest
+	err := tx.SelectContext(ctx, &closed, `
+        UPDATE pull_requests
+        SET status = $2, closed_at = NOW(), need_more_reviewers = FALSE, updated_at = NOW()
+        WHERE id = ANY($1)
+        RETURNING id, name, author_id, status, need_more_reviewers, created_at, merged_at, closed_at`,
+		prIDs, entity.StatusClosed)
+	if err != nil {
+		return nil, domain.WrapError(err, errcodes.InternalServerError, "repository: failed to close pull requests")
+	}
+
+	for i := range closed {
+		pr := &closed[i]
+		err = tx.SelectContext(ctx, &pr.AssignedReviewers, `
+            UPDATE pr_reviewers
+            SET is_current = FALSE, replaced_at = NOW(), replace_reason = $2
+            WHERE pull_request_id = $1 AND is_current
+            RETURNING reviewer_id`, pr.Id, entity.ReplaceReasonPRClosed)
+		if err != nil {
+			return nil, domain.WrapError(err, errcodes.InternalServerError, "repository: failed to release reviewers of closed PR")
+		}
+
+		// в событии остаются ревьюверы, которые были на PR в момент закрытия
+		if err = enqueueWebhook(ctx, tx, entity.EventPullRequestClosed, *pr); err != nil {
+			return nil, err
+		}
+		for _, reviewerID := range pr.AssignedReviewers {
+			released := entity.ReviewerReleased{UserId: reviewerID, PullRequestId: pr.Id}
+			if err = enqueueOutbox(ctx, tx, entity.OutboxReviewerReleased, released); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return closed, nil
+}
+
+// handOverReviews снимает с PR ревьюверов из members и добирает замену из targetTeam
+// до её required_reviewers. targetTeam становится командой ревью PR: из её пулов ревьюверы
+// добираются и дальше. Если кандидатов не хватает, PR помечается need_more_reviewers.
+func handOverReviews(ctx context.Context, tx *sqlx.Tx, prID string, members []string, targetTeam entity.Team,
+	reason string, pick entity.ReviewerPicker) error {
+
+	before, err := pullRequestSnapshot(ctx, tx, prID)
+	if err != nil {
+		return err
+	}
+
+	var replaced []string
+	err = tx.SelectContext(ctx, &replaced, `
+        UPDATE pr_reviewers
+        SET is_current = FALSE, replaced_at = NOW(), replace_reason = $3
+        WHERE pull_request_id = $1 AND reviewer_id = ANY($2) AND is_current
+        RETURNING reviewer_id`, prID, members, reason)
+	if err != nil {
+		return domain.WrapError(err, errcodes.InternalServerError, "repository: failed to replace team reviewers")
+	}
+
+	var current int
+	err = tx.GetContext(ctx, &current, `SELECT COUNT(*) FROM pr_reviewers WHERE pull_request_id = $1 AND is_current`, prID)
+	if err != nil {
+		return domain.WrapError(err, errcodes.InternalServerError, "repository: failed to count current reviewers")
+	}
+
+	var picked []entity.PickedReviewer
+	if need := targetTeam.RequiredReviewers - current; need > 0 {
+		pools, poolsErr := candidatePools(ctx, tx, targetTeam, `
+              u.id != (SELECT author_id FROM pull_requests WHERE id = $1)
+              AND u.id NOT IN (SELECT reviewer_id FROM pr_reviewers WHERE pull_request_id = $1 AND is_current)`,
+			prID)
+		if poolsErr != nil {
+			return poolsErr
+		}
+		picked = entity.PickFromPools(pools, need, pick)
+	}
+
+	for _, reviewer := range picked {
+		if _, err = tx.ExecContext(ctx, assignReviewerQuery, prID, reviewer.ReviewerId, reviewer.SourceTeam); err != nil {
+			return domain.WrapError(err, errcodes.InternalServerError, "repository: failed to assign new reviewer")
+		}
+	}
+
+	updateQuery := `UPDATE pull_requests SET need_more_reviewers = $2, review_team = $3, updated_at = NOW() WHERE id = $1`
+	needMore := current+len(picked) < targetTeam.RequiredReviewers
+	if _, err = tx.ExecContext(ctx, updateQuery, prID, needMore, targetTeam.Name); err != nil {
+		return domain.WrapError(err, errcodes.InternalServerError, "repository: failed to update PR after hand over")
+	}
+
+	for i, oldReviewerID := range replaced {
+		event := entity.ReviewerReplaced{PullRequestId: prID, OldReviewerId: oldReviewerID, Reason: reason}
+		if i < len(picked) {
+			event.NewReviewerId = picked[i].ReviewerId
+		}
+		if err = enqueueWebhook(ctx, tx, entity.EventReviewerReplaced, event); err != nil {
+			return err
+		}
+	}
+	for _, reviewer := range picked[min(len(replaced), len(picked)):] {
+		event := entity.ReviewerAssigned{PullRequestId: prID, ReviewerId: reviewer.ReviewerId}
+		if err = enqueueWebhook(ctx, tx, entity.EventReviewerAssigned, event); err != nil {
+			return err
+		}
+	}
+
+	return auditReviewersChange(ctx, tx, before)
+}
@@ -12,6 +12,9 @@ import (
 	"pull_requests_service/pkg/errcodes"
 )
 
+// userColumns — колонки entity.User; у пользователя, выведенного из команды, team_id пуст.
+const userColumns = `id, name, is_active, COALESCE(team_id, '') AS team_id, seniority, max_open_reviews, created_at`
+
 type UserRepository struct {
 	db *sqlx.DB
 }
@@ -28,39 +31,8 @@ func isUniqueConstraintError(err error) bool {
 	return false
 }
 
-func (r *UserRepository) Create(ctx context.Context, user entity.User) (entity.User, error) {
-	query := `
-        INSERT INTO users (id, name, is_active, team_id)
-        VALUES (:id, :name, :is_active, :team_id)
-        ON CONFLICT (id) DO UPDATE SET
-            name = EXCLUDED.name,
-            is_active = EXCLUDED.is_active,
-            team_id = EXCLUDED.team_id
-        RETURNING id, name, is_active, team_id, created_at;
-    `
-
-	rows, err := r.db.NamedQueryContext(ctx, query, user)
-	if err != nil {
-		if isUniqueConstraintError(err) {
-			return entity.User{}, domain.NewError(errcodes.UserAlreadyExists, fmt.Sprintf("user with id '%s' already exists", user.Id))
-		}
-		return entity.User{}, domain.WrapError(err, errcodes.InternalServerError, "repository: failed to create/update user")
-	}
-	defer rows.Close()
-
-	if rows.Next() {
-		var createdUser entity.User
-		if err := rows.StructScan(&createdUser); err != nil {
-			return entity.User{}, domain.WrapError(err, errcodes.InternalServerError, "repository: failed to scan created user")
-		}
-		return createdUser, nil
-	}
-
-	return entity.User{}, domain.NewError(errcodes.InternalServerError, "repository: user was not created or updated, no rows returned")
-}
-
 func (r *UserRepository) GetById(ctx context.Context, userId string) (entity.User, error) {
-	query := `SELECT id, name, is_active, team_id, created_at FROM users WHERE id = $1`
+	query := `SELECT ` + userColumns + ` FROM users WHERE id = $1`
 
 	var foundUser entity.User
 	err := r.db.GetContext(ctx, &foundUser, query, userId)
@@ -75,7 +47,7 @@ func (r *UserRepository) GetById(ctx context.Context, userId string) (entity.Use
 }
 
 func (r *UserRepository) GetByTeam(ctx context.Context, teamName string) ([]entity.User, error) {
-	query := `SELECT id, name, is_active, team_id, created_at FROM users WHERE team_id = $1`
+	query := `SELECT ` + userColumns + ` FROM users WHERE team_id = $1`
 
 	var users []entity.User
 	err := r.db.SelectContext(ctx, &users, query, teamName)
@@ -87,66 +59,201 @@ func (r *UserRepository) GetByTeam(ctx context.Context, teamName string) ([]enti
 }
 
 func (r *UserRepository) SetIsActive(ctx context.Context, userId string, isActive bool) (entity.User, error) {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return entity.User{}, domain.WrapError(err, errcodes.InternalServerError, "failed to begin transaction")
+	}
+	defer tx.Rollback()
+
+	var previousUser entity.User
+	err = tx.GetContext(ctx, &previousUser,
+		`SELECT `+userColumns+` FROM users WHERE id = $1 FOR UPDATE`, userId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return entity.User{}, domain.NewError(errcodes.NotFound, fmt.Sprintf("user with id '%s' not found for update", userId))
+		}
+		return entity.User{}, domain.WrapError(err, errcodes.InternalServerError, "repository: failed to get user")
+	}
+
+	updatedUser, err := updateIsActive(ctx, tx, previousUser, isActive)
+	if err != nil {
+		return entity.User{}, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return entity.User{}, domain.WrapError(err, errcodes.InternalServerError, "failed to commit transaction")
+	}
+
+	return updatedUser, nil
+}
+
+// updateIsActive меняет активность заблокированного пользователя с записью в аудит и outbox.
+func updateIsActive(ctx context.Context, tx *sqlx.Tx, previousUser entity.User, isActive bool) (entity.User, error) {
 	query := `
         UPDATE users
         SET is_active = $1
         WHERE id = $2
-        RETURNING id, name, is_active, team_id, created_at;
-    `
+        RETURNING ` + userColumns
 
 	var updatedUser entity.User
-	err := r.db.GetContext(ctx, &updatedUser, query, isActive, userId)
+	err := tx.GetContext(ctx, &updatedUser, query, isActive, previousUser.Id)
 	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
-			return entity.User{}, domain.NewError(errcodes.NotFound, fmt.Sprintf("user with id '%s' not found for update", userId))
-		}
 		return entity.User{}, domain.WrapError(err, errcodes.InternalServerError, "repository: failed to set user active status")
 	}
 
+	err = writeAudit(ctx, tx, entity.AuditUserSetIsActive, entity.AuditEntityUser, updatedUser.Id, previousUser, updatedUser)
+	if err != nil {
+		return entity.User{}, err
+	}
+
+	// перераспределение ревью выполняет воркер, событие фиксируется вместе с самим изменением
+	event := entity.UserActivityChanged{UserId: updatedUser.Id, IsActive: updatedUser.IsActive}
+	if err = enqueueOutbox(ctx, tx, entity.OutboxUserActivityChanged, event); err != nil {
+		return entity.User{}, err
+	}
+
 	return updatedUser, nil
 }
 
-func (r *UserRepository) GetActiveTeamCandidatesId(ctx context.Context, authorID string) ([]string, error) {
+// GetCandidatePools возвращает кандидатов в ревьюверы PR автора: его команду и её резервные команды.
+func (r *UserRepository) GetCandidatePools(ctx context.Context, authorID string) ([]entity.CandidatePool, error) {
 	tx, err := r.db.BeginTxx(ctx, nil)
 	if err != nil {
 		return nil, domain.WrapError(err, errcodes.InternalServerError, "failed to begin transaction")
 	}
 	defer tx.Rollback()
 
-	var authorExists bool
-	checkQuery := `SELECT EXISTS(SELECT 1 FROM users WHERE id = $1)`
-	err = tx.GetContext(ctx, &authorExists, checkQuery, authorID)
+	var author struct {
+		Team     *string `db:"team_id"`
+		Archived bool    `db:"archived"`
+	}
+	checkQuery := `
+        SELECT u.team_id, t.archived_at IS NOT NULL AS archived
+        FROM users u
+        LEFT JOIN teams t ON t.name = u.team_id
+        WHERE u.id = $1`
+	err = tx.GetContext(ctx, &author, checkQuery, authorID)
 	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.NewError(errcodes.NotFound, fmt.Sprintf("author with id '%s' not found", authorID))
+		}
 		return nil, domain.WrapError(err, errcodes.InternalServerError, "repository: failed to check author existence")
 	}
 
-	if !authorExists {
-		return nil, domain.NewError(errcodes.NotFound, fmt.Sprintf("author with id '%s' not found", authorID))
+	// после удаления команды у пользователя может не быть команды, назначать ревьюверов не из кого
+	if author.Team == nil {
+		return nil, domain.NewError(errcodes.NotFound, fmt.Sprintf("author '%s' is not a member of any team", authorID))
+	}
+	if author.Archived {
+		return nil, domain.NewError(errcodes.NotFound, fmt.Sprintf("team '%s' of author '%s' is archived", *author.Team, authorID))
 	}
 
-	query := `
-        SELECT id
-        FROM users
-        WHERE team_id = (SELECT team_id FROM users WHERE id = $1)
-          AND is_active = TRUE
-          AND id != $1
-        ORDER BY RANDOM()
-        LIMIT 2;
-    `
-	var candidateIDs []string
-	err = tx.SelectContext(ctx, &candidateIDs, query, authorID)
+	team, err := teamOfUser(ctx, tx, authorID)
+	if err != nil {
+		return nil, err
+	}
+
+	pools, err := candidatePools(ctx, tx, team, `u.id != $1`, authorID)
 	if err != nil {
-		return nil, domain.WrapError(err, errcodes.InternalServerError, "repository: failed to get active team candidates")
+		return nil, err
 	}
 
-	// Вся остальная логика остается без изменений
 	if err = tx.Commit(); err != nil {
 		return nil, domain.WrapError(err, errcodes.InternalServerError, "repository: failed to commit transaction")
 	}
 
-	return candidateIDs, nil
+	return pools, nil
+}
+
+// GetReviewPools возвращает кандидатов в ревьюверы существующего PR. Для PR, переданного другой команде
+// при расформировании команды автора, это её пулы, для остальных — пулы команды автора, как в GetCandidatePools.
+func (r *UserRepository) GetReviewPools(ctx context.Context, prID string) ([]entity.CandidatePool, error) {
+	var pr struct {
+		AuthorId   string  `db:"author_id"`
+		ReviewTeam *string `db:"review_team"`
+	}
+	err := r.db.GetContext(ctx, &pr, `SELECT author_id, review_team FROM pull_requests WHERE id = $1`, prID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.NewError(errcodes.NotFound, fmt.Sprintf("pull request with id '%s' not found", prID))
+		}
+		return nil, domain.WrapError(err, errcodes.InternalServerError, "repository: failed to get pull request")
+	}
+	if pr.ReviewTeam == nil {
+		return r.GetCandidatePools(ctx, pr.AuthorId)
+	}
+
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, domain.WrapError(err, errcodes.InternalServerError, "failed to begin transaction")
+	}
+	defer tx.Rollback()
+
+	team, err := reviewTeamOf(ctx, tx, prID)
+	if err != nil {
+		return nil, err
+	}
+	if team.ArchivedAt != nil {
+		return nil, domain.NewError(errcodes.NotFound, fmt.Sprintf("review team '%s' of pull request '%s' is archived", team.Name, prID))
+	}
+
+	pools, err := candidatePools(ctx, tx, team, `u.id != $1`, pr.AuthorId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, domain.WrapError(err, errcodes.InternalServerError, "repository: failed to commit transaction")
+	}
+
+	return pools, nil
+}
+
+// SetMaxOpenReviews задаёт личный лимит открытых ревью, nil — действует лимит команды.
+// Уже назначенные ревью не снимаются, лимит учитывается при следующих назначениях.
+func (r *UserRepository) SetMaxOpenReviews(ctx context.Context, userId string, maxOpenReviews *int) (entity.UserWorkload, error) {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return entity.UserWorkload{}, domain.WrapError(err, errcodes.InternalServerError, "failed to begin transaction")
+	}
+	defer tx.Rollback()
+
+	previousUser, err := lockUser(ctx, tx, userId)
+	if err != nil {
+		return entity.UserWorkload{}, err
+	}
+
+	var updatedUser entity.User
+	err = tx.GetContext(ctx, &updatedUser,
+		`UPDATE users SET max_open_reviews = $2 WHERE id = $1 RETURNING `+userColumns, userId, maxOpenReviews)
+	if err != nil {
+		return entity.UserWorkload{}, domain.WrapError(err, errcodes.InternalServerError, "repository: failed to set user review limit")
+	}
+
+	err = writeAudit(ctx, tx, entity.AuditUserSetCapacity, entity.AuditEntityUser, updatedUser.Id, previousUser, updatedUser)
+	if err != nil {
+		return entity.UserWorkload{}, err
+	}
+
+	workload, err := selectWorkload(ctx, tx, `u.id = $1`, userId)
+	if err != nil {
+		return entity.UserWorkload{}, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return entity.UserWorkload{}, domain.WrapError(err, errcodes.InternalServerError, "failed to commit transaction")
+	}
+
+	return workload[0], nil
+}
+
+// GetWorkload возвращает нагрузку пользователей команды или всех, если teamName пуст,
+// от самых загруженных к свободным.
+func (r *UserRepository) GetWorkload(ctx context.Context, teamName string) ([]entity.UserWorkload, error) {
+	return selectWorkload(ctx, r.db, `($1 = '' OR u.team_id = $1)`, teamName)
 }
 
+// GetUserAssignmentStats считает текущие назначения; заменённые ревьюверы и освобождённые закрытием PR не учитываются.
 func (r *UserRepository) GetUserAssignmentStats(ctx context.Context) ([]entity.UserAssignmentStat, error) {
 	const query = `
         SELECT
@@ -156,7 +263,7 @@ func (r *UserRepository) GetUserAssignmentStats(ctx context.Context) ([]entity.U
         FROM
             users u
         LEFT JOIN
-            pr_reviewers pr ON u.id = pr.reviewer_id
+            pr_reviewers pr ON u.id = pr.reviewer_id AND pr.is_current
         GROUP BY
             u.id, u.name
         ORDER BY
@@ -0,0 +1,313 @@
+package persistence
+
+import (
+	"context"
+	"crypto/rand"
+	"database/sql"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"pull_requests_service/internal/domain"
+	"pull_requests_service/internal/domain/entity"
+	"pull_requests_service/pkg/errcodes"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+// enqueueWebhook создаёт доставки события всем активным подписчикам в транзакции самого изменения.
+// Таблица доставок сама служит outbox'ом: отправкой занимается воркер.
+func enqueueWebhook(ctx context.Context, tx sqlx.ExecerContext, eventType string, data any) error {
+	eventID, err := newEventID()
+	if err != nil {
+		return err
+	}
+
+	payload, err := json.Marshal(entity.WebhookEnvelope{
+		Id:         eventID,
+		Type:       eventType,
+		OccurredAt: time.Now().UTC(),
+		Data:       data,
+	})
+	if err != nil {
+		return domain.WrapError(err, errcodes.InternalServerError, "repository: failed to marshal webhook payload")
+	}
+
+	const query = `
+        INSERT INTO webhook_deliveries (subscription_id, event_id, event_type, payload)
+        SELECT id, $1, $2, $3::jsonb
+        FROM webhook_subscriptions
+        WHERE is_active AND $2 = ANY(events)`
+	if _, err = tx.ExecContext(ctx, query, eventID, eventType, string(payload)); err != nil {
+		return domain.WrapError(err, errcodes.InternalServerError, "repository: failed to enqueue webhook deliveries")
+	}
+
+	return nil
+}
+
+func newEventID() (string, error) {
+	buf := make([]byte, 16)
+	if _, err := rand.Read(buf); err != nil {
+		return "", domain.WrapError(err, errcodes.InternalServerError, "repository: failed to generate event id")
+	}
+
+	return hex.EncodeToString(buf), nil
+}
+
+// webhookRow нужен, потому что TEXT[] через database/sql читается только в pq.StringArray.
+type webhookRow struct {
+	entity.WebhookSubscription
+	Events pq.StringArray `db:"events"`
+}
+
+func (row webhookRow) toEntity() entity.WebhookSubscription {
+	sub := row.WebhookSubscription
+	sub.Events = []string(row.Events)
+	return sub
+}
+
+const webhookColumns = `id, url, secret, events, is_active, created_at`
+
+type WebhookRepository struct {
+	db *sqlx.DB
+}
+
+func NewWebhookRepository(db *sqlx.DB) *WebhookRepository {
+	return &WebhookRepository{db: db}
+}
+
+func (r *WebhookRepository) Create(ctx context.Context, sub entity.WebhookSubscription) (entity.WebhookSubscription, error) {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return entity.WebhookSubscription{}, domain.WrapError(err, errcodes.InternalServerError, "failed to begin transaction")
+	}
+	defer tx.Rollback()
+
+	query := `
+        INSERT INTO webhook_subscriptions (url, secret, events, is_active)
+        VALUES ($1, $2, $3, $4)
+        RETURNING ` + webhookColumns
+
+	var row webhookRow
+	if err = tx.GetContext(ctx, &row, query, sub.Url, sub.Secret, sub.Events, sub.IsActive); err != nil {
+		return entity.WebhookSubscription{}, domain.WrapError(err, errcodes.InternalServerError, "repository: failed to create webhook")
+	}
+	created := row.toEntity()
+
+	err = writeAudit(ctx, tx, entity.AuditWebhookCreate, entity.AuditEntityWebhook, strconv.FormatInt(created.Id, 10), nil, created)
+	if err != nil {
+		return entity.WebhookSubscription{}, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return entity.WebhookSubscription{}, domain.WrapError(err, errcodes.InternalServerError, "failed to commit transaction")
+	}
+
+	return created, nil
+}
+
+func (r *WebhookRepository) Get(ctx context.Context, id int64) (entity.WebhookSubscription, error) {
+	return getWebhook(ctx, r.db, id, false)
+}
+
+func getWebhook(ctx context.Context, q sqlx.QueryerContext, id int64, forUpdate bool) (entity.WebhookSubscription, error) {
+	query := `SELECT ` + webhookColumns + ` FROM webhook_subscriptions WHERE id = $1`
+	if forUpdate {
+		query += ` FOR UPDATE`
+	}
+
+	var row webhookRow
+	if err := sqlx.GetContext(ctx, q, &row, query, id); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return entity.WebhookSubscription{}, domain.NewError(errcodes.NotFound, fmt.Sprintf("webhook with id %d not found", id))
+		}
+		return entity.WebhookSubscription{}, domain.WrapError(err, errcodes.InternalServerError, "repository: failed to get webhook")
+	}
+
+	return row.toEntity(), nil
+}
+
+func (r *WebhookRepository) List(ctx context.Context) ([]entity.WebhookSubscription, error) {
+	var rows []webhookRow
+	if err := r.db.SelectContext(ctx, &rows, `SELECT `+webhookColumns+` FROM webhook_subscriptions ORDER BY id`); err != nil {
+		return nil, domain.WrapError(err, errcodes.InternalServerError, "repository: failed to list webhooks")
+	}
+
+	subs := make([]entity.WebhookSubscription, len(rows))
+	for i, row := range rows {
+		subs[i] = row.toEntity()
+	}
+
+	return subs, nil
+}
+
+func (r *WebhookRepository) Update(ctx context.Context, sub entity.WebhookSubscription) (entity.WebhookSubscription, error) {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return entity.WebhookSubscription{}, domain.WrapError(err, errcodes.InternalServerError, "failed to begin transaction")
+	}
+	defer tx.Rollback()
+
+	previous, err := getWebhook(ctx, tx, sub.Id, true)
+	if err != nil {
+		return entity.WebhookSubscription{}, err
+	}
+
+	query := `
+        UPDATE webhook_subscriptions
+        SET url = $2, events = $3, is_active = $4
+        WHERE id = $1
+        RETURNING ` + webhookColumns
+
+	var row webhookRow
+	if err = tx.GetContext(ctx, &row, query, sub.Id, sub.Url, sub.Events, sub.IsActive); err != nil {
+		return entity.WebhookSubscription{}, domain.WrapError(err, errcodes.InternalServerError, "repository: failed to update webhook")
+	}
+	updated := row.toEntity()
+
+	err = writeAudit(ctx, tx, entity.AuditWebhookUpdate, entity.AuditEntityWebhook, strconv.FormatInt(updated.Id, 10), previous, updated)
+	if err != nil {
+		return entity.WebhookSubscription{}, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return entity.WebhookSubscription{}, domain.WrapError(err, errcodes.InternalServerError, "failed to commit transaction")
+	}
+
+	return updated, nil
+}
+
+// Delete удаляет подписку вместе с журналом её доставок.
+func (r *WebhookRepository) Delete(ctx context.Context, id int64) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return domain.WrapError(err, errcodes.InternalServerError, "failed to begin transaction")
+	}
+	defer tx.Rollback()
+
+	previous, err := getWebhook(ctx, tx, id, true)
+	if err != nil {
+		return err
+	}
+
+	if _, err = tx.ExecContext(ctx, `DELETE FROM webhook_subscriptions WHERE id = $1`, id); err != nil {
+		return domain.WrapError(err, errcodes.InternalServerError, "repository: failed to delete webhook")
+	}
+
+	err = writeAudit(ctx, tx, entity.AuditWebhookDelete, entity.AuditEntityWebhook, strconv.FormatInt(id, 10), previous, nil)
+	if err != nil {
+		return err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return domain.WrapError(err, errcodes.InternalServerError, "failed to commit transaction")
+	}
+
+	return nil
+}
+
+const deliveryColumns = `d.id, d.subscription_id, d.event_id, d.event_type, d.payload, d.status, d.attempts,
+            d.last_status_code, d.last_error, d.created_at, d.delivered_at`
+
+// ListDeliveries возвращает журнал доставок подписки от новых к старым, начиная строго после filter.After.
+func (r *WebhookRepository) ListDeliveries(ctx context.Context, filter entity.WebhookDeliveryFilter) (
+	[]entity.WebhookDelivery, error) {
+
+	conditions := []string{"d.subscription_id = $1"}
+	args := []any{filter.SubscriptionId}
+	arg := func(v any) string {
+		args = append(args, v)
+		return fmt.Sprintf("$%d", len(args))
+	}
+
+	if filter.Status != "" {
+		conditions = append(conditions, "d.status = "+arg(filter.Status))
+	}
+	if filter.After != nil {
+		id, err := strconv.ParseInt(filter.After.ID, 10, 64)
+		if err != nil {
+			return nil, domain.WrapError(err, errcodes.InvalidArgument, "malformed cursor")
+		}
+		conditions = append(conditions, fmt.Sprintf("(d.created_at, d.id) < (%s, %s)", arg(filter.After.At), arg(id)))
+	}
+
+	query := `
+        SELECT ` + deliveryColumns + `
+        FROM webhook_deliveries d
+        WHERE ` + strings.Join(conditions, "\n          AND ") + `
+        ORDER BY d.created_at DESC, d.id DESC
+        LIMIT ` + arg(filter.Limit)
+
+	var deliveries []entity.WebhookDelivery
+	if err := r.db.SelectContext(ctx, &deliveries, query, args...); err != nil {
+		return nil, domain.WrapError(err, errcodes.InternalServerError, "repository: failed to list webhook deliveries")
+	}
+
+	return deliveries, nil
+}
+
+// ClaimDeliveries забирает до limit ожидающих доставок активных подписок,
+// пропуская занятые другими репликами, и скрывает их на время lease.
+func (r *WebhookRepository) ClaimDeliveries(ctx context.Context, limit int, lease time.Duration) (
+	[]entity.WebhookDeliveryJob, error) {
+
+	query := `
+        UPDATE webhook_deliveries d
+        SET attempts = d.attempts + 1,
+            next_attempt_at = NOW() + $2 * INTERVAL '1 millisecond'
+        FROM webhook_subscriptions s
+        WHERE s.id = d.subscription_id
+          AND d.id IN (
+              SELECT pending.id
+              FROM webhook_deliveries pending
+              JOIN webhook_subscriptions sub ON sub.id = pending.subscription_id
+              WHERE pending.status = 'pending'
+                AND pending.next_attempt_at <= NOW()
+                AND sub.is_active
+              ORDER BY pending.next_attempt_at, pending.id
+              LIMIT $1
+              FOR UPDATE OF pending SKIP LOCKED
+          )
+        RETURNING ` + deliveryColumns + `, s.url, s.secret`
+
+	var jobs []entity.WebhookDeliveryJob
+	if err := r.db.SelectContext(ctx, &jobs, query, limit, lease.Milliseconds()); err != nil {
+		return nil, domain.WrapError(err, errcodes.InternalServerError, "repository: failed to claim webhook deliveries")
+	}
+
+	return jobs, nil
+}
+
+func (r *WebhookRepository) MarkDelivered(ctx context.Context, id int64, statusCode int) error {
+	const query = `
+        UPDATE webhook_deliveries
+        SET status = 'delivered', last_status_code = $2, last_error = NULL, delivered_at = NOW()
+        WHERE id = $1`
+	if _, err := r.db.ExecContext(ctx, query, id, statusCode); err != nil {
+		return domain.WrapError(err, errcodes.InternalServerError, "repository: failed to mark webhook delivered")
+	}
+
+	return nil
+}
+
+// MarkFailed сохраняет результат неудачной попытки. Если final, доставка больше не повторяется.
+func (r *WebhookRepository) MarkFailed(ctx context.Context, id int64, statusCode *int, cause string,
+	retryIn time.Duration, final bool) error {
+
+	const query = `
+        UPDATE webhook_deliveries
+        SET status = CASE WHEN $5 THEN 'failed' ELSE 'pending' END,
+            last_status_code = $2,
+            last_error = $3,
+            next_attempt_at = NOW() + $4 * INTERVAL '1 millisecond'
+        WHERE id = $1`
+	if _, err := r.db.ExecContext(ctx, query, id, statusCode, cause, retryIn.Milliseconds(), final); err != nil {
+		return domain.WrapError(err, errcodes.InternalServerError, "repository: failed to mark webhook delivery failed")
+	}
+
+	return nil
+}
@@ -0,0 +1,36 @@
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+const namespace = "pr_service"
+
+//nolint:gochecknoglobals
+var (
+	PullRequestsCreated = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "pull_requests_created_total",
+		Help:      "Number of created pull requests.",
+	})
+
+	// ReassignNoCandidate — переназначения, отклонённые из-за отсутствия подходящего ревьювера.
+	ReassignNoCandidate = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "reassign_no_candidate_total",
+		Help:      "Number of reviewer reassignments rejected with NO_CANDIDATE.",
+	})
+
+	OutboxEventDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "outbox_event_duration_seconds",
+		Help:      "Outbox event processing latency.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"event_type", "result"})
+)
+
+const (
+	ResultSuccess = "success"
+	ResultError   = "error"
+)
@@ -0,0 +1,116 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"pull_requests_service/internal/domain"
+	"pull_requests_service/internal/domain/entity"
+	"pull_requests_service/internal/server/generated"
+	"pull_requests_service/pkg/errcodes"
+
+	"github.com/samber/lo"
+)
+
+// AbsenceService управляет запланированными отсутствиями пользователей.
+type AbsenceService interface {
+	ScheduleAbsence(ctx context.Context, absence entity.Absence) (entity.Absence, error)
+	ListAbsences(ctx context.Context, filter entity.AbsenceFilter) ([]entity.Absence, error)
+	CancelAbsence(ctx context.Context, id int64) (entity.Absence, error)
+}
+
+func (s *Server) GetUsersAbsences(ctx context.Context, request generated.GetUsersAbsencesRequestObject) (
+	generated.GetUsersAbsencesResponseObject, error) {
+
+	absences, err := s.absenceService.ListAbsences(ctx, entity.AbsenceFilter{
+		UserId:          lo.FromPtr(request.Params.UserId),
+		IncludeFinished: lo.FromPtr(request.Params.IncludeFinished),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response := generated.GetUsersAbsences200JSONResponse{
+		Absences: make([]generated.Absence, len(absences)),
+	}
+	for i, absence := range absences {
+		response.Absences[i] = toAPIAbsence(absence)
+	}
+	return response, nil
+}
+
+func (s *Server) PostUsersAbsencesCreate(ctx context.Context, request generated.PostUsersAbsencesCreateRequestObject) (
+	generated.PostUsersAbsencesCreateResponseObject, error) {
+
+	created, err := s.absenceService.ScheduleAbsence(ctx, entity.Absence{
+		UserId:   request.Body.UserId,
+		StartsAt: request.Body.StartsAt,
+		EndsAt:   request.Body.EndsAt,
+		Reason:   lo.FromPtr(request.Body.Reason),
+	})
+	if err != nil {
+		var appErr *domain.AppError
+		if errors.As(err, &appErr) {
+			switch appErr.Code {
+			case errcodes.NotFound:
+				return generated.PostUsersAbsencesCreate404JSONResponse{
+					Error: struct {
+						Code    generated.ErrorResponseErrorCode `json:"code"`
+						Message string                           `json:"message"`
+					}{Code: generated.NOTFOUND, Message: appErr.Message},
+				}, nil
+			case errcodes.InvalidArgument:
+				return generated.PostUsersAbsencesCreate400JSONResponse{
+					Error: struct {
+						Code    generated.ErrorResponseErrorCode `json:"code"`
+						Message string                           `json:"message"`
+					}{Code: generated.INVALIDARGUMENT, Message: appErr.Message},
+				}, nil
+			}
+		}
+		return nil, err
+	}
+
+	return generated.PostUsersAbsencesCreate201JSONResponse{Absence: toAPIAbsence(created)}, nil
+}
+
+func (s *Server) PostUsersAbsencesCancel(ctx context.Context, request generated.PostUsersAbsencesCancelRequestObject) (
+	generated.PostUsersAbsencesCancelResponseObject, error) {
+
+	cancelled, err := s.absenceService.CancelAbsence(ctx, request.Body.Id)
+	if err != nil {
+		var appErr *domain.AppError
+		if errors.As(err, &appErr) {
+			switch appErr.Code {
+			case errcodes.NotFound:
+				return generated.PostUsersAbsencesCancel404JSONResponse{
+					Error: struct {
+						Code    generated.ErrorResponseErrorCode `json:"code"`
+						Message string                           `json:"message"`
+					}{Code: generated.NOTFOUND, Message: appErr.Message},
+				}, nil
+			case errcodes.InvalidArgument:
+				return generated.PostUsersAbsencesCancel400JSONResponse{
+					Error: struct {
+						Code    generated.ErrorResponseErrorCode `json:"code"`
+						Message string                           `json:"message"`
+					}{Code: generated.INVALIDARGUMENT, Message: appErr.Message},
+				}, nil
+			}
+		}
+		return nil, err
+	}
+
+	return generated.PostUsersAbsencesCancel200JSONResponse{Absence: toAPIAbsence(cancelled)}, nil
+}
+
+func toAPIAbsence(absence entity.Absence) generated.Absence {
+	return generated.Absence{
+		Id:        absence.Id,
+		UserId:    absence.UserId,
+		StartsAt:  absence.StartsAt,
+		EndsAt:    absence.EndsAt,
+		Reason:    absence.Reason,
+		Status:    generated.AbsenceStatus(absence.Status()),
+		CreatedAt: absence.CreatedAt,
+	}
+}
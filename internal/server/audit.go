@@ -0,0 +1,82 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"pull_requests_service/internal/domain"
+	"pull_requests_service/internal/domain/entity"
+	"pull_requests_service/internal/server/generated"
+	"pull_requests_service/pkg/errcodes"
+
+	"github.com/samber/lo"
+)
+
+// AuditService отдаёт журнал изменений.
+type AuditService interface {
+	ListEvents(ctx context.Context, filter entity.AuditFilter, cursor string) ([]entity.AuditEvent, string, error)
+}
+
+func (s *Server) GetAudit(ctx context.Context, request generated.GetAuditRequestObject) (generated.GetAuditResponseObject, error) {
+	params := request.Params
+	filter := entity.AuditFilter{
+		EntityType: lo.FromPtr(params.EntityType),
+		EntityId:   lo.FromPtr(params.EntityId),
+		From:       params.From,
+		To:         params.To,
+		Limit:      lo.FromPtr(params.Limit),
+	}
+
+	events, nextCursor, err := s.auditService.ListEvents(ctx, filter, lo.FromPtr(params.Cursor))
+	if err != nil {
+		var appErr *domain.AppError
+		if errors.As(err, &appErr) && appErr.Code == errcodes.InvalidArgument {
+			return generated.GetAudit400JSONResponse{
+				Error: struct {
+					Code    generated.ErrorResponseErrorCode `json:"code"`
+					Message string                           `json:"message"`
+				}{Code: generated.INVALIDARGUMENT, Message: appErr.Message},
+			}, nil
+		}
+		return nil, err
+	}
+
+	response := generated.GetAudit200JSONResponse{
+		Events: make([]generated.AuditEvent, len(events)),
+	}
+	for i, event := range events {
+		apiEvent := generated.AuditEvent{
+			Id:         event.Id,
+			Actor:      event.Actor,
+			Operation:  event.Operation,
+			EntityType: event.EntityType,
+			EntityId:   event.EntityId,
+			TraceId:    event.TraceId,
+			CreatedAt:  event.CreatedAt,
+		}
+		if apiEvent.Before, err = auditPayload(event.Before); err != nil {
+			return nil, err
+		}
+		if apiEvent.After, err = auditPayload(event.After); err != nil {
+			return nil, err
+		}
+		response.Events[i] = apiEvent
+	}
+	if nextCursor != "" {
+		response.NextCursor = &nextCursor
+	}
+	return response, nil
+}
+
+func auditPayload(data []byte) (*map[string]interface{}, error) {
+	if data == nil {
+		return nil, nil
+	}
+
+	var payload map[string]interface{}
+	if err := json.Unmarshal(data, &payload); err != nil {
+		return nil, domain.WrapError(err, errcodes.InternalServerError, "failed to decode audit payload")
+	}
+
+	return &payload, nil
+}
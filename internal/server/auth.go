@@ -0,0 +1,69 @@
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+	"pull_requests_service/internal/server/generated"
+	"pull_requests_service/pkg/contextx"
+)
+
+// Authorize проверяет роль вызывающего по требованиям security операции из openapi.yaml.
+// Сгенерированная обёртка кладёт в контекст скоупы только тех схем, что указаны у операции,
+// поэтому операции без security пропускаются без проверки. Админ допускается к любой операции.
+func Authorize(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		_, adminAllowed := ctx.Value(generated.AdminTokenScopes).([]string)
+		_, userAllowed := ctx.Value(generated.UserTokenScopes).([]string)
+
+		if !adminAllowed && !userAllowed {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		if _, err := contextx.UserIDFromContext(ctx); err != nil {
+			writeError(w, http.StatusUnauthorized, generated.UNAUTHORIZED, "missing or invalid bearer token")
+			return
+		}
+
+		role, _ := contextx.RoleFromContext(ctx)
+		if role == contextx.RoleAdmin || (userAllowed && role == contextx.RoleUser) {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		writeError(w, http.StatusForbidden, generated.FORBIDDEN, "operation is not allowed for role '"+role.String()+"'")
+	})
+}
+
+// RequireAdmin пропускает только админа; нужен обработчикам вне openapi.yaml, где нет security операции.
+func RequireAdmin(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		if _, err := contextx.UserIDFromContext(ctx); err != nil {
+			writeError(w, http.StatusUnauthorized, generated.UNAUTHORIZED, "missing or invalid bearer token")
+			return
+		}
+
+		role, _ := contextx.RoleFromContext(ctx)
+		if role != contextx.RoleAdmin {
+			writeError(w, http.StatusForbidden, generated.FORBIDDEN, "operation is not allowed for role '"+role.String()+"'")
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+func writeError(w http.ResponseWriter, status int, code generated.ErrorResponseErrorCode, message string) {
+	var response generated.ErrorResponse
+	response.Error.Code = code
+	response.Error.Message = message
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+
+	_ = json.NewEncoder(w).Encode(response)
+}
@@ -0,0 +1,108 @@
+package server
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/require"
+
+	"pull_requests_service/internal/domain/entity"
+	"pull_requests_service/internal/server/generated"
+	"pull_requests_service/pkg/jwtx"
+	"pull_requests_service/pkg/middlewarex"
+)
+
+type fakeHealthService struct{}
+
+func (fakeHealthService) Live(context.Context) entity.HealthReport {
+	return entity.HealthReport{Status: entity.HealthUp}
+}
+
+func (fakeHealthService) Ready(context.Context) entity.HealthReport {
+	return entity.HealthReport{Status: entity.HealthUp}
+}
+
+func TestAuthorize(t *testing.T) {
+	rq := require.New(t)
+
+	key := []byte("secret")
+	token := func(role string) string {
+		signed, err := jwtx.Sign(jwtx.Claims{Subject: "u1", Role: role, ExpiresAt: time.Now().Add(time.Hour).Unix()}, key)
+		rq.NoError(err)
+		return signed
+	}
+	foreign, err := jwtx.Sign(jwtx.Claims{Subject: "u1", Role: "admin"}, []byte("other-secret"))
+	rq.NoError(err)
+
+	router := chi.NewRouter()
+	router.Use(middlewarex.Auth(jwtx.NewVerifier([][]byte{key}, time.Second)))
+	router.With(RequireAdmin).Get("/bulk/export", func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+
+	srv := NewServer(&fakePullRequestService{}, nil, nil, nil, nil, nil, nil, nil, fakeHealthService{})
+	generated.HandlerWithOptions(generated.NewStrictHandler(srv, nil), generated.ChiServerOptions{
+		BaseRouter:  router,
+		Middlewares: []generated.MiddlewareFunc{Authorize},
+	})
+
+	merge := `{"pull_request_id":"pr-1"}`
+	tests := []struct {
+		name   string
+		method string
+		path   string
+		body   string
+		token  string
+		status int
+	}{
+		{name: "admin op without token", method: http.MethodPost, path: "/pullRequest/merge", body: merge,
+			status: http.StatusUnauthorized},
+		{name: "admin op with invalid token", method: http.MethodPost, path: "/pullRequest/merge", body: merge,
+			token: "not-a-jwt", status: http.StatusUnauthorized},
+		{name: "admin op with foreign token", method: http.MethodPost, path: "/pullRequest/merge", body: merge,
+			token: foreign, status: http.StatusUnauthorized},
+		{name: "admin op as user", method: http.MethodPost, path: "/pullRequest/merge", body: merge,
+			token: token("user"), status: http.StatusForbidden},
+		{name: "admin op with unknown role", method: http.MethodPost, path: "/pullRequest/merge", body: merge,
+			token: token("guest"), status: http.StatusForbidden},
+		{name: "admin op as admin", method: http.MethodPost, path: "/pullRequest/merge", body: merge,
+			token: token("admin"), status: http.StatusOK},
+		{name: "user op without token", method: http.MethodGet, path: "/pullRequest/get?pull_request_id=pr-1",
+			status: http.StatusUnauthorized},
+		{name: "user op as user", method: http.MethodGet, path: "/pullRequest/get?pull_request_id=pr-1",
+			token: token("user"), status: http.StatusOK},
+		{name: "user op as admin", method: http.MethodGet, path: "/pullRequest/get?pull_request_id=pr-1",
+			token: token("admin"), status: http.StatusOK},
+		{name: "op without security", method: http.MethodGet, path: "/health/live", status: http.StatusOK},
+		{name: "op without security ignores bad token", method: http.MethodGet, path: "/health/live",
+			token: "not-a-jwt", status: http.StatusOK},
+		{name: "require admin without token", method: http.MethodGet, path: "/bulk/export",
+			status: http.StatusUnauthorized},
+		{name: "require admin as user", method: http.MethodGet, path: "/bulk/export", token: token("user"),
+			status: http.StatusForbidden},
+		{name: "require admin as admin", method: http.MethodGet, path: "/bulk/export", token: token("admin"),
+			status: http.StatusOK},
+	}
+	for _, tt := range tests {
+		req := httptest.NewRequest(tt.method, tt.path, strings.NewReader(tt.body))
+		req.Header.Set("Content-Type", "application/json")
+		if tt.token != "" {
+			req.Header.Set("Authorization", "Bearer "+tt.token)
+		}
+
+		rec := httptest.NewRecorder()
+		router.ServeHTTP(rec, req)
+		rq.Equal(tt.status, rec.Code, tt.name)
+		if tt.status == http.StatusUnauthorized {
+			rq.Contains(rec.Body.String(), string(generated.UNAUTHORIZED), tt.name)
+		}
+		if tt.status == http.StatusForbidden {
+			rq.Contains(rec.Body.String(), string(generated.FORBIDDEN), tt.name)
+		}
+	}
+}
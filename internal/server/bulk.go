@@ -0,0 +1,108 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"pull_requests_service/internal/domain"
+	"pull_requests_service/internal/domain/entity"
+	"pull_requests_service/internal/server/generated"
+	"pull_requests_service/pkg/bulkfile"
+	"pull_requests_service/pkg/contextx"
+	"pull_requests_service/pkg/errcodes"
+	"pull_requests_service/pkg/logx"
+)
+
+// BulkService импортирует и выгружает команды, пользователей и PR файлами.
+type BulkService interface {
+	Import(ctx context.Context, kind, format string, r io.Reader) (entity.ImportResult, error)
+	Export(ctx context.Context, kind, format string, w io.Writer) error
+}
+
+// BulkHandler принимает и отдаёт файлы JSON Lines и CSV. Он не описан в openapi.yaml:
+// тело запроса и ответа — файл, а не JSON-документ.
+type BulkHandler struct {
+	service     BulkService
+	maxFileSize int64
+}
+
+func NewBulkHandler(service BulkService, maxFileSize int64) *BulkHandler {
+	return &BulkHandler{
+		service:     service,
+		maxFileSize: maxFileSize,
+	}
+}
+
+var bulkContentTypes = map[string]string{ //nolint:gochecknoglobals
+	bulkfile.FormatJSONL: "application/x-ndjson",
+	bulkfile.FormatCSV:   "text/csv; charset=utf-8",
+}
+
+// LimitBody ограничивает тело запроса размером файла; ставится до Idempotency, которая читает тело целиком.
+func (h *BulkHandler) LimitBody(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		r.Body = http.MaxBytesReader(w, r.Body, h.maxFileSize)
+		next.ServeHTTP(w, r)
+	})
+}
+
+// Import читает файл из тела запроса: ?kind=teams|users|pull_requests&format=jsonl|csv.
+// Пачки, применённые до ошибки чтения файла, остаются в базе; повторный импорт их пропустит.
+// Размер тела ограничивает LimitBody.
+func (h *BulkHandler) Import(w http.ResponseWriter, r *http.Request) {
+	kind, format := bulkParams(r)
+
+	result, err := h.service.Import(r.Context(), kind, format, r.Body)
+	if err != nil {
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			writeError(w, http.StatusRequestEntityTooLarge, generated.INVALIDARGUMENT, "file is too large")
+			return
+		}
+		writeBulkError(w, r, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(result)
+}
+
+// Export отдаёт файл со всеми сущностями вида kind в формате format.
+func (h *BulkHandler) Export(w http.ResponseWriter, r *http.Request) {
+	kind, format := bulkParams(r)
+
+	// файл собирается целиком, чтобы ошибка выгрузки вернулась статусом, а не обрезанным файлом
+	var buf bytes.Buffer
+	if err := h.service.Export(r.Context(), kind, format, &buf); err != nil {
+		writeBulkError(w, r, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", bulkContentTypes[format])
+	w.Header().Set("Content-Disposition", `attachment; filename="`+kind+"."+format+`"`)
+	_, _ = buf.WriteTo(w)
+}
+
+func bulkParams(r *http.Request) (string, string) {
+	query := r.URL.Query()
+	format := query.Get("format")
+	if format == "" {
+		format = bulkfile.FormatJSONL
+	}
+
+	return query.Get("kind"), format
+}
+
+func writeBulkError(w http.ResponseWriter, r *http.Request, err error) {
+	var appErr *domain.AppError
+	if errors.As(err, &appErr) && appErr.Code == errcodes.InvalidArgument {
+		writeError(w, http.StatusBadRequest, generated.INVALIDARGUMENT, appErr.Error())
+		return
+	}
+
+	contextx.LoggerFromContextOrDefault(r.Context()).Error("bulk operation failed", logx.Error(err))
+	writeError(w, http.StatusInternalServerError, generated.INTERNALSERVERERROR, "internal server error")
+}
@@ -0,0 +1,71 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"pull_requests_service/internal/domain"
+	"pull_requests_service/internal/domain/entity"
+	"pull_requests_service/internal/server/generated"
+	"pull_requests_service/pkg/errcodes"
+)
+
+func (s *Server) GetTeamCodeOwners(ctx context.Context, request generated.GetTeamCodeOwnersRequestObject) (
+	generated.GetTeamCodeOwnersResponseObject, error) {
+
+	owners, err := s.teamService.GetCodeOwners(ctx, request.Params.TeamName)
+	if err != nil {
+		var appErr *domain.AppError
+		if errors.As(err, &appErr) && appErr.Code == errcodes.NotFound {
+			return generated.GetTeamCodeOwners404JSONResponse{
+				Error: struct {
+					Code    generated.ErrorResponseErrorCode `json:"code"`
+					Message string                           `json:"message"`
+				}{Code: generated.NOTFOUND, Message: appErr.Message},
+			}, nil
+		}
+		return nil, err
+	}
+
+	return generated.GetTeamCodeOwners200JSONResponse(toAPICodeOwners(owners)), nil
+}
+
+func (s *Server) PostTeamCodeOwners(ctx context.Context, request generated.PostTeamCodeOwnersRequestObject) (
+	generated.PostTeamCodeOwnersResponseObject, error) {
+
+	owners, err := s.teamService.SetCodeOwners(ctx, entity.CodeOwners{
+		Team:    request.Body.TeamName,
+		Content: request.Body.Content,
+	})
+	if err != nil {
+		var appErr *domain.AppError
+		if errors.As(err, &appErr) {
+			switch appErr.Code {
+			case errcodes.NotFound:
+				return generated.PostTeamCodeOwners404JSONResponse{
+					Error: struct {
+						Code    generated.ErrorResponseErrorCode `json:"code"`
+						Message string                           `json:"message"`
+					}{Code: generated.NOTFOUND, Message: appErr.Message},
+				}, nil
+			case errcodes.InvalidArgument:
+				return generated.PostTeamCodeOwners400JSONResponse{
+					Error: struct {
+						Code    generated.ErrorResponseErrorCode `json:"code"`
+						Message string                           `json:"message"`
+					}{Code: generated.INVALIDARGUMENT, Message: appErr.Message},
+				}, nil
+			}
+		}
+		return nil, err
+	}
+
+	return generated.PostTeamCodeOwners200JSONResponse(toAPICodeOwners(owners)), nil
+}
+
+func toAPICodeOwners(owners entity.CodeOwners) generated.CodeOwners {
+	return generated.CodeOwners{
+		TeamName:  owners.Team,
+		Content:   owners.Content,
+		UpdatedAt: owners.UpdatedAt,
+	}
+}
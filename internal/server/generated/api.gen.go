@@ -20,28 +20,186 @@ const (
 	UserTokenScopes  = "UserToken.Scopes"
 )
 
+// Defines values for AbsenceStatus.
+const (
+	Active    AbsenceStatus = "active"
+	Finished  AbsenceStatus = "finished"
+	Scheduled AbsenceStatus = "scheduled"
+)
+
 // Defines values for ErrorResponseErrorCode.
 const (
-	NOCANDIDATE ErrorResponseErrorCode = "NO_CANDIDATE"
-	NOTASSIGNED ErrorResponseErrorCode = "NOT_ASSIGNED"
-	NOTFOUND    ErrorResponseErrorCode = "NOT_FOUND"
-	PREXISTS    ErrorResponseErrorCode = "PR_EXISTS"
-	PRMERGED    ErrorResponseErrorCode = "PR_MERGED"
-	TEAMEXISTS  ErrorResponseErrorCode = "TEAM_EXISTS"
+	FORBIDDEN            ErrorResponseErrorCode = "FORBIDDEN"
+	IDEMPOTENCYKEYREUSED ErrorResponseErrorCode = "IDEMPOTENCY_KEY_REUSED"
+	INTERNALSERVERERROR  ErrorResponseErrorCode = "INTERNAL_SERVER_ERROR"
+	INVALIDARGUMENT      ErrorResponseErrorCode = "INVALID_ARGUMENT"
+	INVALIDTRANSITION    ErrorResponseErrorCode = "INVALID_TRANSITION"
+	NOCANDIDATE          ErrorResponseErrorCode = "NO_CANDIDATE"
+	NOTAPPROVED          ErrorResponseErrorCode = "NOT_APPROVED"
+	NOTASSIGNED          ErrorResponseErrorCode = "NOT_ASSIGNED"
+	NOTFOUND             ErrorResponseErrorCode = "NOT_FOUND"
+	PREXISTS             ErrorResponseErrorCode = "PR_EXISTS"
+	PRMERGED             ErrorResponseErrorCode = "PR_MERGED"
+	REQUESTINPROGRESS    ErrorResponseErrorCode = "REQUEST_IN_PROGRESS"
+	TEAMEXISTS           ErrorResponseErrorCode = "TEAM_EXISTS"
+	UNAUTHORIZED         ErrorResponseErrorCode = "UNAUTHORIZED"
+	USEREXISTS           ErrorResponseErrorCode = "USER_EXISTS"
+)
+
+// Defines values for HealthStatus.
+const (
+	Down HealthStatus = "down"
+	Up   HealthStatus = "up"
 )
 
 // Defines values for PullRequestStatus.
 const (
+	PullRequestStatusCLOSED PullRequestStatus = "CLOSED"
+	PullRequestStatusDRAFT  PullRequestStatus = "DRAFT"
 	PullRequestStatusMERGED PullRequestStatus = "MERGED"
 	PullRequestStatusOPEN   PullRequestStatus = "OPEN"
 )
 
 // Defines values for PullRequestShortStatus.
 const (
+	PullRequestShortStatusCLOSED PullRequestShortStatus = "CLOSED"
+	PullRequestShortStatusDRAFT  PullRequestShortStatus = "DRAFT"
 	PullRequestShortStatusMERGED PullRequestShortStatus = "MERGED"
 	PullRequestShortStatusOPEN   PullRequestShortStatus = "OPEN"
 )
 
+// Defines values for ReviewState.
+const (
+	ReviewStateApproved         ReviewState = "approved"
+	ReviewStateChangesRequested ReviewState = "changes_requested"
+	ReviewStateCommented        ReviewState = "commented"
+	ReviewStatePending          ReviewState = "pending"
+)
+
+// Defines values for ReviewerAssignmentReplaceReason.
+const (
+	ReviewerAssignmentReplaceReasonDeactivation   ReviewerAssignmentReplaceReason = "deactivation"
+	ReviewerAssignmentReplaceReasonManualReassign ReviewerAssignmentReplaceReason = "manual_reassign"
+	ReviewerAssignmentReplaceReasonPrClosed       ReviewerAssignmentReplaceReason = "pr_closed"
+	ReviewerAssignmentReplaceReasonTeamMove       ReviewerAssignmentReplaceReason = "team_move"
+)
+
+// Defines values for ReviewerStrategy.
+const (
+	LeastLoaded       ReviewerStrategy = "least_loaded"
+	Random            ReviewerStrategy = "random"
+	RoundRobin        ReviewerStrategy = "round_robin"
+	SeniorityWeighted ReviewerStrategy = "seniority_weighted"
+)
+
+// Defines values for TeamRetirePolicyPullRequests.
+const (
+	Close    TeamRetirePolicyPullRequests = "close"
+	Reassign TeamRetirePolicyPullRequests = "reassign"
+)
+
+// Defines values for VcsProvider.
+const (
+	Github VcsProvider = "github"
+	Gitlab VcsProvider = "gitlab"
+)
+
+// Defines values for WebhookDeliveryStatus.
+const (
+	WebhookDeliveryStatusDelivered WebhookDeliveryStatus = "delivered"
+	WebhookDeliveryStatusFailed    WebhookDeliveryStatus = "failed"
+	WebhookDeliveryStatusPending   WebhookDeliveryStatus = "pending"
+)
+
+// Defines values for WebhookEvent.
+const (
+	WebhookEventPrClosed         WebhookEvent = "pr.closed"
+	WebhookEventPrCreated        WebhookEvent = "pr.created"
+	WebhookEventPrMerged         WebhookEvent = "pr.merged"
+	WebhookEventPrReady          WebhookEvent = "pr.ready"
+	WebhookEventPrReopened       WebhookEvent = "pr.reopened"
+	WebhookEventReviewSubmitted  WebhookEvent = "review.submitted"
+	WebhookEventReviewerAssigned WebhookEvent = "reviewer.assigned"
+	WebhookEventReviewerReplaced WebhookEvent = "reviewer.replaced"
+)
+
+// Defines values for GetPullRequestListParamsStatus.
+const (
+	GetPullRequestListParamsStatusCLOSED GetPullRequestListParamsStatus = "CLOSED"
+	GetPullRequestListParamsStatusDRAFT  GetPullRequestListParamsStatus = "DRAFT"
+	GetPullRequestListParamsStatusMERGED GetPullRequestListParamsStatus = "MERGED"
+	GetPullRequestListParamsStatusOPEN   GetPullRequestListParamsStatus = "OPEN"
+)
+
+// Defines values for PostPullRequestReviewJSONBodyState.
+const (
+	PostPullRequestReviewJSONBodyStateApproved         PostPullRequestReviewJSONBodyState = "approved"
+	PostPullRequestReviewJSONBodyStateChangesRequested PostPullRequestReviewJSONBodyState = "changes_requested"
+	PostPullRequestReviewJSONBodyStateCommented        PostPullRequestReviewJSONBodyState = "commented"
+)
+
+// Defines values for GetUsersGetReviewParamsStatus.
+const (
+	GetUsersGetReviewParamsStatusCLOSED GetUsersGetReviewParamsStatus = "CLOSED"
+	GetUsersGetReviewParamsStatusDRAFT  GetUsersGetReviewParamsStatus = "DRAFT"
+	GetUsersGetReviewParamsStatusMERGED GetUsersGetReviewParamsStatus = "MERGED"
+	GetUsersGetReviewParamsStatusOPEN   GetUsersGetReviewParamsStatus = "OPEN"
+)
+
+// Defines values for GetWebhookDeliveriesParamsStatus.
+const (
+	GetWebhookDeliveriesParamsStatusDelivered GetWebhookDeliveriesParamsStatus = "delivered"
+	GetWebhookDeliveriesParamsStatusFailed    GetWebhookDeliveriesParamsStatus = "failed"
+	GetWebhookDeliveriesParamsStatusPending   GetWebhookDeliveriesParamsStatus = "pending"
+)
+
+// Absence defines model for Absence.
+type Absence struct {
+	CreatedAt time.Time `json:"created_at"`
+	EndsAt    time.Time `json:"ends_at"`
+	Id        int64     `json:"id"`
+	Reason    string    `json:"reason"`
+	StartsAt  time.Time `json:"starts_at"`
+
+	// Status scheduled — ещё не началось, active — пользователь выключен планировщиком или вручную, finished — завершено или отменено
+	Status AbsenceStatus `json:"status"`
+	UserId string        `json:"user_id"`
+}
+
+// AbsenceStatus defines model for Absence.Status.
+type AbsenceStatus string
+
+// AuditEvent defines model for AuditEvent.
+type AuditEvent struct {
+	// Actor user_id из токена или system для фоновых задач
+	Actor string `json:"actor"`
+
+	// After Состояние сущности после изменения
+	After *map[string]interface{} `json:"after"`
+
+	// Before Состояние сущности до изменения, null если её не было
+	Before    *map[string]interface{} `json:"before"`
+	CreatedAt time.Time               `json:"created_at"`
+	EntityId  string                  `json:"entity_id"`
+
+	// EntityType team, user или pull_request
+	EntityType string `json:"entity_type"`
+	Id         int64  `json:"id"`
+
+	// Operation Например team.create, user.set_is_active, pull_request.merge
+	Operation string  `json:"operation"`
+	TraceId   *string `json:"trace_id"`
+}
+
+// CodeOwners defines model for CodeOwners.
+type CodeOwners struct {
+	// Content Файл в формате CODEOWNERS: строка — шаблон пути и владельцы (user_id, '@' допускается).
+	// Действует последнее подходящее правило; '!' и '[...]' не поддерживаются.
+	Content   string    `json:"content"`
+	TeamName  string    `json:"team_name"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
 // ErrorResponse defines model for ErrorResponse.
 type ErrorResponse struct {
 	Error struct {
@@ -53,21 +211,66 @@ type ErrorResponse struct {
 // ErrorResponseErrorCode defines model for ErrorResponse.Error.Code.
 type ErrorResponseErrorCode string
 
+// ExternalIdentity defines model for ExternalIdentity.
+type ExternalIdentity struct {
+	// Login Логин в VCS, хранится в нижнем регистре
+	Login    string      `json:"login"`
+	Provider VcsProvider `json:"provider"`
+	UserId   string      `json:"user_id"`
+}
+
+// HealthCheck defines model for HealthCheck.
+type HealthCheck struct {
+	Message *string `json:"message,omitempty"`
+
+	// Name Зависимость — postgres, migrations, event_worker или shutdown.
+	Name   string       `json:"name"`
+	Status HealthStatus `json:"status"`
+}
+
+// HealthResponse defines model for HealthResponse.
+type HealthResponse struct {
+	Checks []HealthCheck `json:"checks"`
+	Status HealthStatus  `json:"status"`
+}
+
+// HealthStatus defines model for HealthStatus.
+type HealthStatus string
+
 // PullRequest defines model for PullRequest.
 type PullRequest struct {
-	// AssignedReviewers user_id назначенных ревьюверов (0..2)
-	AssignedReviewers []string          `json:"assigned_reviewers"`
-	AuthorId          string            `json:"author_id"`
-	CreatedAt         *time.Time        `json:"createdAt"`
-	MergedAt          *time.Time        `json:"mergedAt"`
-	PullRequestId     string            `json:"pull_request_id"`
-	PullRequestName   string            `json:"pull_request_name"`
-	Status            PullRequestStatus `json:"status"`
+	// AssignedReviewers user_id назначенных ревьюверов (0..required_reviewers команды автора)
+	AssignedReviewers []string `json:"assigned_reviewers"`
+	AuthorId          string   `json:"author_id"`
+
+	// ClosedAt Когда PR закрыт без слияния (архивация или удаление команды автора)
+	ClosedAt  *time.Time `json:"closedAt"`
+	CreatedAt *time.Time `json:"createdAt"`
+	MergedAt  *time.Time `json:"mergedAt"`
+
+	// NeedMoreReviewers В команде не нашлось достаточно активных кандидатов
+	NeedMoreReviewers *bool  `json:"need_more_reviewers,omitempty"`
+	PullRequestId     string `json:"pull_request_id"`
+	PullRequestName   string `json:"pull_request_name"`
+
+	// Reviews Ревью текущих ревьюверов в порядке назначения
+	Reviews *[]PullRequestReview `json:"reviews,omitempty"`
+	Status  PullRequestStatus    `json:"status"`
 }
 
 // PullRequestStatus defines model for PullRequest.Status.
 type PullRequestStatus string
 
+// PullRequestReview defines model for PullRequestReview.
+type PullRequestReview struct {
+	Comment string `json:"comment"`
+
+	// ReviewedAt Когда ревьювер последний раз отправил ревью, null — ещё не отправлял
+	ReviewedAt *time.Time  `json:"reviewed_at"`
+	ReviewerId string      `json:"reviewer_id"`
+	State      ReviewState `json:"state"`
+}
+
 // PullRequestShort defines model for PullRequestShort.
 type PullRequestShort struct {
 	AuthorId        string                 `json:"author_id"`
@@ -79,6 +282,34 @@ type PullRequestShort struct {
 // PullRequestShortStatus defines model for PullRequestShort.Status.
 type PullRequestShortStatus string
 
+// ReviewState pending — ревью ещё не отправлено; последнее отправленное состояние заменяет предыдущее
+type ReviewState string
+
+// ReviewerAssignment defines model for ReviewerAssignment.
+type ReviewerAssignment struct {
+	AssignedAt time.Time `json:"assigned_at"`
+	IsCurrent  bool      `json:"is_current"`
+
+	// ReplaceReason Почему ревьювер снят: manual_reassign — /pullRequest/reassign;
+	// deactivation — пользователь деактивирован; team_move — пользователь перешёл в другую команду или выведен из неё;
+	// pr_closed — PR закрыт без слияния.
+	ReplaceReason *ReviewerAssignmentReplaceReason `json:"replace_reason"`
+	ReplacedAt    *time.Time                       `json:"replaced_at"`
+	ReviewState   *ReviewState                     `json:"review_state,omitempty"`
+	ReviewerId    string                           `json:"reviewer_id"`
+
+	// SourceTeam Команда, из пула которой назначен ревьювер (своя команда автора или резервная)
+	SourceTeam *string `json:"source_team"`
+}
+
+// ReviewerAssignmentReplaceReason defines model for ReviewerAssignment.ReplaceReason.
+type ReviewerAssignmentReplaceReason string
+
+// ReviewerStrategy Стратегия выбора ревьюверов команды:
+// random — случайно; least_loaded — меньше всего открытых ревью;
+// round_robin — кого дольше всех не назначали; seniority_weighted — случайно с весом seniority.
+type ReviewerStrategy string
+
 // StatsResponse defines model for StatsResponse.
 type StatsResponse struct {
 	AssignmentsByUser *[]UserAssignmentStat `json:"assignments_by_user,omitempty"`
@@ -86,15 +317,81 @@ type StatsResponse struct {
 
 // Team defines model for Team.
 type Team struct {
-	Members  []TeamMember `json:"members"`
-	TeamName string       `json:"team_name"`
+	// ArchivedAt Когда команда архивирована; задаётся только через /team/archive
+	ArchivedAt *time.Time `json:"archived_at"`
+
+	// FallbackTeams Резервные команды в порядке приоритета, меняются через /team/settings
+	FallbackTeams *[]string `json:"fallback_teams,omitempty"`
+
+	// MaxOpenReviews Лимит одновременных открытых ревью на участника; null — без лимита
+	MaxOpenReviews *int         `json:"max_open_reviews"`
+	Members        []TeamMember `json:"members"`
+
+	// RequireApprovals PR участников сливаются только при required_reviewers одобрениях, по умолчанию false
+	RequireApprovals *bool `json:"require_approvals,omitempty"`
+
+	// RequiredReviewers Сколько ревьюверов назначать на PR (по умолчанию 2)
+	RequiredReviewers *int              `json:"required_reviewers,omitempty"`
+	ReviewerStrategy  *ReviewerStrategy `json:"reviewer_strategy,omitempty"`
+	TeamName          string            `json:"team_name"`
 }
 
 // TeamMember defines model for TeamMember.
 type TeamMember struct {
-	IsActive bool   `json:"is_active"`
-	UserId   string `json:"user_id"`
-	Username string `json:"username"`
+	IsActive bool `json:"is_active"`
+
+	// Seniority Вес для стратегии seniority_weighted (по умолчанию 1)
+	Seniority *int   `json:"seniority,omitempty"`
+	UserId    string `json:"user_id"`
+	Username  string `json:"username"`
+}
+
+// TeamMembershipChange defines model for TeamMembershipChange.
+type TeamMembershipChange struct {
+	// ReassignedPullRequests Открытые PR прежней команды, с которых пользователь снят (replace_reason = team_move).
+	ReassignedPullRequests []string `json:"reassigned_pull_requests"`
+	User                   User     `json:"user"`
+}
+
+// TeamRetirePolicy defines model for TeamRetirePolicy.
+type TeamRetirePolicy struct {
+	// PullRequests Что делать с открытыми PR участников команды:
+	// close — закрыть (status CLOSED); reassign — снять ревьюверов команды и добрать замену из target_team.
+	PullRequests TeamRetirePolicyPullRequests `json:"pull_requests"`
+
+	// TargetTeam Команда, которой передаются ревью; обязательна для reassign
+	TargetTeam *string `json:"target_team,omitempty"`
+}
+
+// TeamRetirePolicyPullRequests defines model for TeamRetirePolicy.PullRequests.
+type TeamRetirePolicyPullRequests string
+
+// TeamRetirement defines model for TeamRetirement.
+type TeamRetirement struct {
+	ClosedPullRequests []string `json:"closed_pull_requests"`
+
+	// Members Участники команды на момент архивации или удаления
+	Members []string `json:"members"`
+
+	// ReassignedPullRequests PR, на которых заменены ревьюверы из этой команды
+	ReassignedPullRequests []string `json:"reassigned_pull_requests"`
+	TeamName               string   `json:"team_name"`
+}
+
+// TeamSettings defines model for TeamSettings.
+type TeamSettings struct {
+	// FallbackTeams Резервные команды (общие пулы ревьюверов) в порядке приоритета. Если в команде не хватает
+	// активных кандидатов, недостающие ревьюверы берутся из них по очереди.
+	FallbackTeams []string `json:"fallback_teams"`
+
+	// MaxOpenReviews Лимит одновременных открытых ревью на участника, если у него нет личного; null — без лимита
+	MaxOpenReviews *int `json:"max_open_reviews"`
+
+	// RequireApprovals PR участников сливаются только при required_reviewers одобрениях
+	RequireApprovals  bool             `json:"require_approvals"`
+	RequiredReviewers int              `json:"required_reviewers"`
+	ReviewerStrategy  ReviewerStrategy `json:"reviewer_strategy"`
+	TeamName          string           `json:"team_name"`
 }
 
 // User defines model for User.
@@ -113,763 +410,5707 @@ type UserAssignmentStat struct {
 	Username        string `json:"username"`
 }
 
+// UserWorkload defines model for UserWorkload.
+type UserWorkload struct {
+	// Available Сколько ещё ревью можно назначить; null — без лимита
+	Available *int `json:"available"`
+	IsActive  bool `json:"is_active"`
+
+	// MaxOpenReviews Действующий лимит — личный или команды; null — без лимита
+	MaxOpenReviews *int `json:"max_open_reviews"`
+
+	// OpenReviews Сколько открытых PR пользователь ревьюит сейчас
+	OpenReviews int    `json:"open_reviews"`
+	TeamName    string `json:"team_name"`
+	UserId      string `json:"user_id"`
+	Username    string `json:"username"`
+}
+
+// VcsProvider defines model for VcsProvider.
+type VcsProvider string
+
+// Webhook defines model for Webhook.
+type Webhook struct {
+	CreatedAt time.Time      `json:"created_at"`
+	Events    []WebhookEvent `json:"events"`
+	Id        int64          `json:"id"`
+	IsActive  bool           `json:"is_active"`
+	Url       string         `json:"url"`
+}
+
+// WebhookDelivery defines model for WebhookDelivery.
+type WebhookDelivery struct {
+	Attempts    int        `json:"attempts"`
+	CreatedAt   time.Time  `json:"created_at"`
+	DeliveredAt *time.Time `json:"delivered_at"`
+
+	// EventId Идентификатор события, одинаковый для всех подписчиков
+	EventId   string       `json:"event_id"`
+	EventType WebhookEvent `json:"event_type"`
+
+	// Id Значение заголовка X-Webhook-Delivery
+	Id             int64   `json:"id"`
+	LastError      *string `json:"last_error"`
+	LastStatusCode *int    `json:"last_status_code"`
+
+	// Payload Отправленное тело запроса
+	Payload   map[string]interface{} `json:"payload"`
+	Status    WebhookDeliveryStatus  `json:"status"`
+	WebhookId int64                  `json:"webhook_id"`
+}
+
+// WebhookDeliveryStatus defines model for WebhookDelivery.Status.
+type WebhookDeliveryStatus string
+
+// WebhookEvent defines model for WebhookEvent.
+type WebhookEvent string
+
+// CursorQuery defines model for CursorQuery.
+type CursorQuery = string
+
+// IdempotencyKey defines model for IdempotencyKey.
+type IdempotencyKey = string
+
+// LimitQuery defines model for LimitQuery.
+type LimitQuery = int
+
+// PullRequestIdQuery defines model for PullRequestIdQuery.
+type PullRequestIdQuery = string
+
 // TeamNameQuery defines model for TeamNameQuery.
 type TeamNameQuery = string
 
 // UserIdQuery defines model for UserIdQuery.
 type UserIdQuery = string
 
+// GetAuditParams defines parameters for GetAudit.
+type GetAuditParams struct {
+	// EntityType team, user или pull_request
+	EntityType *string `form:"entity_type,omitempty" json:"entity_type,omitempty"`
+	EntityId   *string `form:"entity_id,omitempty" json:"entity_id,omitempty"`
+
+	// From created_at >= from
+	From *time.Time `form:"from,omitempty" json:"from,omitempty"`
+
+	// To created_at < to
+	To *time.Time `form:"to,omitempty" json:"to,omitempty"`
+
+	// Limit Размер страницы
+	Limit *LimitQuery `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Cursor Значение next_cursor из предыдущего ответа
+	Cursor *CursorQuery `form:"cursor,omitempty" json:"cursor,omitempty"`
+}
+
+// PostPullRequestCloseJSONBody defines parameters for PostPullRequestClose.
+type PostPullRequestCloseJSONBody struct {
+	PullRequestId string `json:"pull_request_id"`
+}
+
+// PostPullRequestCloseParams defines parameters for PostPullRequestClose.
+type PostPullRequestCloseParams struct {
+	// IdempotencyKey Ключ повтора запроса. Ответ на первый запрос с ключом хранится IDEMPOTENCY_TTL и возвращается
+	// на повторы того же вызывающего с заголовком Idempotent-Replayed: true. Тот же ключ с другим телом — 422
+	// IDEMPOTENCY_KEY_REUSED, повтор до завершения первого запроса — 409 REQUEST_IN_PROGRESS.
+	// Ответы 5xx не сохраняются, такой запрос можно повторить с тем же ключом.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+}
+
 // PostPullRequestCreateJSONBody defines parameters for PostPullRequestCreate.
 type PostPullRequestCreateJSONBody struct {
-	AuthorId        string `json:"author_id"`
+	AuthorId string `json:"author_id"`
+
+	// ChangedPaths Изменённые файлы. Если у команды автора задан файл владельцев (/team/codeOwners),
+	// ревьюверы сначала выбираются среди владельцев этих путей.
+	ChangedPaths *[]string `json:"changed_paths,omitempty"`
+
+	// Draft Создать черновик (DRAFT) без ревьюверов; они назначаются в /pullRequest/ready.
+	Draft           *bool  `json:"draft,omitempty"`
 	PullRequestId   string `json:"pull_request_id"`
 	PullRequestName string `json:"pull_request_name"`
 }
 
+// PostPullRequestCreateParams defines parameters for PostPullRequestCreate.
+type PostPullRequestCreateParams struct {
+	// IdempotencyKey Ключ повтора запроса. Ответ на первый запрос с ключом хранится IDEMPOTENCY_TTL и возвращается
+	// на повторы того же вызывающего с заголовком Idempotent-Replayed: true. Тот же ключ с другим телом — 422
+	// IDEMPOTENCY_KEY_REUSED, повтор до завершения первого запроса — 409 REQUEST_IN_PROGRESS.
+	// Ответы 5xx не сохраняются, такой запрос можно повторить с тем же ключом.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+}
+
+// GetPullRequestGetParams defines parameters for GetPullRequestGet.
+type GetPullRequestGetParams struct {
+	// PullRequestId Идентификатор PR
+	PullRequestId PullRequestIdQuery `form:"pull_request_id" json:"pull_request_id"`
+}
+
+// GetPullRequestHistoryParams defines parameters for GetPullRequestHistory.
+type GetPullRequestHistoryParams struct {
+	// PullRequestId Идентификатор PR
+	PullRequestId PullRequestIdQuery `form:"pull_request_id" json:"pull_request_id"`
+}
+
+// GetPullRequestListParams defines parameters for GetPullRequestList.
+type GetPullRequestListParams struct {
+	Status   *GetPullRequestListParamsStatus `form:"status,omitempty" json:"status,omitempty"`
+	AuthorId *string                         `form:"author_id,omitempty" json:"author_id,omitempty"`
+
+	// TeamName Команда автора PR
+	TeamName          *string `form:"team_name,omitempty" json:"team_name,omitempty"`
+	ReviewerId        *string `form:"reviewer_id,omitempty" json:"reviewer_id,omitempty"`
+	NeedMoreReviewers *bool   `form:"need_more_reviewers,omitempty" json:"need_more_reviewers,omitempty"`
+
+	// CreatedFrom created_at >= created_from
+	CreatedFrom *time.Time `form:"created_from,omitempty" json:"created_from,omitempty"`
+
+	// CreatedTo created_at < created_to
+	CreatedTo *time.Time `form:"created_to,omitempty" json:"created_to,omitempty"`
+
+	// Limit Размер страницы
+	Limit *LimitQuery `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Cursor Значение next_cursor из предыдущего ответа
+	Cursor *CursorQuery `form:"cursor,omitempty" json:"cursor,omitempty"`
+}
+
+// GetPullRequestListParamsStatus defines parameters for GetPullRequestList.
+type GetPullRequestListParamsStatus string
+
 // PostPullRequestMergeJSONBody defines parameters for PostPullRequestMerge.
 type PostPullRequestMergeJSONBody struct {
 	PullRequestId string `json:"pull_request_id"`
 }
 
+// PostPullRequestMergeParams defines parameters for PostPullRequestMerge.
+type PostPullRequestMergeParams struct {
+	// IdempotencyKey Ключ повтора запроса. Ответ на первый запрос с ключом хранится IDEMPOTENCY_TTL и возвращается
+	// на повторы того же вызывающего с заголовком Idempotent-Replayed: true. Тот же ключ с другим телом — 422
+	// IDEMPOTENCY_KEY_REUSED, повтор до завершения первого запроса — 409 REQUEST_IN_PROGRESS.
+	// Ответы 5xx не сохраняются, такой запрос можно повторить с тем же ключом.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+}
+
+// PostPullRequestReadyJSONBody defines parameters for PostPullRequestReady.
+type PostPullRequestReadyJSONBody struct {
+	// ChangedPaths Изменённые файлы для выбора владельцев путей
+	ChangedPaths  *[]string `json:"changed_paths,omitempty"`
+	PullRequestId string    `json:"pull_request_id"`
+}
+
+// PostPullRequestReadyParams defines parameters for PostPullRequestReady.
+type PostPullRequestReadyParams struct {
+	// IdempotencyKey Ключ повтора запроса. Ответ на первый запрос с ключом хранится IDEMPOTENCY_TTL и возвращается
+	// на повторы того же вызывающего с заголовком Idempotent-Replayed: true. Тот же ключ с другим телом — 422
+	// IDEMPOTENCY_KEY_REUSED, повтор до завершения первого запроса — 409 REQUEST_IN_PROGRESS.
+	// Ответы 5xx не сохраняются, такой запрос можно повторить с тем же ключом.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+}
+
 // PostPullRequestReassignJSONBody defines parameters for PostPullRequestReassign.
 type PostPullRequestReassignJSONBody struct {
 	OldUserId     string `json:"old_user_id"`
 	PullRequestId string `json:"pull_request_id"`
 }
 
-// GetTeamGetParams defines parameters for GetTeamGet.
-type GetTeamGetParams struct {
-	// TeamName Уникальное имя команды
-	TeamName TeamNameQuery `form:"team_name" json:"team_name"`
+// PostPullRequestReassignParams defines parameters for PostPullRequestReassign.
+type PostPullRequestReassignParams struct {
+	// IdempotencyKey Ключ повтора запроса. Ответ на первый запрос с ключом хранится IDEMPOTENCY_TTL и возвращается
+	// на повторы того же вызывающего с заголовком Idempotent-Replayed: true. Тот же ключ с другим телом — 422
+	// IDEMPOTENCY_KEY_REUSED, повтор до завершения первого запроса — 409 REQUEST_IN_PROGRESS.
+	// Ответы 5xx не сохраняются, такой запрос можно повторить с тем же ключом.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
 }
 
-// GetUsersGetReviewParams defines parameters for GetUsersGetReview.
-type GetUsersGetReviewParams struct {
-	// UserId Идентификатор пользователя
-	UserId UserIdQuery `form:"user_id" json:"user_id"`
+// PostPullRequestReopenJSONBody defines parameters for PostPullRequestReopen.
+type PostPullRequestReopenJSONBody struct {
+	PullRequestId string `json:"pull_request_id"`
 }
 
-// PostUsersSetIsActiveJSONBody defines parameters for PostUsersSetIsActive.
-type PostUsersSetIsActiveJSONBody struct {
-	IsActive bool   `json:"is_active"`
-	UserId   string `json:"user_id"`
+// PostPullRequestReopenParams defines parameters for PostPullRequestReopen.
+type PostPullRequestReopenParams struct {
+	// IdempotencyKey Ключ повтора запроса. Ответ на первый запрос с ключом хранится IDEMPOTENCY_TTL и возвращается
+	// на повторы того же вызывающего с заголовком Idempotent-Replayed: true. Тот же ключ с другим телом — 422
+	// IDEMPOTENCY_KEY_REUSED, повтор до завершения первого запроса — 409 REQUEST_IN_PROGRESS.
+	// Ответы 5xx не сохраняются, такой запрос можно повторить с тем же ключом.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
 }
 
-// PostPullRequestCreateJSONRequestBody defines body for PostPullRequestCreate for application/json ContentType.
-type PostPullRequestCreateJSONRequestBody PostPullRequestCreateJSONBody
-
-// PostPullRequestMergeJSONRequestBody defines body for PostPullRequestMerge for application/json ContentType.
-type PostPullRequestMergeJSONRequestBody PostPullRequestMergeJSONBody
+// PostPullRequestReviewJSONBody defines parameters for PostPullRequestReview.
+type PostPullRequestReviewJSONBody struct {
+	Comment       *string                            `json:"comment,omitempty"`
+	PullRequestId string                             `json:"pull_request_id"`
+	ReviewerId    string                             `json:"reviewer_id"`
+	State         PostPullRequestReviewJSONBodyState `json:"state"`
+}
 
-// PostPullRequestReassignJSONRequestBody defines body for PostPullRequestReassign for application/json ContentType.
-type PostPullRequestReassignJSONRequestBody PostPullRequestReassignJSONBody
+// PostPullRequestReviewJSONBodyState defines parameters for PostPullRequestReview.
+type PostPullRequestReviewJSONBodyState string
 
-// PostTeamAddJSONRequestBody defines body for PostTeamAdd for application/json ContentType.
-type PostTeamAddJSONRequestBody = Team
+// PostPullRequestReviewParams defines parameters for PostPullRequestReview.
+type PostPullRequestReviewParams struct {
+	// IdempotencyKey Ключ повтора запроса. Ответ на первый запрос с ключом хранится IDEMPOTENCY_TTL и возвращается
+	// на повторы того же вызывающего с заголовком Idempotent-Replayed: true. Тот же ключ с другим телом — 422
+	// IDEMPOTENCY_KEY_REUSED, повтор до завершения первого запроса — 409 REQUEST_IN_PROGRESS.
+	// Ответы 5xx не сохраняются, такой запрос можно повторить с тем же ключом.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+}
 
-// PostUsersSetIsActiveJSONRequestBody defines body for PostUsersSetIsActive for application/json ContentType.
-type PostUsersSetIsActiveJSONRequestBody PostUsersSetIsActiveJSONBody
+// PostTeamAddParams defines parameters for PostTeamAdd.
+type PostTeamAddParams struct {
+	// IdempotencyKey Ключ повтора запроса. Ответ на первый запрос с ключом хранится IDEMPOTENCY_TTL и возвращается
+	// на повторы того же вызывающего с заголовком Idempotent-Replayed: true. Тот же ключ с другим телом — 422
+	// IDEMPOTENCY_KEY_REUSED, повтор до завершения первого запроса — 409 REQUEST_IN_PROGRESS.
+	// Ответы 5xx не сохраняются, такой запрос можно повторить с тем же ключом.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+}
 
-// ServerInterface represents all server handlers.
-type ServerInterface interface {
-	// Создать PR и автоматически назначить до 2 ревьюверов из команды автора
-	// (POST /pullRequest/create)
-	PostPullRequestCreate(w http.ResponseWriter, r *http.Request)
-	// Пометить PR как MERGED (идемпотентная операция)
-	// (POST /pullRequest/merge)
-	PostPullRequestMerge(w http.ResponseWriter, r *http.Request)
-	// Переназначить конкретного ревьювера на другого из его команды
-	// (POST /pullRequest/reassign)
-	PostPullRequestReassign(w http.ResponseWriter, r *http.Request)
-	// Создать команду с участниками (создаёт/обновляет пользователей)
-	// (POST /team/add)
-	PostTeamAdd(w http.ResponseWriter, r *http.Request)
-	// Получить команду с участниками
-	// (GET /team/get)
-	GetTeamGet(w http.ResponseWriter, r *http.Request, params GetTeamGetParams)
-	// Получить статистику по назначениям пользователей
-	// (GET /user_stats)
-	GetUserStats(w http.ResponseWriter, r *http.Request)
-	// Получить PR'ы, где пользователь назначен ревьювером
-	// (GET /users/getReview)
-	GetUsersGetReview(w http.ResponseWriter, r *http.Request, params GetUsersGetReviewParams)
-	// Установить флаг активности пользователя
-	// (POST /users/setIsActive)
-	PostUsersSetIsActive(w http.ResponseWriter, r *http.Request)
+// PostTeamAddMemberJSONBody defines parameters for PostTeamAddMember.
+type PostTeamAddMemberJSONBody struct {
+	Member   TeamMember `json:"member"`
+	TeamName string     `json:"team_name"`
 }
 
-// Unimplemented server implementation that returns http.StatusNotImplemented for each endpoint.
+// PostTeamAddMemberParams defines parameters for PostTeamAddMember.
+type PostTeamAddMemberParams struct {
+	// IdempotencyKey Ключ повтора запроса. Ответ на первый запрос с ключом хранится IDEMPOTENCY_TTL и возвращается
+	// на повторы того же вызывающего с заголовком Idempotent-Replayed: true. Тот же ключ с другим телом — 422
+	// IDEMPOTENCY_KEY_REUSED, повтор до завершения первого запроса — 409 REQUEST_IN_PROGRESS.
+	// Ответы 5xx не сохраняются, такой запрос можно повторить с тем же ключом.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+}
 
-type Unimplemented struct{}
+// PostTeamArchiveJSONBody defines parameters for PostTeamArchive.
+type PostTeamArchiveJSONBody struct {
+	Policy   TeamRetirePolicy `json:"policy"`
+	TeamName string           `json:"team_name"`
+}
 
-// Создать PR и автоматически назначить до 2 ревьюверов из команды автора
-// (POST /pullRequest/create)
-func (_ Unimplemented) PostPullRequestCreate(w http.ResponseWriter, r *http.Request) {
-	w.WriteHeader(http.StatusNotImplemented)
+// PostTeamArchiveParams defines parameters for PostTeamArchive.
+type PostTeamArchiveParams struct {
+	// IdempotencyKey Ключ повтора запроса. Ответ на первый запрос с ключом хранится IDEMPOTENCY_TTL и возвращается
+	// на повторы того же вызывающего с заголовком Idempotent-Replayed: true. Тот же ключ с другим телом — 422
+	// IDEMPOTENCY_KEY_REUSED, повтор до завершения первого запроса — 409 REQUEST_IN_PROGRESS.
+	// Ответы 5xx не сохраняются, такой запрос можно повторить с тем же ключом.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
 }
 
-// Пометить PR как MERGED (идемпотентная операция)
-// (POST /pullRequest/merge)
-func (_ Unimplemented) PostPullRequestMerge(w http.ResponseWriter, r *http.Request) {
-	w.WriteHeader(http.StatusNotImplemented)
+// GetTeamCodeOwnersParams defines parameters for GetTeamCodeOwners.
+type GetTeamCodeOwnersParams struct {
+	// TeamName Уникальное имя команды
+	TeamName TeamNameQuery `form:"team_name" json:"team_name"`
 }
 
-// Переназначить конкретного ревьювера на другого из его команды
-// (POST /pullRequest/reassign)
-func (_ Unimplemented) PostPullRequestReassign(w http.ResponseWriter, r *http.Request) {
-	w.WriteHeader(http.StatusNotImplemented)
+// PostTeamCodeOwnersJSONBody defines parameters for PostTeamCodeOwners.
+type PostTeamCodeOwnersJSONBody struct {
+	Content  string `json:"content"`
+	TeamName string `json:"team_name"`
 }
 
-// Создать команду с участниками (создаёт/обновляет пользователей)
-// (POST /team/add)
-func (_ Unimplemented) PostTeamAdd(w http.ResponseWriter, r *http.Request) {
-	w.WriteHeader(http.StatusNotImplemented)
+// PostTeamCodeOwnersParams defines parameters for PostTeamCodeOwners.
+type PostTeamCodeOwnersParams struct {
+	// IdempotencyKey Ключ повтора запроса. Ответ на первый запрос с ключом хранится IDEMPOTENCY_TTL и возвращается
+	// на повторы того же вызывающего с заголовком Idempotent-Replayed: true. Тот же ключ с другим телом — 422
+	// IDEMPOTENCY_KEY_REUSED, повтор до завершения первого запроса — 409 REQUEST_IN_PROGRESS.
+	// Ответы 5xx не сохраняются, такой запрос можно повторить с тем же ключом.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
 }
 
-// Получить команду с участниками
-// (GET /team/get)
-func (_ Unimplemented) GetTeamGet(w http.ResponseWriter, r *http.Request, params GetTeamGetParams) {
-	w.WriteHeader(http.StatusNotImplemented)
+// PostTeamDeleteJSONBody defines parameters for PostTeamDelete.
+type PostTeamDeleteJSONBody struct {
+	Policy   TeamRetirePolicy `json:"policy"`
+	TeamName string           `json:"team_name"`
 }
 
-// Получить статистику по назначениям пользователей
-// (GET /user_stats)
-func (_ Unimplemented) GetUserStats(w http.ResponseWriter, r *http.Request) {
-	w.WriteHeader(http.StatusNotImplemented)
+// PostTeamDeleteParams defines parameters for PostTeamDelete.
+type PostTeamDeleteParams struct {
+	// IdempotencyKey Ключ повтора запроса. Ответ на первый запрос с ключом хранится IDEMPOTENCY_TTL и возвращается
+	// на повторы того же вызывающего с заголовком Idempotent-Replayed: true. Тот же ключ с другим телом — 422
+	// IDEMPOTENCY_KEY_REUSED, повтор до завершения первого запроса — 409 REQUEST_IN_PROGRESS.
+	// Ответы 5xx не сохраняются, такой запрос можно повторить с тем же ключом.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
 }
 
-// Получить PR'ы, где пользователь назначен ревьювером
-// (GET /users/getReview)
-func (_ Unimplemented) GetUsersGetReview(w http.ResponseWriter, r *http.Request, params GetUsersGetReviewParams) {
-	w.WriteHeader(http.StatusNotImplemented)
+// GetTeamGetParams defines parameters for GetTeamGet.
+type GetTeamGetParams struct {
+	// TeamName Уникальное имя команды
+	TeamName TeamNameQuery `form:"team_name" json:"team_name"`
 }
 
-// Установить флаг активности пользователя
-// (POST /users/setIsActive)
-func (_ Unimplemented) PostUsersSetIsActive(w http.ResponseWriter, r *http.Request) {
-	w.WriteHeader(http.StatusNotImplemented)
+// PostTeamMoveMemberJSONBody defines parameters for PostTeamMoveMember.
+type PostTeamMoveMemberJSONBody struct {
+	// TeamName Команда, в которую переводится пользователь
+	TeamName string `json:"team_name"`
+	UserId   string `json:"user_id"`
 }
 
-// ServerInterfaceWrapper converts contexts to parameters.
-type ServerInterfaceWrapper struct {
-	Handler            ServerInterface
-	HandlerMiddlewares []MiddlewareFunc
-	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+// PostTeamMoveMemberParams defines parameters for PostTeamMoveMember.
+type PostTeamMoveMemberParams struct {
+	// IdempotencyKey Ключ повтора запроса. Ответ на первый запрос с ключом хранится IDEMPOTENCY_TTL и возвращается
+	// на повторы того же вызывающего с заголовком Idempotent-Replayed: true. Тот же ключ с другим телом — 422
+	// IDEMPOTENCY_KEY_REUSED, повтор до завершения первого запроса — 409 REQUEST_IN_PROGRESS.
+	// Ответы 5xx не сохраняются, такой запрос можно повторить с тем же ключом.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
 }
 
-type MiddlewareFunc func(http.Handler) http.Handler
+// PostTeamRemoveMemberJSONBody defines parameters for PostTeamRemoveMember.
+type PostTeamRemoveMemberJSONBody struct {
+	TeamName string `json:"team_name"`
+	UserId   string `json:"user_id"`
+}
 
-// PostPullRequestCreate operation middleware
-func (siw *ServerInterfaceWrapper) PostPullRequestCreate(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+// PostTeamRemoveMemberParams defines parameters for PostTeamRemoveMember.
+type PostTeamRemoveMemberParams struct {
+	// IdempotencyKey Ключ повтора запроса. Ответ на первый запрос с ключом хранится IDEMPOTENCY_TTL и возвращается
+	// на повторы того же вызывающего с заголовком Idempotent-Replayed: true. Тот же ключ с другим телом — 422
+	// IDEMPOTENCY_KEY_REUSED, повтор до завершения первого запроса — 409 REQUEST_IN_PROGRESS.
+	// Ответы 5xx не сохраняются, такой запрос можно повторить с тем же ключом.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+}
 
-	ctx = context.WithValue(ctx, AdminTokenScopes, []string{})
+// PostTeamSettingsJSONBody defines parameters for PostTeamSettings.
+type PostTeamSettingsJSONBody struct {
+	// FallbackTeams Резервные команды в порядке приоритета; пустой список убирает их
+	FallbackTeams *[]string `json:"fallback_teams,omitempty"`
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostPullRequestCreate(w, r)
-	}))
+	// MaxOpenReviews Лимит открытых ревью на участника; 0 снимает лимит
+	MaxOpenReviews *int `json:"max_open_reviews,omitempty"`
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+	// RequireApprovals Требовать required_reviewers одобрений для слияния
+	RequireApprovals  *bool             `json:"require_approvals,omitempty"`
+	RequiredReviewers *int              `json:"required_reviewers,omitempty"`
+	ReviewerStrategy  *ReviewerStrategy `json:"reviewer_strategy,omitempty"`
+	TeamName          string            `json:"team_name"`
+}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+// PostTeamSettingsParams defines parameters for PostTeamSettings.
+type PostTeamSettingsParams struct {
+	// IdempotencyKey Ключ повтора запроса. Ответ на первый запрос с ключом хранится IDEMPOTENCY_TTL и возвращается
+	// на повторы того же вызывающего с заголовком Idempotent-Replayed: true. Тот же ключ с другим телом — 422
+	// IDEMPOTENCY_KEY_REUSED, повтор до завершения первого запроса — 409 REQUEST_IN_PROGRESS.
+	// Ответы 5xx не сохраняются, такой запрос можно повторить с тем же ключом.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
 }
 
-// PostPullRequestMerge operation middleware
-func (siw *ServerInterfaceWrapper) PostPullRequestMerge(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+// GetUsersAbsencesParams defines parameters for GetUsersAbsences.
+type GetUsersAbsencesParams struct {
+	// UserId Только отсутствия пользователя
+	UserId *string `form:"user_id,omitempty" json:"user_id,omitempty"`
 
-	ctx = context.WithValue(ctx, AdminTokenScopes, []string{})
+	// IncludeFinished Вернуть и завершённые
+	IncludeFinished *bool `form:"include_finished,omitempty" json:"include_finished,omitempty"`
+}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostPullRequestMerge(w, r)
-	}))
+// PostUsersAbsencesCancelJSONBody defines parameters for PostUsersAbsencesCancel.
+type PostUsersAbsencesCancelJSONBody struct {
+	Id int64 `json:"id"`
+}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+// PostUsersAbsencesCancelParams defines parameters for PostUsersAbsencesCancel.
+type PostUsersAbsencesCancelParams struct {
+	// IdempotencyKey Ключ повтора запроса. Ответ на первый запрос с ключом хранится IDEMPOTENCY_TTL и возвращается
+	// на повторы того же вызывающего с заголовком Idempotent-Replayed: true. Тот же ключ с другим телом — 422
+	// IDEMPOTENCY_KEY_REUSED, повтор до завершения первого запроса — 409 REQUEST_IN_PROGRESS.
+	// Ответы 5xx не сохраняются, такой запрос можно повторить с тем же ключом.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+// PostUsersAbsencesCreateJSONBody defines parameters for PostUsersAbsencesCreate.
+type PostUsersAbsencesCreateJSONBody struct {
+	EndsAt   time.Time `json:"ends_at"`
+	Reason   *string   `json:"reason,omitempty"`
+	StartsAt time.Time `json:"starts_at"`
+	UserId   string    `json:"user_id"`
 }
 
-// PostPullRequestReassign operation middleware
-func (siw *ServerInterfaceWrapper) PostPullRequestReassign(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
-
-	ctx = context.WithValue(ctx, AdminTokenScopes, []string{})
+// PostUsersAbsencesCreateParams defines parameters for PostUsersAbsencesCreate.
+type PostUsersAbsencesCreateParams struct {
+	// IdempotencyKey Ключ повтора запроса. Ответ на первый запрос с ключом хранится IDEMPOTENCY_TTL и возвращается
+	// на повторы того же вызывающего с заголовком Idempotent-Replayed: true. Тот же ключ с другим телом — 422
+	// IDEMPOTENCY_KEY_REUSED, повтор до завершения первого запроса — 409 REQUEST_IN_PROGRESS.
+	// Ответы 5xx не сохраняются, такой запрос можно повторить с тем же ключом.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostPullRequestReassign(w, r)
-	}))
+// GetUsersGetReviewParams defines parameters for GetUsersGetReview.
+type GetUsersGetReviewParams struct {
+	// UserId Идентификатор пользователя
+	UserId UserIdQuery                    `form:"user_id" json:"user_id"`
+	Status *GetUsersGetReviewParamsStatus `form:"status,omitempty" json:"status,omitempty"`
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+	// Limit Размер страницы
+	Limit *LimitQuery `form:"limit,omitempty" json:"limit,omitempty"`
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	// Cursor Значение next_cursor из предыдущего ответа
+	Cursor *CursorQuery `form:"cursor,omitempty" json:"cursor,omitempty"`
 }
 
-// PostTeamAdd operation middleware
-func (siw *ServerInterfaceWrapper) PostTeamAdd(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+// GetUsersGetReviewParamsStatus defines parameters for GetUsersGetReview.
+type GetUsersGetReviewParamsStatus string
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostTeamAdd(w, r)
-	}))
+// PostUsersLinkIdentityParams defines parameters for PostUsersLinkIdentity.
+type PostUsersLinkIdentityParams struct {
+	// IdempotencyKey Ключ повтора запроса. Ответ на первый запрос с ключом хранится IDEMPOTENCY_TTL и возвращается
+	// на повторы того же вызывающего с заголовком Idempotent-Replayed: true. Тот же ключ с другим телом — 422
+	// IDEMPOTENCY_KEY_REUSED, повтор до завершения первого запроса — 409 REQUEST_IN_PROGRESS.
+	// Ответы 5xx не сохраняются, такой запрос можно повторить с тем же ключом.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+// PostUsersSetIsActiveJSONBody defines parameters for PostUsersSetIsActive.
+type PostUsersSetIsActiveJSONBody struct {
+	IsActive bool   `json:"is_active"`
+	UserId   string `json:"user_id"`
+}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+// PostUsersSetIsActiveParams defines parameters for PostUsersSetIsActive.
+type PostUsersSetIsActiveParams struct {
+	// IdempotencyKey Ключ повтора запроса. Ответ на первый запрос с ключом хранится IDEMPOTENCY_TTL и возвращается
+	// на повторы того же вызывающего с заголовком Idempotent-Replayed: true. Тот же ключ с другим телом — 422
+	// IDEMPOTENCY_KEY_REUSED, повтор до завершения первого запроса — 409 REQUEST_IN_PROGRESS.
+	// Ответы 5xx не сохраняются, такой запрос можно повторить с тем же ключом.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
 }
 
-// GetTeamGet operation middleware
-func (siw *ServerInterfaceWrapper) GetTeamGet(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+// PostUsersSetMaxOpenReviewsJSONBody defines parameters for PostUsersSetMaxOpenReviews.
+type PostUsersSetMaxOpenReviewsJSONBody struct {
+	MaxOpenReviews int    `json:"max_open_reviews"`
+	UserId         string `json:"user_id"`
+}
 
-	var err error
+// PostUsersSetMaxOpenReviewsParams defines parameters for PostUsersSetMaxOpenReviews.
+type PostUsersSetMaxOpenReviewsParams struct {
+	// IdempotencyKey Ключ повтора запроса. Ответ на первый запрос с ключом хранится IDEMPOTENCY_TTL и возвращается
+	// на повторы того же вызывающего с заголовком Idempotent-Replayed: true. Тот же ключ с другим телом — 422
+	// IDEMPOTENCY_KEY_REUSED, повтор до завершения первого запроса — 409 REQUEST_IN_PROGRESS.
+	// Ответы 5xx не сохраняются, такой запрос можно повторить с тем же ключом.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+}
 
-	ctx = context.WithValue(ctx, AdminTokenScopes, []string{})
+// PostUsersUnlinkIdentityJSONBody defines parameters for PostUsersUnlinkIdentity.
+type PostUsersUnlinkIdentityJSONBody struct {
+	Login    string      `json:"login"`
+	Provider VcsProvider `json:"provider"`
+}
 
-	ctx = context.WithValue(ctx, UserTokenScopes, []string{})
+// PostUsersUnlinkIdentityParams defines parameters for PostUsersUnlinkIdentity.
+type PostUsersUnlinkIdentityParams struct {
+	// IdempotencyKey Ключ повтора запроса. Ответ на первый запрос с ключом хранится IDEMPOTENCY_TTL и возвращается
+	// на повторы того же вызывающего с заголовком Idempotent-Replayed: true. Тот же ключ с другим телом — 422
+	// IDEMPOTENCY_KEY_REUSED, повтор до завершения первого запроса — 409 REQUEST_IN_PROGRESS.
+	// Ответы 5xx не сохраняются, такой запрос можно повторить с тем же ключом.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+}
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetTeamGetParams
+// GetUsersWorkloadParams defines parameters for GetUsersWorkload.
+type GetUsersWorkloadParams struct {
+	// TeamName Только участники команды
+	TeamName *string `form:"team_name,omitempty" json:"team_name,omitempty"`
+}
 
-	// ------------- Required query parameter "team_name" -------------
+// PostWebhookCreateJSONBody defines parameters for PostWebhookCreate.
+type PostWebhookCreateJSONBody struct {
+	Events []WebhookEvent `json:"events"`
 
-	if paramValue := r.URL.Query().Get("team_name"); paramValue != "" {
+	// Secret Секрет подписи, генерируется, если не передан
+	Secret *string `json:"secret,omitempty"`
+	Url    string  `json:"url"`
+}
 
-	} else {
-		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "team_name"})
-		return
-	}
+// PostWebhookCreateParams defines parameters for PostWebhookCreate.
+type PostWebhookCreateParams struct {
+	// IdempotencyKey Ключ повтора запроса. Ответ на первый запрос с ключом хранится IDEMPOTENCY_TTL и возвращается
+	// на повторы того же вызывающего с заголовком Idempotent-Replayed: true. Тот же ключ с другим телом — 422
+	// IDEMPOTENCY_KEY_REUSED, повтор до завершения первого запроса — 409 REQUEST_IN_PROGRESS.
+	// Ответы 5xx не сохраняются, такой запрос можно повторить с тем же ключом.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+}
 
-	err = runtime.BindQueryParameter("form", true, true, "team_name", r.URL.Query(), &params.TeamName)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "team_name", Err: err})
-		return
-	}
+// PostWebhookDeleteJSONBody defines parameters for PostWebhookDelete.
+type PostWebhookDeleteJSONBody struct {
+	Id int64 `json:"id"`
+}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetTeamGet(w, r, params)
-	}))
+// PostWebhookDeleteParams defines parameters for PostWebhookDelete.
+type PostWebhookDeleteParams struct {
+	// IdempotencyKey Ключ повтора запроса. Ответ на первый запрос с ключом хранится IDEMPOTENCY_TTL и возвращается
+	// на повторы того же вызывающего с заголовком Idempotent-Replayed: true. Тот же ключ с другим телом — 422
+	// IDEMPOTENCY_KEY_REUSED, повтор до завершения первого запроса — 409 REQUEST_IN_PROGRESS.
+	// Ответы 5xx не сохраняются, такой запрос можно повторить с тем же ключом.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+// GetWebhookDeliveriesParams defines parameters for GetWebhookDeliveries.
+type GetWebhookDeliveriesParams struct {
+	WebhookId int64                             `form:"webhook_id" json:"webhook_id"`
+	Status    *GetWebhookDeliveriesParamsStatus `form:"status,omitempty" json:"status,omitempty"`
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	// Limit Размер страницы
+	Limit *LimitQuery `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Cursor Значение next_cursor из предыдущего ответа
+	Cursor *CursorQuery `form:"cursor,omitempty" json:"cursor,omitempty"`
 }
 
-// GetUserStats operation middleware
-func (siw *ServerInterfaceWrapper) GetUserStats(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+// GetWebhookDeliveriesParamsStatus defines parameters for GetWebhookDeliveries.
+type GetWebhookDeliveriesParamsStatus string
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetUserStats(w, r)
-	}))
+// PostWebhookUpdateJSONBody defines parameters for PostWebhookUpdate.
+type PostWebhookUpdateJSONBody struct {
+	Events *[]WebhookEvent `json:"events,omitempty"`
+	Id     int64           `json:"id"`
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+	// IsActive Неактивной подписке доставки не отправляются, но копятся
+	IsActive *bool   `json:"is_active,omitempty"`
+	Url      *string `json:"url,omitempty"`
+}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+// PostWebhookUpdateParams defines parameters for PostWebhookUpdate.
+type PostWebhookUpdateParams struct {
+	// IdempotencyKey Ключ повтора запроса. Ответ на первый запрос с ключом хранится IDEMPOTENCY_TTL и возвращается
+	// на повторы того же вызывающего с заголовком Idempotent-Replayed: true. Тот же ключ с другим телом — 422
+	// IDEMPOTENCY_KEY_REUSED, повтор до завершения первого запроса — 409 REQUEST_IN_PROGRESS.
+	// Ответы 5xx не сохраняются, такой запрос можно повторить с тем же ключом.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
 }
 
-// GetUsersGetReview operation middleware
-func (siw *ServerInterfaceWrapper) GetUsersGetReview(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+// PostPullRequestCloseJSONRequestBody defines body for PostPullRequestClose for application/json ContentType.
+type PostPullRequestCloseJSONRequestBody PostPullRequestCloseJSONBody
 
-	var err error
+// PostPullRequestCreateJSONRequestBody defines body for PostPullRequestCreate for application/json ContentType.
+type PostPullRequestCreateJSONRequestBody PostPullRequestCreateJSONBody
 
-	ctx = context.WithValue(ctx, AdminTokenScopes, []string{})
+// PostPullRequestMergeJSONRequestBody defines body for PostPullRequestMerge for application/json ContentType.
+type PostPullRequestMergeJSONRequestBody PostPullRequestMergeJSONBody
 
-	ctx = context.WithValue(ctx, UserTokenScopes, []string{})
+// PostPullRequestReadyJSONRequestBody defines body for PostPullRequestReady for application/json ContentType.
+type PostPullRequestReadyJSONRequestBody PostPullRequestReadyJSONBody
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetUsersGetReviewParams
+// PostPullRequestReassignJSONRequestBody defines body for PostPullRequestReassign for application/json ContentType.
+type PostPullRequestReassignJSONRequestBody PostPullRequestReassignJSONBody
 
-	// ------------- Required query parameter "user_id" -------------
+// PostPullRequestReopenJSONRequestBody defines body for PostPullRequestReopen for application/json ContentType.
+type PostPullRequestReopenJSONRequestBody PostPullRequestReopenJSONBody
 
-	if paramValue := r.URL.Query().Get("user_id"); paramValue != "" {
+// PostPullRequestReviewJSONRequestBody defines body for PostPullRequestReview for application/json ContentType.
+type PostPullRequestReviewJSONRequestBody PostPullRequestReviewJSONBody
 
-	} else {
-		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "user_id"})
-		return
-	}
+// PostTeamAddJSONRequestBody defines body for PostTeamAdd for application/json ContentType.
+type PostTeamAddJSONRequestBody = Team
 
-	err = runtime.BindQueryParameter("form", true, true, "user_id", r.URL.Query(), &params.UserId)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
-		return
-	}
+// PostTeamAddMemberJSONRequestBody defines body for PostTeamAddMember for application/json ContentType.
+type PostTeamAddMemberJSONRequestBody PostTeamAddMemberJSONBody
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetUsersGetReview(w, r, params)
-	}))
+// PostTeamArchiveJSONRequestBody defines body for PostTeamArchive for application/json ContentType.
+type PostTeamArchiveJSONRequestBody PostTeamArchiveJSONBody
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+// PostTeamCodeOwnersJSONRequestBody defines body for PostTeamCodeOwners for application/json ContentType.
+type PostTeamCodeOwnersJSONRequestBody PostTeamCodeOwnersJSONBody
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
-}
+// PostTeamDeleteJSONRequestBody defines body for PostTeamDelete for application/json ContentType.
+type PostTeamDeleteJSONRequestBody PostTeamDeleteJSONBody
 
-// PostUsersSetIsActive operation middleware
-func (siw *ServerInterfaceWrapper) PostUsersSetIsActive(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+// PostTeamMoveMemberJSONRequestBody defines body for PostTeamMoveMember for application/json ContentType.
+type PostTeamMoveMemberJSONRequestBody PostTeamMoveMemberJSONBody
 
-	ctx = context.WithValue(ctx, AdminTokenScopes, []string{})
+// PostTeamRemoveMemberJSONRequestBody defines body for PostTeamRemoveMember for application/json ContentType.
+type PostTeamRemoveMemberJSONRequestBody PostTeamRemoveMemberJSONBody
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostUsersSetIsActive(w, r)
-	}))
+// PostTeamSettingsJSONRequestBody defines body for PostTeamSettings for application/json ContentType.
+type PostTeamSettingsJSONRequestBody PostTeamSettingsJSONBody
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+// PostUsersAbsencesCancelJSONRequestBody defines body for PostUsersAbsencesCancel for application/json ContentType.
+type PostUsersAbsencesCancelJSONRequestBody PostUsersAbsencesCancelJSONBody
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+// PostUsersAbsencesCreateJSONRequestBody defines body for PostUsersAbsencesCreate for application/json ContentType.
+type PostUsersAbsencesCreateJSONRequestBody PostUsersAbsencesCreateJSONBody
+
+// PostUsersLinkIdentityJSONRequestBody defines body for PostUsersLinkIdentity for application/json ContentType.
+type PostUsersLinkIdentityJSONRequestBody = ExternalIdentity
+
+// PostUsersSetIsActiveJSONRequestBody defines body for PostUsersSetIsActive for application/json ContentType.
+type PostUsersSetIsActiveJSONRequestBody PostUsersSetIsActiveJSONBody
+
+// PostUsersSetMaxOpenReviewsJSONRequestBody defines body for PostUsersSetMaxOpenReviews for application/json ContentType.
+type PostUsersSetMaxOpenReviewsJSONRequestBody PostUsersSetMaxOpenReviewsJSONBody
+
+// PostUsersUnlinkIdentityJSONRequestBody defines body for PostUsersUnlinkIdentity for application/json ContentType.
+type PostUsersUnlinkIdentityJSONRequestBody PostUsersUnlinkIdentityJSONBody
+
+// PostWebhookCreateJSONRequestBody defines body for PostWebhookCreate for application/json ContentType.
+type PostWebhookCreateJSONRequestBody PostWebhookCreateJSONBody
+
+// PostWebhookDeleteJSONRequestBody defines body for PostWebhookDelete for application/json ContentType.
+type PostWebhookDeleteJSONRequestBody PostWebhookDeleteJSONBody
+
+// PostWebhookUpdateJSONRequestBody defines body for PostWebhookUpdate for application/json ContentType.
+type PostWebhookUpdateJSONRequestBody PostWebhookUpdateJSONBody
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+	// Журнал изменений (новые первыми), только для администратора
+	// (GET /audit)
+	GetAudit(w http.ResponseWriter, r *http.Request, params GetAuditParams)
+	// Процесс жив и отвечает на запросы
+	// (GET /health/live)
+	GetHealthLive(w http.ResponseWriter, r *http.Request)
+	// Реплика готова принимать трафик (БД, миграции, воркер событий)
+	// (GET /health/ready)
+	GetHealthReady(w http.ResponseWriter, r *http.Request)
+	// Закрыть PR без слияния
+	// (POST /pullRequest/close)
+	PostPullRequestClose(w http.ResponseWriter, r *http.Request, params PostPullRequestCloseParams)
+	// Создать PR и автоматически назначить ревьюверов из команды автора (сколько требует команда)
+	// (POST /pullRequest/create)
+	PostPullRequestCreate(w http.ResponseWriter, r *http.Request, params PostPullRequestCreateParams)
+	// Получить PR с ревьюверами
+	// (GET /pullRequest/get)
+	GetPullRequestGet(w http.ResponseWriter, r *http.Request, params GetPullRequestGetParams)
+	// История назначений ревьюверов на PR, включая снятых
+	// (GET /pullRequest/history)
+	GetPullRequestHistory(w http.ResponseWriter, r *http.Request, params GetPullRequestHistoryParams)
+	// Список PR с фильтрами и курсорной пагинацией (новые первыми)
+	// (GET /pullRequest/list)
+	GetPullRequestList(w http.ResponseWriter, r *http.Request, params GetPullRequestListParams)
+	// Пометить PR как MERGED (идемпотентная операция)
+	// (POST /pullRequest/merge)
+	PostPullRequestMerge(w http.ResponseWriter, r *http.Request, params PostPullRequestMergeParams)
+	// Перевести черновик в OPEN и назначить ревьюверов
+	// (POST /pullRequest/ready)
+	PostPullRequestReady(w http.ResponseWriter, r *http.Request, params PostPullRequestReadyParams)
+	// Переназначить конкретного ревьювера на другого из его команды
+	// (POST /pullRequest/reassign)
+	PostPullRequestReassign(w http.ResponseWriter, r *http.Request, params PostPullRequestReassignParams)
+	// Открыть закрытый PR заново
+	// (POST /pullRequest/reopen)
+	PostPullRequestReopen(w http.ResponseWriter, r *http.Request, params PostPullRequestReopenParams)
+	// Отправить ревью текущего ревьювера
+	// (POST /pullRequest/review)
+	PostPullRequestReview(w http.ResponseWriter, r *http.Request, params PostPullRequestReviewParams)
+	// Создать команду с участниками (новых создаёт, существующих переводит в команду)
+	// (POST /team/add)
+	PostTeamAdd(w http.ResponseWriter, r *http.Request, params PostTeamAddParams)
+	// Добавить в команду нового пользователя или пользователя без команды
+	// (POST /team/addMember)
+	PostTeamAddMember(w http.ResponseWriter, r *http.Request, params PostTeamAddMemberParams)
+	// Архивировать команду — деактивировать участников и разобрать их открытые PR
+	// (POST /team/archive)
+	PostTeamArchive(w http.ResponseWriter, r *http.Request, params PostTeamArchiveParams)
+	// Получить файл владельцев путей команды
+	// (GET /team/codeOwners)
+	GetTeamCodeOwners(w http.ResponseWriter, r *http.Request, params GetTeamCodeOwnersParams)
+	// Задать файл владельцев путей команды (CODEOWNERS)
+	// (POST /team/codeOwners)
+	PostTeamCodeOwners(w http.ResponseWriter, r *http.Request, params PostTeamCodeOwnersParams)
+	// Удалить команду — вывести из неё участников и разобрать их открытые PR
+	// (POST /team/delete)
+	PostTeamDelete(w http.ResponseWriter, r *http.Request, params PostTeamDeleteParams)
+	// Получить команду с участниками
+	// (GET /team/get)
+	GetTeamGet(w http.ResponseWriter, r *http.Request, params GetTeamGetParams)
+	// Перевести пользователя в другую команду с переназначением его открытых ревью в прежней
+	// (POST /team/moveMember)
+	PostTeamMoveMember(w http.ResponseWriter, r *http.Request, params PostTeamMoveMemberParams)
+	// Вывести пользователя из команды с переназначением его открытых ревью
+	// (POST /team/removeMember)
+	PostTeamRemoveMember(w http.ResponseWriter, r *http.Request, params PostTeamRemoveMemberParams)
+	// Изменить настройки назначения ревьюверов команды
+	// (POST /team/settings)
+	PostTeamSettings(w http.ResponseWriter, r *http.Request, params PostTeamSettingsParams)
+	// Получить статистику по назначениям пользователей
+	// (GET /user_stats)
+	GetUserStats(w http.ResponseWriter, r *http.Request)
+	// Запланированные и текущие отсутствия
+	// (GET /users/absences)
+	GetUsersAbsences(w http.ResponseWriter, r *http.Request, params GetUsersAbsencesParams)
+	// Отменить отсутствие или досрочно завершить текущее
+	// (POST /users/absences/cancel)
+	PostUsersAbsencesCancel(w http.ResponseWriter, r *http.Request, params PostUsersAbsencesCancelParams)
+	// Запланировать отсутствие пользователя
+	// (POST /users/absences/create)
+	PostUsersAbsencesCreate(w http.ResponseWriter, r *http.Request, params PostUsersAbsencesCreateParams)
+	// Получить PR'ы, где пользователь назначен ревьювером (последние назначения первыми)
+	// (GET /users/getReview)
+	GetUsersGetReview(w http.ResponseWriter, r *http.Request, params GetUsersGetReviewParams)
+	// Привязать логин GitHub/GitLab к пользователю
+	// (POST /users/linkIdentity)
+	PostUsersLinkIdentity(w http.ResponseWriter, r *http.Request, params PostUsersLinkIdentityParams)
+	// Установить флаг активности пользователя
+	// (POST /users/setIsActive)
+	PostUsersSetIsActive(w http.ResponseWriter, r *http.Request, params PostUsersSetIsActiveParams)
+	// Задать личный лимит одновременных открытых ревью
+	// (POST /users/setMaxOpenReviews)
+	PostUsersSetMaxOpenReviews(w http.ResponseWriter, r *http.Request, params PostUsersSetMaxOpenReviewsParams)
+	// Отвязать логин GitHub/GitLab
+	// (POST /users/unlinkIdentity)
+	PostUsersUnlinkIdentity(w http.ResponseWriter, r *http.Request, params PostUsersUnlinkIdentityParams)
+	// Текущие открытые ревью пользователей против их лимита
+	// (GET /users/workload)
+	GetUsersWorkload(w http.ResponseWriter, r *http.Request, params GetUsersWorkloadParams)
+	// Подписаться на события
+	// (POST /webhook/create)
+	PostWebhookCreate(w http.ResponseWriter, r *http.Request, params PostWebhookCreateParams)
+	// Удалить подписку вместе с журналом доставок
+	// (POST /webhook/delete)
+	PostWebhookDelete(w http.ResponseWriter, r *http.Request, params PostWebhookDeleteParams)
+	// Журнал доставок подписки (новые первыми)
+	// (GET /webhook/deliveries)
+	GetWebhookDeliveries(w http.ResponseWriter, r *http.Request, params GetWebhookDeliveriesParams)
+	// Список подписок
+	// (GET /webhook/list)
+	GetWebhookList(w http.ResponseWriter, r *http.Request)
+	// Изменить подписку
+	// (POST /webhook/update)
+	PostWebhookUpdate(w http.ResponseWriter, r *http.Request, params PostWebhookUpdateParams)
 }
 
-type UnescapedCookieParamError struct {
-	ParamName string
-	Err       error
+// Unimplemented server implementation that returns http.StatusNotImplemented for each endpoint.
+
+type Unimplemented struct{}
+
+// Журнал изменений (новые первыми), только для администратора
+// (GET /audit)
+func (_ Unimplemented) GetAudit(w http.ResponseWriter, r *http.Request, params GetAuditParams) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-func (e *UnescapedCookieParamError) Error() string {
-	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
+// Процесс жив и отвечает на запросы
+// (GET /health/live)
+func (_ Unimplemented) GetHealthLive(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-func (e *UnescapedCookieParamError) Unwrap() error {
-	return e.Err
+// Реплика готова принимать трафик (БД, миграции, воркер событий)
+// (GET /health/ready)
+func (_ Unimplemented) GetHealthReady(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-type UnmarshalingParamError struct {
-	ParamName string
-	Err       error
+// Закрыть PR без слияния
+// (POST /pullRequest/close)
+func (_ Unimplemented) PostPullRequestClose(w http.ResponseWriter, r *http.Request, params PostPullRequestCloseParams) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-func (e *UnmarshalingParamError) Error() string {
-	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
+// Создать PR и автоматически назначить ревьюверов из команды автора (сколько требует команда)
+// (POST /pullRequest/create)
+func (_ Unimplemented) PostPullRequestCreate(w http.ResponseWriter, r *http.Request, params PostPullRequestCreateParams) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-func (e *UnmarshalingParamError) Unwrap() error {
-	return e.Err
+// Получить PR с ревьюверами
+// (GET /pullRequest/get)
+func (_ Unimplemented) GetPullRequestGet(w http.ResponseWriter, r *http.Request, params GetPullRequestGetParams) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-type RequiredParamError struct {
-	ParamName string
+// История назначений ревьюверов на PR, включая снятых
+// (GET /pullRequest/history)
+func (_ Unimplemented) GetPullRequestHistory(w http.ResponseWriter, r *http.Request, params GetPullRequestHistoryParams) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-func (e *RequiredParamError) Error() string {
-	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
+// Список PR с фильтрами и курсорной пагинацией (новые первыми)
+// (GET /pullRequest/list)
+func (_ Unimplemented) GetPullRequestList(w http.ResponseWriter, r *http.Request, params GetPullRequestListParams) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-type RequiredHeaderError struct {
-	ParamName string
-	Err       error
+// Пометить PR как MERGED (идемпотентная операция)
+// (POST /pullRequest/merge)
+func (_ Unimplemented) PostPullRequestMerge(w http.ResponseWriter, r *http.Request, params PostPullRequestMergeParams) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-func (e *RequiredHeaderError) Error() string {
-	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
+// Перевести черновик в OPEN и назначить ревьюверов
+// (POST /pullRequest/ready)
+func (_ Unimplemented) PostPullRequestReady(w http.ResponseWriter, r *http.Request, params PostPullRequestReadyParams) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-func (e *RequiredHeaderError) Unwrap() error {
-	return e.Err
+// Переназначить конкретного ревьювера на другого из его команды
+// (POST /pullRequest/reassign)
+func (_ Unimplemented) PostPullRequestReassign(w http.ResponseWriter, r *http.Request, params PostPullRequestReassignParams) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-type InvalidParamFormatError struct {
-	ParamName string
-	Err       error
+// Открыть закрытый PR заново
+// (POST /pullRequest/reopen)
+func (_ Unimplemented) PostPullRequestReopen(w http.ResponseWriter, r *http.Request, params PostPullRequestReopenParams) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-func (e *InvalidParamFormatError) Error() string {
-	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
+// Отправить ревью текущего ревьювера
+// (POST /pullRequest/review)
+func (_ Unimplemented) PostPullRequestReview(w http.ResponseWriter, r *http.Request, params PostPullRequestReviewParams) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-func (e *InvalidParamFormatError) Unwrap() error {
-	return e.Err
+// Создать команду с участниками (новых создаёт, существующих переводит в команду)
+// (POST /team/add)
+func (_ Unimplemented) PostTeamAdd(w http.ResponseWriter, r *http.Request, params PostTeamAddParams) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-type TooManyValuesForParamError struct {
-	ParamName string
-	Count     int
+// Добавить в команду нового пользователя или пользователя без команды
+// (POST /team/addMember)
+func (_ Unimplemented) PostTeamAddMember(w http.ResponseWriter, r *http.Request, params PostTeamAddMemberParams) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-func (e *TooManyValuesForParamError) Error() string {
-	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
+// Архивировать команду — деактивировать участников и разобрать их открытые PR
+// (POST /team/archive)
+func (_ Unimplemented) PostTeamArchive(w http.ResponseWriter, r *http.Request, params PostTeamArchiveParams) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// Handler creates http.Handler with routing matching OpenAPI spec.
-func Handler(si ServerInterface) http.Handler {
-	return HandlerWithOptions(si, ChiServerOptions{})
+// Получить файл владельцев путей команды
+// (GET /team/codeOwners)
+func (_ Unimplemented) GetTeamCodeOwners(w http.ResponseWriter, r *http.Request, params GetTeamCodeOwnersParams) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-type ChiServerOptions struct {
-	BaseURL          string
-	BaseRouter       chi.Router
-	Middlewares      []MiddlewareFunc
-	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+// Задать файл владельцев путей команды (CODEOWNERS)
+// (POST /team/codeOwners)
+func (_ Unimplemented) PostTeamCodeOwners(w http.ResponseWriter, r *http.Request, params PostTeamCodeOwnersParams) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
-func HandlerFromMux(si ServerInterface, r chi.Router) http.Handler {
-	return HandlerWithOptions(si, ChiServerOptions{
-		BaseRouter: r,
-	})
+// Удалить команду — вывести из неё участников и разобрать их открытые PR
+// (POST /team/delete)
+func (_ Unimplemented) PostTeamDelete(w http.ResponseWriter, r *http.Request, params PostTeamDeleteParams) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-func HandlerFromMuxWithBaseURL(si ServerInterface, r chi.Router, baseURL string) http.Handler {
-	return HandlerWithOptions(si, ChiServerOptions{
-		BaseURL:    baseURL,
-		BaseRouter: r,
-	})
+// Получить команду с участниками
+// (GET /team/get)
+func (_ Unimplemented) GetTeamGet(w http.ResponseWriter, r *http.Request, params GetTeamGetParams) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// HandlerWithOptions creates http.Handler with additional options
-func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handler {
-	r := options.BaseRouter
+// Перевести пользователя в другую команду с переназначением его открытых ревью в прежней
+// (POST /team/moveMember)
+func (_ Unimplemented) PostTeamMoveMember(w http.ResponseWriter, r *http.Request, params PostTeamMoveMemberParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	if r == nil {
-		r = chi.NewRouter()
-	}
-	if options.ErrorHandlerFunc == nil {
-		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusBadRequest)
-		}
-	}
-	wrapper := ServerInterfaceWrapper{
-		Handler:            si,
-		HandlerMiddlewares: options.Middlewares,
-		ErrorHandlerFunc:   options.ErrorHandlerFunc,
-	}
+// Вывести пользователя из команды с переназначением его открытых ревью
+// (POST /team/removeMember)
+func (_ Unimplemented) PostTeamRemoveMember(w http.ResponseWriter, r *http.Request, params PostTeamRemoveMemberParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/pullRequest/create", wrapper.PostPullRequestCreate)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/pullRequest/merge", wrapper.PostPullRequestMerge)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/pullRequest/reassign", wrapper.PostPullRequestReassign)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/team/add", wrapper.PostTeamAdd)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/team/get", wrapper.GetTeamGet)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/user_stats", wrapper.GetUserStats)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/users/getReview", wrapper.GetUsersGetReview)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/users/setIsActive", wrapper.PostUsersSetIsActive)
-	})
-
-	return r
+// Изменить настройки назначения ревьюверов команды
+// (POST /team/settings)
+func (_ Unimplemented) PostTeamSettings(w http.ResponseWriter, r *http.Request, params PostTeamSettingsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-type PostPullRequestCreateRequestObject struct {
-	Body *PostPullRequestCreateJSONRequestBody
+// Получить статистику по назначениям пользователей
+// (GET /user_stats)
+func (_ Unimplemented) GetUserStats(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-type PostPullRequestCreateResponseObject interface {
-	VisitPostPullRequestCreateResponse(w http.ResponseWriter) error
+// Запланированные и текущие отсутствия
+// (GET /users/absences)
+func (_ Unimplemented) GetUsersAbsences(w http.ResponseWriter, r *http.Request, params GetUsersAbsencesParams) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-type PostPullRequestCreate201JSONResponse struct {
-	Pr *PullRequest `json:"pr,omitempty"`
+// Отменить отсутствие или досрочно завершить текущее
+// (POST /users/absences/cancel)
+func (_ Unimplemented) PostUsersAbsencesCancel(w http.ResponseWriter, r *http.Request, params PostUsersAbsencesCancelParams) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-func (response PostPullRequestCreate201JSONResponse) VisitPostPullRequestCreateResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+// Запланировать отсутствие пользователя
+// (POST /users/absences/create)
+func (_ Unimplemented) PostUsersAbsencesCreate(w http.ResponseWriter, r *http.Request, params PostUsersAbsencesCreateParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	return json.NewEncoder(w).Encode(response)
+// Получить PR'ы, где пользователь назначен ревьювером (последние назначения первыми)
+// (GET /users/getReview)
+func (_ Unimplemented) GetUsersGetReview(w http.ResponseWriter, r *http.Request, params GetUsersGetReviewParams) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-type PostPullRequestCreate404JSONResponse ErrorResponse
+// Привязать логин GitHub/GitLab к пользователю
+// (POST /users/linkIdentity)
+func (_ Unimplemented) PostUsersLinkIdentity(w http.ResponseWriter, r *http.Request, params PostUsersLinkIdentityParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-func (response PostPullRequestCreate404JSONResponse) VisitPostPullRequestCreateResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+// Установить флаг активности пользователя
+// (POST /users/setIsActive)
+func (_ Unimplemented) PostUsersSetIsActive(w http.ResponseWriter, r *http.Request, params PostUsersSetIsActiveParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	return json.NewEncoder(w).Encode(response)
+// Задать личный лимит одновременных открытых ревью
+// (POST /users/setMaxOpenReviews)
+func (_ Unimplemented) PostUsersSetMaxOpenReviews(w http.ResponseWriter, r *http.Request, params PostUsersSetMaxOpenReviewsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-type PostPullRequestCreate409JSONResponse ErrorResponse
+// Отвязать логин GitHub/GitLab
+// (POST /users/unlinkIdentity)
+func (_ Unimplemented) PostUsersUnlinkIdentity(w http.ResponseWriter, r *http.Request, params PostUsersUnlinkIdentityParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-func (response PostPullRequestCreate409JSONResponse) VisitPostPullRequestCreateResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(409)
+// Текущие открытые ревью пользователей против их лимита
+// (GET /users/workload)
+func (_ Unimplemented) GetUsersWorkload(w http.ResponseWriter, r *http.Request, params GetUsersWorkloadParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	return json.NewEncoder(w).Encode(response)
+// Подписаться на события
+// (POST /webhook/create)
+func (_ Unimplemented) PostWebhookCreate(w http.ResponseWriter, r *http.Request, params PostWebhookCreateParams) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-type PostPullRequestMergeRequestObject struct {
-	Body *PostPullRequestMergeJSONRequestBody
+// Удалить подписку вместе с журналом доставок
+// (POST /webhook/delete)
+func (_ Unimplemented) PostWebhookDelete(w http.ResponseWriter, r *http.Request, params PostWebhookDeleteParams) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-type PostPullRequestMergeResponseObject interface {
-	VisitPostPullRequestMergeResponse(w http.ResponseWriter) error
+// Журнал доставок подписки (новые первыми)
+// (GET /webhook/deliveries)
+func (_ Unimplemented) GetWebhookDeliveries(w http.ResponseWriter, r *http.Request, params GetWebhookDeliveriesParams) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-type PostPullRequestMerge200JSONResponse struct {
-	Pr *PullRequest `json:"pr,omitempty"`
+// Список подписок
+// (GET /webhook/list)
+func (_ Unimplemented) GetWebhookList(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-func (response PostPullRequestMerge200JSONResponse) VisitPostPullRequestMergeResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+// Изменить подписку
+// (POST /webhook/update)
+func (_ Unimplemented) PostWebhookUpdate(w http.ResponseWriter, r *http.Request, params PostWebhookUpdateParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	return json.NewEncoder(w).Encode(response)
+// ServerInterfaceWrapper converts contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler            ServerInterface
+	HandlerMiddlewares []MiddlewareFunc
+	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
 }
 
-type PostPullRequestMerge404JSONResponse ErrorResponse
+type MiddlewareFunc func(http.Handler) http.Handler
 
-func (response PostPullRequestMerge404JSONResponse) VisitPostPullRequestMergeResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+// GetAudit operation middleware
+func (siw *ServerInterfaceWrapper) GetAudit(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var err error
 
-type PostPullRequestReassignRequestObject struct {
-	Body *PostPullRequestReassignJSONRequestBody
-}
+	ctx = context.WithValue(ctx, AdminTokenScopes, []string{})
 
-type PostPullRequestReassignResponseObject interface {
-	VisitPostPullRequestReassignResponse(w http.ResponseWriter) error
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAuditParams
 
-type PostPullRequestReassign200JSONResponse struct {
-	Pr PullRequest `json:"pr"`
+	// ------------- Optional query parameter "entity_type" -------------
 
-	// ReplacedBy user_id нового ревьювера
-	ReplacedBy string `json:"replaced_by"`
-}
+	err = runtime.BindQueryParameter("form", true, false, "entity_type", r.URL.Query(), &params.EntityType)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "entity_type", Err: err})
+		return
+	}
 
-func (response PostPullRequestReassign200JSONResponse) VisitPostPullRequestReassignResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	// ------------- Optional query parameter "entity_id" -------------
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindQueryParameter("form", true, false, "entity_id", r.URL.Query(), &params.EntityId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "entity_id", Err: err})
+		return
+	}
 
-type PostPullRequestReassign404JSONResponse ErrorResponse
+	// ------------- Optional query parameter "from" -------------
 
-func (response PostPullRequestReassign404JSONResponse) VisitPostPullRequestReassignResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	err = runtime.BindQueryParameter("form", true, false, "from", r.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "from", Err: err})
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Optional query parameter "to" -------------
 
-type PostPullRequestReassign409JSONResponse ErrorResponse
+	err = runtime.BindQueryParameter("form", true, false, "to", r.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "to", Err: err})
+		return
+	}
 
-func (response PostPullRequestReassign409JSONResponse) VisitPostPullRequestReassignResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(409)
+	// ------------- Optional query parameter "limit" -------------
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
 
-type PostTeamAddRequestObject struct {
-	Body *PostTeamAddJSONRequestBody
-}
+	// ------------- Optional query parameter "cursor" -------------
 
-type PostTeamAddResponseObject interface {
-	VisitPostTeamAddResponse(w http.ResponseWriter) error
-}
+	err = runtime.BindQueryParameter("form", true, false, "cursor", r.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cursor", Err: err})
+		return
+	}
 
-type PostTeamAdd201JSONResponse struct {
-	Team *Team `json:"team,omitempty"`
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetAudit(w, r, params)
+	}))
 
-func (response PostTeamAdd201JSONResponse) VisitPostTeamAddResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-type PostTeamAdd400JSONResponse ErrorResponse
+// GetHealthLive operation middleware
+func (siw *ServerInterfaceWrapper) GetHealthLive(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-func (response PostTeamAdd400JSONResponse) VisitPostTeamAddResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetHealthLive(w, r)
+	}))
 
-	return json.NewEncoder(w).Encode(response)
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type GetTeamGetRequestObject struct {
-	Params GetTeamGetParams
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-type GetTeamGetResponseObject interface {
-	VisitGetTeamGetResponse(w http.ResponseWriter) error
-}
+// GetHealthReady operation middleware
+func (siw *ServerInterfaceWrapper) GetHealthReady(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-type GetTeamGet200JSONResponse Team
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetHealthReady(w, r)
+	}))
 
-func (response GetTeamGet200JSONResponse) VisitGetTeamGetResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-type GetTeamGet404JSONResponse ErrorResponse
+// PostPullRequestClose operation middleware
+func (siw *ServerInterfaceWrapper) PostPullRequestClose(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-func (response GetTeamGet404JSONResponse) VisitGetTeamGetResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx = context.WithValue(ctx, AdminTokenScopes, []string{})
 
-type GetUserStatsRequestObject struct {
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostPullRequestCloseParams
 
-type GetUserStatsResponseObject interface {
-	VisitGetUserStatsResponse(w http.ResponseWriter) error
-}
+	headers := r.Header
 
-type GetUserStats200JSONResponse StatsResponse
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Idempotency-Key", Count: n})
+			return
+		}
 
-func (response GetUserStats200JSONResponse) VisitGetUserStatsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Idempotency-Key", Err: err})
+			return
+		}
 
-	return json.NewEncoder(w).Encode(response)
-}
+		params.IdempotencyKey = &IdempotencyKey
 
-type GetUsersGetReviewRequestObject struct {
-	Params GetUsersGetReviewParams
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostPullRequestClose(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-type GetUsersGetReviewResponseObject interface {
-	VisitGetUsersGetReviewResponse(w http.ResponseWriter) error
+// PostPullRequestCreate operation middleware
+func (siw *ServerInterfaceWrapper) PostPullRequestCreate(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	ctx = context.WithValue(ctx, AdminTokenScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostPullRequestCreateParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Idempotency-Key", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Idempotency-Key", Err: err})
+			return
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostPullRequestCreate(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-type GetUsersGetReview200JSONResponse struct {
-	PullRequests []PullRequestShort `json:"pull_requests"`
+// GetPullRequestGet operation middleware
+func (siw *ServerInterfaceWrapper) GetPullRequestGet(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	ctx = context.WithValue(ctx, AdminTokenScopes, []string{})
+
+	ctx = context.WithValue(ctx, UserTokenScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetPullRequestGetParams
+
+	// ------------- Required query parameter "pull_request_id" -------------
+
+	if paramValue := r.URL.Query().Get("pull_request_id"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "pull_request_id"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "pull_request_id", r.URL.Query(), &params.PullRequestId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pull_request_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetPullRequestGet(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetPullRequestHistory operation middleware
+func (siw *ServerInterfaceWrapper) GetPullRequestHistory(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	ctx = context.WithValue(ctx, AdminTokenScopes, []string{})
+
+	ctx = context.WithValue(ctx, UserTokenScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetPullRequestHistoryParams
+
+	// ------------- Required query parameter "pull_request_id" -------------
+
+	if paramValue := r.URL.Query().Get("pull_request_id"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "pull_request_id"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "pull_request_id", r.URL.Query(), &params.PullRequestId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pull_request_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetPullRequestHistory(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetPullRequestList operation middleware
+func (siw *ServerInterfaceWrapper) GetPullRequestList(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	ctx = context.WithValue(ctx, AdminTokenScopes, []string{})
+
+	ctx = context.WithValue(ctx, UserTokenScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetPullRequestListParams
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", r.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "author_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "author_id", r.URL.Query(), &params.AuthorId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "author_id", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "team_name" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "team_name", r.URL.Query(), &params.TeamName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "team_name", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "reviewer_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "reviewer_id", r.URL.Query(), &params.ReviewerId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "reviewer_id", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "need_more_reviewers" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "need_more_reviewers", r.URL.Query(), &params.NeedMoreReviewers)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "need_more_reviewers", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "created_from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "created_from", r.URL.Query(), &params.CreatedFrom)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "created_from", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "created_to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "created_to", r.URL.Query(), &params.CreatedTo)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "created_to", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", r.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cursor", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetPullRequestList(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PostPullRequestMerge operation middleware
+func (siw *ServerInterfaceWrapper) PostPullRequestMerge(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	ctx = context.WithValue(ctx, AdminTokenScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostPullRequestMergeParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Idempotency-Key", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Idempotency-Key", Err: err})
+			return
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostPullRequestMerge(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PostPullRequestReady operation middleware
+func (siw *ServerInterfaceWrapper) PostPullRequestReady(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	ctx = context.WithValue(ctx, AdminTokenScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostPullRequestReadyParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Idempotency-Key", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Idempotency-Key", Err: err})
+			return
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostPullRequestReady(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PostPullRequestReassign operation middleware
+func (siw *ServerInterfaceWrapper) PostPullRequestReassign(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	ctx = context.WithValue(ctx, AdminTokenScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostPullRequestReassignParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Idempotency-Key", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Idempotency-Key", Err: err})
+			return
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostPullRequestReassign(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PostPullRequestReopen operation middleware
+func (siw *ServerInterfaceWrapper) PostPullRequestReopen(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	ctx = context.WithValue(ctx, AdminTokenScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostPullRequestReopenParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Idempotency-Key", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Idempotency-Key", Err: err})
+			return
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostPullRequestReopen(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PostPullRequestReview operation middleware
+func (siw *ServerInterfaceWrapper) PostPullRequestReview(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	ctx = context.WithValue(ctx, AdminTokenScopes, []string{})
+
+	ctx = context.WithValue(ctx, UserTokenScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostPullRequestReviewParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Idempotency-Key", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Idempotency-Key", Err: err})
+			return
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostPullRequestReview(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PostTeamAdd operation middleware
+func (siw *ServerInterfaceWrapper) PostTeamAdd(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	ctx = context.WithValue(ctx, AdminTokenScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostTeamAddParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Idempotency-Key", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Idempotency-Key", Err: err})
+			return
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostTeamAdd(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PostTeamAddMember operation middleware
+func (siw *ServerInterfaceWrapper) PostTeamAddMember(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	ctx = context.WithValue(ctx, AdminTokenScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostTeamAddMemberParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Idempotency-Key", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Idempotency-Key", Err: err})
+			return
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostTeamAddMember(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PostTeamArchive operation middleware
+func (siw *ServerInterfaceWrapper) PostTeamArchive(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	ctx = context.WithValue(ctx, AdminTokenScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostTeamArchiveParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Idempotency-Key", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Idempotency-Key", Err: err})
+			return
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostTeamArchive(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetTeamCodeOwners operation middleware
+func (siw *ServerInterfaceWrapper) GetTeamCodeOwners(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	ctx = context.WithValue(ctx, AdminTokenScopes, []string{})
+
+	ctx = context.WithValue(ctx, UserTokenScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTeamCodeOwnersParams
+
+	// ------------- Required query parameter "team_name" -------------
+
+	if paramValue := r.URL.Query().Get("team_name"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "team_name"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "team_name", r.URL.Query(), &params.TeamName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "team_name", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetTeamCodeOwners(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PostTeamCodeOwners operation middleware
+func (siw *ServerInterfaceWrapper) PostTeamCodeOwners(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	ctx = context.WithValue(ctx, AdminTokenScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostTeamCodeOwnersParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Idempotency-Key", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Idempotency-Key", Err: err})
+			return
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostTeamCodeOwners(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PostTeamDelete operation middleware
+func (siw *ServerInterfaceWrapper) PostTeamDelete(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	ctx = context.WithValue(ctx, AdminTokenScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostTeamDeleteParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Idempotency-Key", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Idempotency-Key", Err: err})
+			return
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostTeamDelete(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetTeamGet operation middleware
+func (siw *ServerInterfaceWrapper) GetTeamGet(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	ctx = context.WithValue(ctx, AdminTokenScopes, []string{})
+
+	ctx = context.WithValue(ctx, UserTokenScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTeamGetParams
+
+	// ------------- Required query parameter "team_name" -------------
+
+	if paramValue := r.URL.Query().Get("team_name"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "team_name"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "team_name", r.URL.Query(), &params.TeamName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "team_name", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetTeamGet(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PostTeamMoveMember operation middleware
+func (siw *ServerInterfaceWrapper) PostTeamMoveMember(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	ctx = context.WithValue(ctx, AdminTokenScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostTeamMoveMemberParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Idempotency-Key", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Idempotency-Key", Err: err})
+			return
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostTeamMoveMember(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PostTeamRemoveMember operation middleware
+func (siw *ServerInterfaceWrapper) PostTeamRemoveMember(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	ctx = context.WithValue(ctx, AdminTokenScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostTeamRemoveMemberParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Idempotency-Key", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Idempotency-Key", Err: err})
+			return
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostTeamRemoveMember(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PostTeamSettings operation middleware
+func (siw *ServerInterfaceWrapper) PostTeamSettings(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	ctx = context.WithValue(ctx, AdminTokenScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostTeamSettingsParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Idempotency-Key", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Idempotency-Key", Err: err})
+			return
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostTeamSettings(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetUserStats operation middleware
+func (siw *ServerInterfaceWrapper) GetUserStats(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetUserStats(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetUsersAbsences operation middleware
+func (siw *ServerInterfaceWrapper) GetUsersAbsences(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	ctx = context.WithValue(ctx, AdminTokenScopes, []string{})
+
+	ctx = context.WithValue(ctx, UserTokenScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetUsersAbsencesParams
+
+	// ------------- Optional query parameter "user_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "user_id", r.URL.Query(), &params.UserId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "include_finished" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "include_finished", r.URL.Query(), &params.IncludeFinished)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "include_finished", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetUsersAbsences(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PostUsersAbsencesCancel operation middleware
+func (siw *ServerInterfaceWrapper) PostUsersAbsencesCancel(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	ctx = context.WithValue(ctx, AdminTokenScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostUsersAbsencesCancelParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Idempotency-Key", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Idempotency-Key", Err: err})
+			return
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostUsersAbsencesCancel(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PostUsersAbsencesCreate operation middleware
+func (siw *ServerInterfaceWrapper) PostUsersAbsencesCreate(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	ctx = context.WithValue(ctx, AdminTokenScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostUsersAbsencesCreateParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Idempotency-Key", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Idempotency-Key", Err: err})
+			return
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostUsersAbsencesCreate(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetUsersGetReview operation middleware
+func (siw *ServerInterfaceWrapper) GetUsersGetReview(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	ctx = context.WithValue(ctx, AdminTokenScopes, []string{})
+
+	ctx = context.WithValue(ctx, UserTokenScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetUsersGetReviewParams
+
+	// ------------- Required query parameter "user_id" -------------
+
+	if paramValue := r.URL.Query().Get("user_id"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "user_id"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "user_id", r.URL.Query(), &params.UserId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", r.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", r.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cursor", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetUsersGetReview(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PostUsersLinkIdentity operation middleware
+func (siw *ServerInterfaceWrapper) PostUsersLinkIdentity(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	ctx = context.WithValue(ctx, AdminTokenScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostUsersLinkIdentityParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Idempotency-Key", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Idempotency-Key", Err: err})
+			return
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostUsersLinkIdentity(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PostUsersSetIsActive operation middleware
+func (siw *ServerInterfaceWrapper) PostUsersSetIsActive(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	ctx = context.WithValue(ctx, AdminTokenScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostUsersSetIsActiveParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Idempotency-Key", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Idempotency-Key", Err: err})
+			return
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostUsersSetIsActive(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PostUsersSetMaxOpenReviews operation middleware
+func (siw *ServerInterfaceWrapper) PostUsersSetMaxOpenReviews(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	ctx = context.WithValue(ctx, AdminTokenScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostUsersSetMaxOpenReviewsParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Idempotency-Key", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Idempotency-Key", Err: err})
+			return
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostUsersSetMaxOpenReviews(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PostUsersUnlinkIdentity operation middleware
+func (siw *ServerInterfaceWrapper) PostUsersUnlinkIdentity(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	ctx = context.WithValue(ctx, AdminTokenScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostUsersUnlinkIdentityParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Idempotency-Key", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Idempotency-Key", Err: err})
+			return
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostUsersUnlinkIdentity(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetUsersWorkload operation middleware
+func (siw *ServerInterfaceWrapper) GetUsersWorkload(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	ctx = context.WithValue(ctx, AdminTokenScopes, []string{})
+
+	ctx = context.WithValue(ctx, UserTokenScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetUsersWorkloadParams
+
+	// ------------- Optional query parameter "team_name" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "team_name", r.URL.Query(), &params.TeamName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "team_name", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetUsersWorkload(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PostWebhookCreate operation middleware
+func (siw *ServerInterfaceWrapper) PostWebhookCreate(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	ctx = context.WithValue(ctx, AdminTokenScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostWebhookCreateParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Idempotency-Key", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Idempotency-Key", Err: err})
+			return
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostWebhookCreate(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PostWebhookDelete operation middleware
+func (siw *ServerInterfaceWrapper) PostWebhookDelete(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	ctx = context.WithValue(ctx, AdminTokenScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostWebhookDeleteParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Idempotency-Key", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Idempotency-Key", Err: err})
+			return
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostWebhookDelete(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetWebhookDeliveries operation middleware
+func (siw *ServerInterfaceWrapper) GetWebhookDeliveries(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	ctx = context.WithValue(ctx, AdminTokenScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetWebhookDeliveriesParams
+
+	// ------------- Required query parameter "webhook_id" -------------
+
+	if paramValue := r.URL.Query().Get("webhook_id"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "webhook_id"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "webhook_id", r.URL.Query(), &params.WebhookId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "webhook_id", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", r.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", r.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cursor", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetWebhookDeliveries(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetWebhookList operation middleware
+func (siw *ServerInterfaceWrapper) GetWebhookList(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, AdminTokenScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetWebhookList(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PostWebhookUpdate operation middleware
+func (siw *ServerInterfaceWrapper) PostWebhookUpdate(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	ctx = context.WithValue(ctx, AdminTokenScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostWebhookUpdateParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Idempotency-Key", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Idempotency-Key", Err: err})
+			return
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostWebhookUpdate(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+type UnescapedCookieParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnescapedCookieParamError) Error() string {
+	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
+}
+
+func (e *UnescapedCookieParamError) Unwrap() error {
+	return e.Err
+}
+
+type UnmarshalingParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnmarshalingParamError) Error() string {
+	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *UnmarshalingParamError) Unwrap() error {
+	return e.Err
+}
+
+type RequiredParamError struct {
+	ParamName string
+}
+
+func (e *RequiredParamError) Error() string {
+	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
+}
+
+type RequiredHeaderError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *RequiredHeaderError) Error() string {
+	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
+}
+
+func (e *RequiredHeaderError) Unwrap() error {
+	return e.Err
+}
+
+type InvalidParamFormatError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *InvalidParamFormatError) Error() string {
+	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *InvalidParamFormatError) Unwrap() error {
+	return e.Err
+}
+
+type TooManyValuesForParamError struct {
+	ParamName string
+	Count     int
+}
+
+func (e *TooManyValuesForParamError) Error() string {
+	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
+}
+
+// Handler creates http.Handler with routing matching OpenAPI spec.
+func Handler(si ServerInterface) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{})
+}
+
+type ChiServerOptions struct {
+	BaseURL          string
+	BaseRouter       chi.Router
+	Middlewares      []MiddlewareFunc
+	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
+func HandlerFromMux(si ServerInterface, r chi.Router) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{
+		BaseRouter: r,
+	})
+}
+
+func HandlerFromMuxWithBaseURL(si ServerInterface, r chi.Router, baseURL string) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{
+		BaseURL:    baseURL,
+		BaseRouter: r,
+	})
+}
+
+// HandlerWithOptions creates http.Handler with additional options
+func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handler {
+	r := options.BaseRouter
+
+	if r == nil {
+		r = chi.NewRouter()
+	}
+	if options.ErrorHandlerFunc == nil {
+		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+	}
+	wrapper := ServerInterfaceWrapper{
+		Handler:            si,
+		HandlerMiddlewares: options.Middlewares,
+		ErrorHandlerFunc:   options.ErrorHandlerFunc,
+	}
+
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/audit", wrapper.GetAudit)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/health/live", wrapper.GetHealthLive)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/health/ready", wrapper.GetHealthReady)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/pullRequest/close", wrapper.PostPullRequestClose)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/pullRequest/create", wrapper.PostPullRequestCreate)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/pullRequest/get", wrapper.GetPullRequestGet)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/pullRequest/history", wrapper.GetPullRequestHistory)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/pullRequest/list", wrapper.GetPullRequestList)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/pullRequest/merge", wrapper.PostPullRequestMerge)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/pullRequest/ready", wrapper.PostPullRequestReady)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/pullRequest/reassign", wrapper.PostPullRequestReassign)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/pullRequest/reopen", wrapper.PostPullRequestReopen)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/pullRequest/review", wrapper.PostPullRequestReview)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/team/add", wrapper.PostTeamAdd)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/team/addMember", wrapper.PostTeamAddMember)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/team/archive", wrapper.PostTeamArchive)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/team/codeOwners", wrapper.GetTeamCodeOwners)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/team/codeOwners", wrapper.PostTeamCodeOwners)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/team/delete", wrapper.PostTeamDelete)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/team/get", wrapper.GetTeamGet)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/team/moveMember", wrapper.PostTeamMoveMember)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/team/removeMember", wrapper.PostTeamRemoveMember)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/team/settings", wrapper.PostTeamSettings)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/user_stats", wrapper.GetUserStats)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/users/absences", wrapper.GetUsersAbsences)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/users/absences/cancel", wrapper.PostUsersAbsencesCancel)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/users/absences/create", wrapper.PostUsersAbsencesCreate)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/users/getReview", wrapper.GetUsersGetReview)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/users/linkIdentity", wrapper.PostUsersLinkIdentity)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/users/setIsActive", wrapper.PostUsersSetIsActive)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/users/setMaxOpenReviews", wrapper.PostUsersSetMaxOpenReviews)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/users/unlinkIdentity", wrapper.PostUsersUnlinkIdentity)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/users/workload", wrapper.GetUsersWorkload)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/webhook/create", wrapper.PostWebhookCreate)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/webhook/delete", wrapper.PostWebhookDelete)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/webhook/deliveries", wrapper.GetWebhookDeliveries)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/webhook/list", wrapper.GetWebhookList)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/webhook/update", wrapper.PostWebhookUpdate)
+	})
+
+	return r
+}
+
+type GetAuditRequestObject struct {
+	Params GetAuditParams
+}
+
+type GetAuditResponseObject interface {
+	VisitGetAuditResponse(w http.ResponseWriter) error
+}
+
+type GetAudit200JSONResponse struct {
+	Events []AuditEvent `json:"events"`
+
+	// NextCursor Курсор следующей страницы, отсутствует на последней
+	NextCursor *string `json:"next_cursor,omitempty"`
+}
+
+func (response GetAudit200JSONResponse) VisitGetAuditResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAudit400JSONResponse ErrorResponse
+
+func (response GetAudit400JSONResponse) VisitGetAuditResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAudit401JSONResponse ErrorResponse
+
+func (response GetAudit401JSONResponse) VisitGetAuditResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAudit403JSONResponse ErrorResponse
+
+func (response GetAudit403JSONResponse) VisitGetAuditResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetHealthLiveRequestObject struct {
+}
+
+type GetHealthLiveResponseObject interface {
+	VisitGetHealthLiveResponse(w http.ResponseWriter) error
+}
+
+type GetHealthLive200JSONResponse HealthResponse
+
+func (response GetHealthLive200JSONResponse) VisitGetHealthLiveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetHealthReadyRequestObject struct {
+}
+
+type GetHealthReadyResponseObject interface {
+	VisitGetHealthReadyResponse(w http.ResponseWriter) error
+}
+
+type GetHealthReady200JSONResponse HealthResponse
+
+func (response GetHealthReady200JSONResponse) VisitGetHealthReadyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetHealthReady503JSONResponse HealthResponse
+
+func (response GetHealthReady503JSONResponse) VisitGetHealthReadyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(503)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestCloseRequestObject struct {
+	Params PostPullRequestCloseParams
+	Body   *PostPullRequestCloseJSONRequestBody
+}
+
+type PostPullRequestCloseResponseObject interface {
+	VisitPostPullRequestCloseResponse(w http.ResponseWriter) error
+}
+
+type PostPullRequestClose200JSONResponse struct {
+	Pr PullRequest `json:"pr"`
+}
+
+func (response PostPullRequestClose200JSONResponse) VisitPostPullRequestCloseResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestClose401JSONResponse ErrorResponse
+
+func (response PostPullRequestClose401JSONResponse) VisitPostPullRequestCloseResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestClose403JSONResponse ErrorResponse
+
+func (response PostPullRequestClose403JSONResponse) VisitPostPullRequestCloseResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestClose404JSONResponse ErrorResponse
+
+func (response PostPullRequestClose404JSONResponse) VisitPostPullRequestCloseResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestClose409JSONResponse ErrorResponse
+
+func (response PostPullRequestClose409JSONResponse) VisitPostPullRequestCloseResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestCreateRequestObject struct {
+	Params PostPullRequestCreateParams
+	Body   *PostPullRequestCreateJSONRequestBody
+}
+
+type PostPullRequestCreateResponseObject interface {
+	VisitPostPullRequestCreateResponse(w http.ResponseWriter) error
+}
+
+type PostPullRequestCreate201JSONResponse struct {
+	Pr *PullRequest `json:"pr,omitempty"`
+}
+
+func (response PostPullRequestCreate201JSONResponse) VisitPostPullRequestCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestCreate401JSONResponse ErrorResponse
+
+func (response PostPullRequestCreate401JSONResponse) VisitPostPullRequestCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestCreate403JSONResponse ErrorResponse
+
+func (response PostPullRequestCreate403JSONResponse) VisitPostPullRequestCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestCreate404JSONResponse ErrorResponse
+
+func (response PostPullRequestCreate404JSONResponse) VisitPostPullRequestCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestCreate409JSONResponse ErrorResponse
+
+func (response PostPullRequestCreate409JSONResponse) VisitPostPullRequestCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPullRequestGetRequestObject struct {
+	Params GetPullRequestGetParams
+}
+
+type GetPullRequestGetResponseObject interface {
+	VisitGetPullRequestGetResponse(w http.ResponseWriter) error
+}
+
+type GetPullRequestGet200JSONResponse struct {
+	Pr PullRequest `json:"pr"`
+}
+
+func (response GetPullRequestGet200JSONResponse) VisitGetPullRequestGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPullRequestGet401JSONResponse ErrorResponse
+
+func (response GetPullRequestGet401JSONResponse) VisitGetPullRequestGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPullRequestGet403JSONResponse ErrorResponse
+
+func (response GetPullRequestGet403JSONResponse) VisitGetPullRequestGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPullRequestGet404JSONResponse ErrorResponse
+
+func (response GetPullRequestGet404JSONResponse) VisitGetPullRequestGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPullRequestHistoryRequestObject struct {
+	Params GetPullRequestHistoryParams
+}
+
+type GetPullRequestHistoryResponseObject interface {
+	VisitGetPullRequestHistoryResponse(w http.ResponseWriter) error
+}
+
+type GetPullRequestHistory200JSONResponse struct {
+	History       []ReviewerAssignment `json:"history"`
+	PullRequestId string               `json:"pull_request_id"`
+}
+
+func (response GetPullRequestHistory200JSONResponse) VisitGetPullRequestHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPullRequestHistory401JSONResponse ErrorResponse
+
+func (response GetPullRequestHistory401JSONResponse) VisitGetPullRequestHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPullRequestHistory403JSONResponse ErrorResponse
+
+func (response GetPullRequestHistory403JSONResponse) VisitGetPullRequestHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPullRequestHistory404JSONResponse ErrorResponse
+
+func (response GetPullRequestHistory404JSONResponse) VisitGetPullRequestHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPullRequestListRequestObject struct {
+	Params GetPullRequestListParams
+}
+
+type GetPullRequestListResponseObject interface {
+	VisitGetPullRequestListResponse(w http.ResponseWriter) error
+}
+
+type GetPullRequestList200JSONResponse struct {
+	// NextCursor Курсор следующей страницы, отсутствует на последней
+	NextCursor   *string       `json:"next_cursor,omitempty"`
+	PullRequests []PullRequest `json:"pull_requests"`
+}
+
+func (response GetPullRequestList200JSONResponse) VisitGetPullRequestListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPullRequestList400JSONResponse ErrorResponse
+
+func (response GetPullRequestList400JSONResponse) VisitGetPullRequestListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPullRequestList401JSONResponse ErrorResponse
+
+func (response GetPullRequestList401JSONResponse) VisitGetPullRequestListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPullRequestList403JSONResponse ErrorResponse
+
+func (response GetPullRequestList403JSONResponse) VisitGetPullRequestListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestMergeRequestObject struct {
+	Params PostPullRequestMergeParams
+	Body   *PostPullRequestMergeJSONRequestBody
+}
+
+type PostPullRequestMergeResponseObject interface {
+	VisitPostPullRequestMergeResponse(w http.ResponseWriter) error
+}
+
+type PostPullRequestMerge200JSONResponse struct {
+	Pr *PullRequest `json:"pr,omitempty"`
+}
+
+func (response PostPullRequestMerge200JSONResponse) VisitPostPullRequestMergeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestMerge401JSONResponse ErrorResponse
+
+func (response PostPullRequestMerge401JSONResponse) VisitPostPullRequestMergeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestMerge403JSONResponse ErrorResponse
+
+func (response PostPullRequestMerge403JSONResponse) VisitPostPullRequestMergeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestMerge404JSONResponse ErrorResponse
+
+func (response PostPullRequestMerge404JSONResponse) VisitPostPullRequestMergeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestMerge409JSONResponse ErrorResponse
+
+func (response PostPullRequestMerge409JSONResponse) VisitPostPullRequestMergeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestMerge500JSONResponse ErrorResponse
+
+func (response PostPullRequestMerge500JSONResponse) VisitPostPullRequestMergeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestReadyRequestObject struct {
+	Params PostPullRequestReadyParams
+	Body   *PostPullRequestReadyJSONRequestBody
+}
+
+type PostPullRequestReadyResponseObject interface {
+	VisitPostPullRequestReadyResponse(w http.ResponseWriter) error
+}
+
+type PostPullRequestReady200JSONResponse struct {
+	Pr PullRequest `json:"pr"`
+}
+
+func (response PostPullRequestReady200JSONResponse) VisitPostPullRequestReadyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestReady401JSONResponse ErrorResponse
+
+func (response PostPullRequestReady401JSONResponse) VisitPostPullRequestReadyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestReady403JSONResponse ErrorResponse
+
+func (response PostPullRequestReady403JSONResponse) VisitPostPullRequestReadyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestReady404JSONResponse ErrorResponse
+
+func (response PostPullRequestReady404JSONResponse) VisitPostPullRequestReadyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestReady409JSONResponse ErrorResponse
+
+func (response PostPullRequestReady409JSONResponse) VisitPostPullRequestReadyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestReassignRequestObject struct {
+	Params PostPullRequestReassignParams
+	Body   *PostPullRequestReassignJSONRequestBody
+}
+
+type PostPullRequestReassignResponseObject interface {
+	VisitPostPullRequestReassignResponse(w http.ResponseWriter) error
+}
+
+type PostPullRequestReassign200JSONResponse struct {
+	Pr PullRequest `json:"pr"`
+
+	// ReplacedBy user_id нового ревьювера
+	ReplacedBy string `json:"replaced_by"`
+}
+
+func (response PostPullRequestReassign200JSONResponse) VisitPostPullRequestReassignResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestReassign401JSONResponse ErrorResponse
+
+func (response PostPullRequestReassign401JSONResponse) VisitPostPullRequestReassignResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestReassign403JSONResponse ErrorResponse
+
+func (response PostPullRequestReassign403JSONResponse) VisitPostPullRequestReassignResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestReassign404JSONResponse ErrorResponse
+
+func (response PostPullRequestReassign404JSONResponse) VisitPostPullRequestReassignResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestReassign409JSONResponse ErrorResponse
+
+func (response PostPullRequestReassign409JSONResponse) VisitPostPullRequestReassignResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestReopenRequestObject struct {
+	Params PostPullRequestReopenParams
+	Body   *PostPullRequestReopenJSONRequestBody
+}
+
+type PostPullRequestReopenResponseObject interface {
+	VisitPostPullRequestReopenResponse(w http.ResponseWriter) error
+}
+
+type PostPullRequestReopen200JSONResponse struct {
+	Pr PullRequest `json:"pr"`
+}
+
+func (response PostPullRequestReopen200JSONResponse) VisitPostPullRequestReopenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestReopen401JSONResponse ErrorResponse
+
+func (response PostPullRequestReopen401JSONResponse) VisitPostPullRequestReopenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestReopen403JSONResponse ErrorResponse
+
+func (response PostPullRequestReopen403JSONResponse) VisitPostPullRequestReopenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestReopen404JSONResponse ErrorResponse
+
+func (response PostPullRequestReopen404JSONResponse) VisitPostPullRequestReopenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestReopen409JSONResponse ErrorResponse
+
+func (response PostPullRequestReopen409JSONResponse) VisitPostPullRequestReopenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestReviewRequestObject struct {
+	Params PostPullRequestReviewParams
+	Body   *PostPullRequestReviewJSONRequestBody
+}
+
+type PostPullRequestReviewResponseObject interface {
+	VisitPostPullRequestReviewResponse(w http.ResponseWriter) error
+}
+
+type PostPullRequestReview200JSONResponse struct {
+	Pr PullRequest `json:"pr"`
+}
+
+func (response PostPullRequestReview200JSONResponse) VisitPostPullRequestReviewResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestReview400JSONResponse ErrorResponse
+
+func (response PostPullRequestReview400JSONResponse) VisitPostPullRequestReviewResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestReview401JSONResponse ErrorResponse
+
+func (response PostPullRequestReview401JSONResponse) VisitPostPullRequestReviewResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestReview403JSONResponse ErrorResponse
+
+func (response PostPullRequestReview403JSONResponse) VisitPostPullRequestReviewResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestReview404JSONResponse ErrorResponse
+
+func (response PostPullRequestReview404JSONResponse) VisitPostPullRequestReviewResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestReview409JSONResponse ErrorResponse
+
+func (response PostPullRequestReview409JSONResponse) VisitPostPullRequestReviewResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamAddRequestObject struct {
+	Params PostTeamAddParams
+	Body   *PostTeamAddJSONRequestBody
+}
+
+type PostTeamAddResponseObject interface {
+	VisitPostTeamAddResponse(w http.ResponseWriter) error
+}
+
+type PostTeamAdd201JSONResponse struct {
+	Team *Team `json:"team,omitempty"`
+}
+
+func (response PostTeamAdd201JSONResponse) VisitPostTeamAddResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamAdd400JSONResponse ErrorResponse
+
+func (response PostTeamAdd400JSONResponse) VisitPostTeamAddResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamAdd401JSONResponse ErrorResponse
+
+func (response PostTeamAdd401JSONResponse) VisitPostTeamAddResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamAdd403JSONResponse ErrorResponse
+
+func (response PostTeamAdd403JSONResponse) VisitPostTeamAddResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamAddMemberRequestObject struct {
+	Params PostTeamAddMemberParams
+	Body   *PostTeamAddMemberJSONRequestBody
+}
+
+type PostTeamAddMemberResponseObject interface {
+	VisitPostTeamAddMemberResponse(w http.ResponseWriter) error
+}
+
+type PostTeamAddMember200JSONResponse struct {
+	User User `json:"user"`
+}
+
+func (response PostTeamAddMember200JSONResponse) VisitPostTeamAddMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamAddMember400JSONResponse ErrorResponse
+
+func (response PostTeamAddMember400JSONResponse) VisitPostTeamAddMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamAddMember401JSONResponse ErrorResponse
+
+func (response PostTeamAddMember401JSONResponse) VisitPostTeamAddMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamAddMember403JSONResponse ErrorResponse
+
+func (response PostTeamAddMember403JSONResponse) VisitPostTeamAddMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamAddMember404JSONResponse ErrorResponse
+
+func (response PostTeamAddMember404JSONResponse) VisitPostTeamAddMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamAddMember409JSONResponse ErrorResponse
+
+func (response PostTeamAddMember409JSONResponse) VisitPostTeamAddMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamArchiveRequestObject struct {
+	Params PostTeamArchiveParams
+	Body   *PostTeamArchiveJSONRequestBody
+}
+
+type PostTeamArchiveResponseObject interface {
+	VisitPostTeamArchiveResponse(w http.ResponseWriter) error
+}
+
+type PostTeamArchive200JSONResponse TeamRetirement
+
+func (response PostTeamArchive200JSONResponse) VisitPostTeamArchiveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamArchive400JSONResponse ErrorResponse
+
+func (response PostTeamArchive400JSONResponse) VisitPostTeamArchiveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamArchive401JSONResponse ErrorResponse
+
+func (response PostTeamArchive401JSONResponse) VisitPostTeamArchiveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamArchive403JSONResponse ErrorResponse
+
+func (response PostTeamArchive403JSONResponse) VisitPostTeamArchiveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamArchive404JSONResponse ErrorResponse
+
+func (response PostTeamArchive404JSONResponse) VisitPostTeamArchiveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTeamCodeOwnersRequestObject struct {
+	Params GetTeamCodeOwnersParams
+}
+
+type GetTeamCodeOwnersResponseObject interface {
+	VisitGetTeamCodeOwnersResponse(w http.ResponseWriter) error
+}
+
+type GetTeamCodeOwners200JSONResponse CodeOwners
+
+func (response GetTeamCodeOwners200JSONResponse) VisitGetTeamCodeOwnersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTeamCodeOwners401JSONResponse ErrorResponse
+
+func (response GetTeamCodeOwners401JSONResponse) VisitGetTeamCodeOwnersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTeamCodeOwners403JSONResponse ErrorResponse
+
+func (response GetTeamCodeOwners403JSONResponse) VisitGetTeamCodeOwnersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTeamCodeOwners404JSONResponse ErrorResponse
+
+func (response GetTeamCodeOwners404JSONResponse) VisitGetTeamCodeOwnersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamCodeOwnersRequestObject struct {
+	Params PostTeamCodeOwnersParams
+	Body   *PostTeamCodeOwnersJSONRequestBody
+}
+
+type PostTeamCodeOwnersResponseObject interface {
+	VisitPostTeamCodeOwnersResponse(w http.ResponseWriter) error
+}
+
+type PostTeamCodeOwners200JSONResponse CodeOwners
+
+func (response PostTeamCodeOwners200JSONResponse) VisitPostTeamCodeOwnersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamCodeOwners400JSONResponse ErrorResponse
+
+func (response PostTeamCodeOwners400JSONResponse) VisitPostTeamCodeOwnersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamCodeOwners401JSONResponse ErrorResponse
+
+func (response PostTeamCodeOwners401JSONResponse) VisitPostTeamCodeOwnersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamCodeOwners403JSONResponse ErrorResponse
+
+func (response PostTeamCodeOwners403JSONResponse) VisitPostTeamCodeOwnersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamCodeOwners404JSONResponse ErrorResponse
+
+func (response PostTeamCodeOwners404JSONResponse) VisitPostTeamCodeOwnersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamDeleteRequestObject struct {
+	Params PostTeamDeleteParams
+	Body   *PostTeamDeleteJSONRequestBody
+}
+
+type PostTeamDeleteResponseObject interface {
+	VisitPostTeamDeleteResponse(w http.ResponseWriter) error
+}
+
+type PostTeamDelete200JSONResponse TeamRetirement
+
+func (response PostTeamDelete200JSONResponse) VisitPostTeamDeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamDelete400JSONResponse ErrorResponse
+
+func (response PostTeamDelete400JSONResponse) VisitPostTeamDeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamDelete401JSONResponse ErrorResponse
+
+func (response PostTeamDelete401JSONResponse) VisitPostTeamDeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamDelete403JSONResponse ErrorResponse
+
+func (response PostTeamDelete403JSONResponse) VisitPostTeamDeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamDelete404JSONResponse ErrorResponse
+
+func (response PostTeamDelete404JSONResponse) VisitPostTeamDeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTeamGetRequestObject struct {
+	Params GetTeamGetParams
+}
+
+type GetTeamGetResponseObject interface {
+	VisitGetTeamGetResponse(w http.ResponseWriter) error
+}
+
+type GetTeamGet200JSONResponse Team
+
+func (response GetTeamGet200JSONResponse) VisitGetTeamGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTeamGet401JSONResponse ErrorResponse
+
+func (response GetTeamGet401JSONResponse) VisitGetTeamGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTeamGet403JSONResponse ErrorResponse
+
+func (response GetTeamGet403JSONResponse) VisitGetTeamGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTeamGet404JSONResponse ErrorResponse
+
+func (response GetTeamGet404JSONResponse) VisitGetTeamGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamMoveMemberRequestObject struct {
+	Params PostTeamMoveMemberParams
+	Body   *PostTeamMoveMemberJSONRequestBody
+}
+
+type PostTeamMoveMemberResponseObject interface {
+	VisitPostTeamMoveMemberResponse(w http.ResponseWriter) error
+}
+
+type PostTeamMoveMember200JSONResponse TeamMembershipChange
+
+func (response PostTeamMoveMember200JSONResponse) VisitPostTeamMoveMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamMoveMember400JSONResponse ErrorResponse
+
+func (response PostTeamMoveMember400JSONResponse) VisitPostTeamMoveMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamMoveMember401JSONResponse ErrorResponse
+
+func (response PostTeamMoveMember401JSONResponse) VisitPostTeamMoveMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamMoveMember403JSONResponse ErrorResponse
+
+func (response PostTeamMoveMember403JSONResponse) VisitPostTeamMoveMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamMoveMember404JSONResponse ErrorResponse
+
+func (response PostTeamMoveMember404JSONResponse) VisitPostTeamMoveMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamRemoveMemberRequestObject struct {
+	Params PostTeamRemoveMemberParams
+	Body   *PostTeamRemoveMemberJSONRequestBody
+}
+
+type PostTeamRemoveMemberResponseObject interface {
+	VisitPostTeamRemoveMemberResponse(w http.ResponseWriter) error
+}
+
+type PostTeamRemoveMember200JSONResponse TeamMembershipChange
+
+func (response PostTeamRemoveMember200JSONResponse) VisitPostTeamRemoveMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamRemoveMember401JSONResponse ErrorResponse
+
+func (response PostTeamRemoveMember401JSONResponse) VisitPostTeamRemoveMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamRemoveMember403JSONResponse ErrorResponse
+
+func (response PostTeamRemoveMember403JSONResponse) VisitPostTeamRemoveMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamRemoveMember404JSONResponse ErrorResponse
+
+func (response PostTeamRemoveMember404JSONResponse) VisitPostTeamRemoveMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamSettingsRequestObject struct {
+	Params PostTeamSettingsParams
+	Body   *PostTeamSettingsJSONRequestBody
+}
+
+type PostTeamSettingsResponseObject interface {
+	VisitPostTeamSettingsResponse(w http.ResponseWriter) error
+}
+
+type PostTeamSettings200JSONResponse struct {
+	Settings TeamSettings `json:"settings"`
+}
+
+func (response PostTeamSettings200JSONResponse) VisitPostTeamSettingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamSettings400JSONResponse ErrorResponse
+
+func (response PostTeamSettings400JSONResponse) VisitPostTeamSettingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamSettings401JSONResponse ErrorResponse
+
+func (response PostTeamSettings401JSONResponse) VisitPostTeamSettingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamSettings403JSONResponse ErrorResponse
+
+func (response PostTeamSettings403JSONResponse) VisitPostTeamSettingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamSettings404JSONResponse ErrorResponse
+
+func (response PostTeamSettings404JSONResponse) VisitPostTeamSettingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUserStatsRequestObject struct {
+}
+
+type GetUserStatsResponseObject interface {
+	VisitGetUserStatsResponse(w http.ResponseWriter) error
+}
+
+type GetUserStats200JSONResponse StatsResponse
+
+func (response GetUserStats200JSONResponse) VisitGetUserStatsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersAbsencesRequestObject struct {
+	Params GetUsersAbsencesParams
+}
+
+type GetUsersAbsencesResponseObject interface {
+	VisitGetUsersAbsencesResponse(w http.ResponseWriter) error
+}
+
+type GetUsersAbsences200JSONResponse struct {
+	Absences []Absence `json:"absences"`
+}
+
+func (response GetUsersAbsences200JSONResponse) VisitGetUsersAbsencesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersAbsences401JSONResponse ErrorResponse
+
+func (response GetUsersAbsences401JSONResponse) VisitGetUsersAbsencesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersAbsences403JSONResponse ErrorResponse
+
+func (response GetUsersAbsences403JSONResponse) VisitGetUsersAbsencesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersAbsencesCancelRequestObject struct {
+	Params PostUsersAbsencesCancelParams
+	Body   *PostUsersAbsencesCancelJSONRequestBody
+}
+
+type PostUsersAbsencesCancelResponseObject interface {
+	VisitPostUsersAbsencesCancelResponse(w http.ResponseWriter) error
+}
+
+type PostUsersAbsencesCancel200JSONResponse struct {
+	Absence Absence `json:"absence"`
+}
+
+func (response PostUsersAbsencesCancel200JSONResponse) VisitPostUsersAbsencesCancelResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersAbsencesCancel400JSONResponse ErrorResponse
+
+func (response PostUsersAbsencesCancel400JSONResponse) VisitPostUsersAbsencesCancelResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersAbsencesCancel401JSONResponse ErrorResponse
+
+func (response PostUsersAbsencesCancel401JSONResponse) VisitPostUsersAbsencesCancelResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersAbsencesCancel403JSONResponse ErrorResponse
+
+func (response PostUsersAbsencesCancel403JSONResponse) VisitPostUsersAbsencesCancelResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersAbsencesCancel404JSONResponse ErrorResponse
+
+func (response PostUsersAbsencesCancel404JSONResponse) VisitPostUsersAbsencesCancelResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersAbsencesCreateRequestObject struct {
+	Params PostUsersAbsencesCreateParams
+	Body   *PostUsersAbsencesCreateJSONRequestBody
+}
+
+type PostUsersAbsencesCreateResponseObject interface {
+	VisitPostUsersAbsencesCreateResponse(w http.ResponseWriter) error
+}
+
+type PostUsersAbsencesCreate201JSONResponse struct {
+	Absence Absence `json:"absence"`
+}
+
+func (response PostUsersAbsencesCreate201JSONResponse) VisitPostUsersAbsencesCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersAbsencesCreate400JSONResponse ErrorResponse
+
+func (response PostUsersAbsencesCreate400JSONResponse) VisitPostUsersAbsencesCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersAbsencesCreate401JSONResponse ErrorResponse
+
+func (response PostUsersAbsencesCreate401JSONResponse) VisitPostUsersAbsencesCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersAbsencesCreate403JSONResponse ErrorResponse
+
+func (response PostUsersAbsencesCreate403JSONResponse) VisitPostUsersAbsencesCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersAbsencesCreate404JSONResponse ErrorResponse
+
+func (response PostUsersAbsencesCreate404JSONResponse) VisitPostUsersAbsencesCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersGetReviewRequestObject struct {
+	Params GetUsersGetReviewParams
+}
+
+type GetUsersGetReviewResponseObject interface {
+	VisitGetUsersGetReviewResponse(w http.ResponseWriter) error
+}
+
+type GetUsersGetReview200JSONResponse struct {
+	// NextCursor Курсор следующей страницы, отсутствует на последней
+	NextCursor   *string            `json:"next_cursor,omitempty"`
+	PullRequests []PullRequestShort `json:"pull_requests"`
 	UserId       string             `json:"user_id"`
 }
 
-func (response GetUsersGetReview200JSONResponse) VisitGetUsersGetReviewResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
-
-	return json.NewEncoder(w).Encode(response)
-}
+func (response GetUsersGetReview200JSONResponse) VisitGetUsersGetReviewResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersGetReview400JSONResponse ErrorResponse
+
+func (response GetUsersGetReview400JSONResponse) VisitGetUsersGetReviewResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersGetReview401JSONResponse ErrorResponse
+
+func (response GetUsersGetReview401JSONResponse) VisitGetUsersGetReviewResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersGetReview403JSONResponse ErrorResponse
+
+func (response GetUsersGetReview403JSONResponse) VisitGetUsersGetReviewResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersLinkIdentityRequestObject struct {
+	Params PostUsersLinkIdentityParams
+	Body   *PostUsersLinkIdentityJSONRequestBody
+}
+
+type PostUsersLinkIdentityResponseObject interface {
+	VisitPostUsersLinkIdentityResponse(w http.ResponseWriter) error
+}
+
+type PostUsersLinkIdentity200JSONResponse struct {
+	Identity ExternalIdentity `json:"identity"`
+}
+
+func (response PostUsersLinkIdentity200JSONResponse) VisitPostUsersLinkIdentityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersLinkIdentity400JSONResponse ErrorResponse
+
+func (response PostUsersLinkIdentity400JSONResponse) VisitPostUsersLinkIdentityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersLinkIdentity401JSONResponse ErrorResponse
+
+func (response PostUsersLinkIdentity401JSONResponse) VisitPostUsersLinkIdentityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersLinkIdentity403JSONResponse ErrorResponse
+
+func (response PostUsersLinkIdentity403JSONResponse) VisitPostUsersLinkIdentityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersLinkIdentity404JSONResponse ErrorResponse
+
+func (response PostUsersLinkIdentity404JSONResponse) VisitPostUsersLinkIdentityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersSetIsActiveRequestObject struct {
+	Params PostUsersSetIsActiveParams
+	Body   *PostUsersSetIsActiveJSONRequestBody
+}
+
+type PostUsersSetIsActiveResponseObject interface {
+	VisitPostUsersSetIsActiveResponse(w http.ResponseWriter) error
+}
+
+type PostUsersSetIsActive200JSONResponse struct {
+	User *User `json:"user,omitempty"`
+}
+
+func (response PostUsersSetIsActive200JSONResponse) VisitPostUsersSetIsActiveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersSetIsActive401JSONResponse ErrorResponse
+
+func (response PostUsersSetIsActive401JSONResponse) VisitPostUsersSetIsActiveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersSetIsActive403JSONResponse ErrorResponse
+
+func (response PostUsersSetIsActive403JSONResponse) VisitPostUsersSetIsActiveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersSetIsActive404JSONResponse ErrorResponse
+
+func (response PostUsersSetIsActive404JSONResponse) VisitPostUsersSetIsActiveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersSetMaxOpenReviewsRequestObject struct {
+	Params PostUsersSetMaxOpenReviewsParams
+	Body   *PostUsersSetMaxOpenReviewsJSONRequestBody
+}
+
+type PostUsersSetMaxOpenReviewsResponseObject interface {
+	VisitPostUsersSetMaxOpenReviewsResponse(w http.ResponseWriter) error
+}
+
+type PostUsersSetMaxOpenReviews200JSONResponse struct {
+	Workload UserWorkload `json:"workload"`
+}
+
+func (response PostUsersSetMaxOpenReviews200JSONResponse) VisitPostUsersSetMaxOpenReviewsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersSetMaxOpenReviews400JSONResponse ErrorResponse
+
+func (response PostUsersSetMaxOpenReviews400JSONResponse) VisitPostUsersSetMaxOpenReviewsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersSetMaxOpenReviews401JSONResponse ErrorResponse
+
+func (response PostUsersSetMaxOpenReviews401JSONResponse) VisitPostUsersSetMaxOpenReviewsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersSetMaxOpenReviews403JSONResponse ErrorResponse
+
+func (response PostUsersSetMaxOpenReviews403JSONResponse) VisitPostUsersSetMaxOpenReviewsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersSetMaxOpenReviews404JSONResponse ErrorResponse
+
+func (response PostUsersSetMaxOpenReviews404JSONResponse) VisitPostUsersSetMaxOpenReviewsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersUnlinkIdentityRequestObject struct {
+	Params PostUsersUnlinkIdentityParams
+	Body   *PostUsersUnlinkIdentityJSONRequestBody
+}
+
+type PostUsersUnlinkIdentityResponseObject interface {
+	VisitPostUsersUnlinkIdentityResponse(w http.ResponseWriter) error
+}
+
+type PostUsersUnlinkIdentity204Response struct {
+}
+
+func (response PostUsersUnlinkIdentity204Response) VisitPostUsersUnlinkIdentityResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type PostUsersUnlinkIdentity400JSONResponse ErrorResponse
+
+func (response PostUsersUnlinkIdentity400JSONResponse) VisitPostUsersUnlinkIdentityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersUnlinkIdentity401JSONResponse ErrorResponse
+
+func (response PostUsersUnlinkIdentity401JSONResponse) VisitPostUsersUnlinkIdentityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersUnlinkIdentity403JSONResponse ErrorResponse
+
+func (response PostUsersUnlinkIdentity403JSONResponse) VisitPostUsersUnlinkIdentityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersUnlinkIdentity404JSONResponse ErrorResponse
+
+func (response PostUsersUnlinkIdentity404JSONResponse) VisitPostUsersUnlinkIdentityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersWorkloadRequestObject struct {
+	Params GetUsersWorkloadParams
+}
+
+type GetUsersWorkloadResponseObject interface {
+	VisitGetUsersWorkloadResponse(w http.ResponseWriter) error
+}
+
+type GetUsersWorkload200JSONResponse struct {
+	Users []UserWorkload `json:"users"`
+}
+
+func (response GetUsersWorkload200JSONResponse) VisitGetUsersWorkloadResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersWorkload401JSONResponse ErrorResponse
+
+func (response GetUsersWorkload401JSONResponse) VisitGetUsersWorkloadResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersWorkload403JSONResponse ErrorResponse
+
+func (response GetUsersWorkload403JSONResponse) VisitGetUsersWorkloadResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostWebhookCreateRequestObject struct {
+	Params PostWebhookCreateParams
+	Body   *PostWebhookCreateJSONRequestBody
+}
+
+type PostWebhookCreateResponseObject interface {
+	VisitPostWebhookCreateResponse(w http.ResponseWriter) error
+}
+
+type PostWebhookCreate201JSONResponse struct {
+	Secret  string  `json:"secret"`
+	Webhook Webhook `json:"webhook"`
+}
+
+func (response PostWebhookCreate201JSONResponse) VisitPostWebhookCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostWebhookCreate400JSONResponse ErrorResponse
+
+func (response PostWebhookCreate400JSONResponse) VisitPostWebhookCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostWebhookCreate401JSONResponse ErrorResponse
+
+func (response PostWebhookCreate401JSONResponse) VisitPostWebhookCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostWebhookCreate403JSONResponse ErrorResponse
+
+func (response PostWebhookCreate403JSONResponse) VisitPostWebhookCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostWebhookDeleteRequestObject struct {
+	Params PostWebhookDeleteParams
+	Body   *PostWebhookDeleteJSONRequestBody
+}
+
+type PostWebhookDeleteResponseObject interface {
+	VisitPostWebhookDeleteResponse(w http.ResponseWriter) error
+}
+
+type PostWebhookDelete204Response struct {
+}
+
+func (response PostWebhookDelete204Response) VisitPostWebhookDeleteResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type PostWebhookDelete401JSONResponse ErrorResponse
+
+func (response PostWebhookDelete401JSONResponse) VisitPostWebhookDeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostWebhookDelete403JSONResponse ErrorResponse
+
+func (response PostWebhookDelete403JSONResponse) VisitPostWebhookDeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostWebhookDelete404JSONResponse ErrorResponse
+
+func (response PostWebhookDelete404JSONResponse) VisitPostWebhookDeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetWebhookDeliveriesRequestObject struct {
+	Params GetWebhookDeliveriesParams
+}
+
+type GetWebhookDeliveriesResponseObject interface {
+	VisitGetWebhookDeliveriesResponse(w http.ResponseWriter) error
+}
+
+type GetWebhookDeliveries200JSONResponse struct {
+	Deliveries []WebhookDelivery `json:"deliveries"`
+
+	// NextCursor Курсор следующей страницы, отсутствует на последней
+	NextCursor *string `json:"next_cursor,omitempty"`
+}
+
+func (response GetWebhookDeliveries200JSONResponse) VisitGetWebhookDeliveriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetWebhookDeliveries400JSONResponse ErrorResponse
+
+func (response GetWebhookDeliveries400JSONResponse) VisitGetWebhookDeliveriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetWebhookDeliveries401JSONResponse ErrorResponse
+
+func (response GetWebhookDeliveries401JSONResponse) VisitGetWebhookDeliveriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetWebhookDeliveries403JSONResponse ErrorResponse
+
+func (response GetWebhookDeliveries403JSONResponse) VisitGetWebhookDeliveriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetWebhookDeliveries404JSONResponse ErrorResponse
+
+func (response GetWebhookDeliveries404JSONResponse) VisitGetWebhookDeliveriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetWebhookListRequestObject struct {
+}
+
+type GetWebhookListResponseObject interface {
+	VisitGetWebhookListResponse(w http.ResponseWriter) error
+}
+
+type GetWebhookList200JSONResponse struct {
+	Webhooks []Webhook `json:"webhooks"`
+}
+
+func (response GetWebhookList200JSONResponse) VisitGetWebhookListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetWebhookList401JSONResponse ErrorResponse
+
+func (response GetWebhookList401JSONResponse) VisitGetWebhookListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetWebhookList403JSONResponse ErrorResponse
+
+func (response GetWebhookList403JSONResponse) VisitGetWebhookListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostWebhookUpdateRequestObject struct {
+	Params PostWebhookUpdateParams
+	Body   *PostWebhookUpdateJSONRequestBody
+}
+
+type PostWebhookUpdateResponseObject interface {
+	VisitPostWebhookUpdateResponse(w http.ResponseWriter) error
+}
+
+type PostWebhookUpdate200JSONResponse struct {
+	Webhook Webhook `json:"webhook"`
+}
+
+func (response PostWebhookUpdate200JSONResponse) VisitPostWebhookUpdateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostWebhookUpdate400JSONResponse ErrorResponse
+
+func (response PostWebhookUpdate400JSONResponse) VisitPostWebhookUpdateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostWebhookUpdate401JSONResponse ErrorResponse
+
+func (response PostWebhookUpdate401JSONResponse) VisitPostWebhookUpdateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostWebhookUpdate403JSONResponse ErrorResponse
+
+func (response PostWebhookUpdate403JSONResponse) VisitPostWebhookUpdateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostWebhookUpdate404JSONResponse ErrorResponse
+
+func (response PostWebhookUpdate404JSONResponse) VisitPostWebhookUpdateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+// StrictServerInterface represents all server handlers.
+type StrictServerInterface interface {
+	// Журнал изменений (новые первыми), только для администратора
+	// (GET /audit)
+	GetAudit(ctx context.Context, request GetAuditRequestObject) (GetAuditResponseObject, error)
+	// Процесс жив и отвечает на запросы
+	// (GET /health/live)
+	GetHealthLive(ctx context.Context, request GetHealthLiveRequestObject) (GetHealthLiveResponseObject, error)
+	// Реплика готова принимать трафик (БД, миграции, воркер событий)
+	// (GET /health/ready)
+	GetHealthReady(ctx context.Context, request GetHealthReadyRequestObject) (GetHealthReadyResponseObject, error)
+	// Закрыть PR без слияния
+	// (POST /pullRequest/close)
+	PostPullRequestClose(ctx context.Context, request PostPullRequestCloseRequestObject) (PostPullRequestCloseResponseObject, error)
+	// Создать PR и автоматически назначить ревьюверов из команды автора (сколько требует команда)
+	// (POST /pullRequest/create)
+	PostPullRequestCreate(ctx context.Context, request PostPullRequestCreateRequestObject) (PostPullRequestCreateResponseObject, error)
+	// Получить PR с ревьюверами
+	// (GET /pullRequest/get)
+	GetPullRequestGet(ctx context.Context, request GetPullRequestGetRequestObject) (GetPullRequestGetResponseObject, error)
+	// История назначений ревьюверов на PR, включая снятых
+	// (GET /pullRequest/history)
+	GetPullRequestHistory(ctx context.Context, request GetPullRequestHistoryRequestObject) (GetPullRequestHistoryResponseObject, error)
+	// Список PR с фильтрами и курсорной пагинацией (новые первыми)
+	// (GET /pullRequest/list)
+	GetPullRequestList(ctx context.Context, request GetPullRequestListRequestObject) (GetPullRequestListResponseObject, error)
+	// Пометить PR как MERGED (идемпотентная операция)
+	// (POST /pullRequest/merge)
+	PostPullRequestMerge(ctx context.Context, request PostPullRequestMergeRequestObject) (PostPullRequestMergeResponseObject, error)
+	// Перевести черновик в OPEN и назначить ревьюверов
+	// (POST /pullRequest/ready)
+	PostPullRequestReady(ctx context.Context, request PostPullRequestReadyRequestObject) (PostPullRequestReadyResponseObject, error)
+	// Переназначить конкретного ревьювера на другого из его команды
+	// (POST /pullRequest/reassign)
+	PostPullRequestReassign(ctx context.Context, request PostPullRequestReassignRequestObject) (PostPullRequestReassignResponseObject, error)
+	// Открыть закрытый PR заново
+	// (POST /pullRequest/reopen)
+	PostPullRequestReopen(ctx context.Context, request PostPullRequestReopenRequestObject) (PostPullRequestReopenResponseObject, error)
+	// Отправить ревью текущего ревьювера
+	// (POST /pullRequest/review)
+	PostPullRequestReview(ctx context.Context, request PostPullRequestReviewRequestObject) (PostPullRequestReviewResponseObject, error)
+	// Создать команду с участниками (новых создаёт, существующих переводит в команду)
+	// (POST /team/add)
+	PostTeamAdd(ctx context.Context, request PostTeamAddRequestObject) (PostTeamAddResponseObject, error)
+	// Добавить в команду нового пользователя или пользователя без команды
+	// (POST /team/addMember)
+	PostTeamAddMember(ctx context.Context, request PostTeamAddMemberRequestObject) (PostTeamAddMemberResponseObject, error)
+	// Архивировать команду — деактивировать участников и разобрать их открытые PR
+	// (POST /team/archive)
+	PostTeamArchive(ctx context.Context, request PostTeamArchiveRequestObject) (PostTeamArchiveResponseObject, error)
+	// Получить файл владельцев путей команды
+	// (GET /team/codeOwners)
+	GetTeamCodeOwners(ctx context.Context, request GetTeamCodeOwnersRequestObject) (GetTeamCodeOwnersResponseObject, error)
+	// Задать файл владельцев путей команды (CODEOWNERS)
+	// (POST /team/codeOwners)
+	PostTeamCodeOwners(ctx context.Context, request PostTeamCodeOwnersRequestObject) (PostTeamCodeOwnersResponseObject, error)
+	// Удалить команду — вывести из неё участников и разобрать их открытые PR
+	// (POST /team/delete)
+	PostTeamDelete(ctx context.Context, request PostTeamDeleteRequestObject) (PostTeamDeleteResponseObject, error)
+	// Получить команду с участниками
+	// (GET /team/get)
+	GetTeamGet(ctx context.Context, request GetTeamGetRequestObject) (GetTeamGetResponseObject, error)
+	// Перевести пользователя в другую команду с переназначением его открытых ревью в прежней
+	// (POST /team/moveMember)
+	PostTeamMoveMember(ctx context.Context, request PostTeamMoveMemberRequestObject) (PostTeamMoveMemberResponseObject, error)
+	// Вывести пользователя из команды с переназначением его открытых ревью
+	// (POST /team/removeMember)
+	PostTeamRemoveMember(ctx context.Context, request PostTeamRemoveMemberRequestObject) (PostTeamRemoveMemberResponseObject, error)
+	// Изменить настройки назначения ревьюверов команды
+	// (POST /team/settings)
+	PostTeamSettings(ctx context.Context, request PostTeamSettingsRequestObject) (PostTeamSettingsResponseObject, error)
+	// Получить статистику по назначениям пользователей
+	// (GET /user_stats)
+	GetUserStats(ctx context.Context, request GetUserStatsRequestObject) (GetUserStatsResponseObject, error)
+	// Запланированные и текущие отсутствия
+	// (GET /users/absences)
+	GetUsersAbsences(ctx context.Context, request GetUsersAbsencesRequestObject) (GetUsersAbsencesResponseObject, error)
+	// Отменить отсутствие или досрочно завершить текущее
+	// (POST /users/absences/cancel)
+	PostUsersAbsencesCancel(ctx context.Context, request PostUsersAbsencesCancelRequestObject) (PostUsersAbsencesCancelResponseObject, error)
+	// Запланировать отсутствие пользователя
+	// (POST /users/absences/create)
+	PostUsersAbsencesCreate(ctx context.Context, request PostUsersAbsencesCreateRequestObject) (PostUsersAbsencesCreateResponseObject, error)
+	// Получить PR'ы, где пользователь назначен ревьювером (последние назначения первыми)
+	// (GET /users/getReview)
+	GetUsersGetReview(ctx context.Context, request GetUsersGetReviewRequestObject) (GetUsersGetReviewResponseObject, error)
+	// Привязать логин GitHub/GitLab к пользователю
+	// (POST /users/linkIdentity)
+	PostUsersLinkIdentity(ctx context.Context, request PostUsersLinkIdentityRequestObject) (PostUsersLinkIdentityResponseObject, error)
+	// Установить флаг активности пользователя
+	// (POST /users/setIsActive)
+	PostUsersSetIsActive(ctx context.Context, request PostUsersSetIsActiveRequestObject) (PostUsersSetIsActiveResponseObject, error)
+	// Задать личный лимит одновременных открытых ревью
+	// (POST /users/setMaxOpenReviews)
+	PostUsersSetMaxOpenReviews(ctx context.Context, request PostUsersSetMaxOpenReviewsRequestObject) (PostUsersSetMaxOpenReviewsResponseObject, error)
+	// Отвязать логин GitHub/GitLab
+	// (POST /users/unlinkIdentity)
+	PostUsersUnlinkIdentity(ctx context.Context, request PostUsersUnlinkIdentityRequestObject) (PostUsersUnlinkIdentityResponseObject, error)
+	// Текущие открытые ревью пользователей против их лимита
+	// (GET /users/workload)
+	GetUsersWorkload(ctx context.Context, request GetUsersWorkloadRequestObject) (GetUsersWorkloadResponseObject, error)
+	// Подписаться на события
+	// (POST /webhook/create)
+	PostWebhookCreate(ctx context.Context, request PostWebhookCreateRequestObject) (PostWebhookCreateResponseObject, error)
+	// Удалить подписку вместе с журналом доставок
+	// (POST /webhook/delete)
+	PostWebhookDelete(ctx context.Context, request PostWebhookDeleteRequestObject) (PostWebhookDeleteResponseObject, error)
+	// Журнал доставок подписки (новые первыми)
+	// (GET /webhook/deliveries)
+	GetWebhookDeliveries(ctx context.Context, request GetWebhookDeliveriesRequestObject) (GetWebhookDeliveriesResponseObject, error)
+	// Список подписок
+	// (GET /webhook/list)
+	GetWebhookList(ctx context.Context, request GetWebhookListRequestObject) (GetWebhookListResponseObject, error)
+	// Изменить подписку
+	// (POST /webhook/update)
+	PostWebhookUpdate(ctx context.Context, request PostWebhookUpdateRequestObject) (PostWebhookUpdateResponseObject, error)
+}
+
+type StrictHandlerFunc = strictnethttp.StrictHttpHandlerFunc
+type StrictMiddlewareFunc = strictnethttp.StrictHttpMiddlewareFunc
+
+type StrictHTTPServerOptions struct {
+	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
+	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
+		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		},
+		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		},
+	}}
+}
+
+func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc, options StrictHTTPServerOptions) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
+}
+
+type strictHandler struct {
+	ssi         StrictServerInterface
+	middlewares []StrictMiddlewareFunc
+	options     StrictHTTPServerOptions
+}
+
+// GetAudit operation middleware
+func (sh *strictHandler) GetAudit(w http.ResponseWriter, r *http.Request, params GetAuditParams) {
+	var request GetAuditRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAudit(ctx, request.(GetAuditRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAudit")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetAuditResponseObject); ok {
+		if err := validResponse.VisitGetAuditResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetHealthLive operation middleware
+func (sh *strictHandler) GetHealthLive(w http.ResponseWriter, r *http.Request) {
+	var request GetHealthLiveRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetHealthLive(ctx, request.(GetHealthLiveRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetHealthLive")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetHealthLiveResponseObject); ok {
+		if err := validResponse.VisitGetHealthLiveResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetHealthReady operation middleware
+func (sh *strictHandler) GetHealthReady(w http.ResponseWriter, r *http.Request) {
+	var request GetHealthReadyRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetHealthReady(ctx, request.(GetHealthReadyRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetHealthReady")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetHealthReadyResponseObject); ok {
+		if err := validResponse.VisitGetHealthReadyResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostPullRequestClose operation middleware
+func (sh *strictHandler) PostPullRequestClose(w http.ResponseWriter, r *http.Request, params PostPullRequestCloseParams) {
+	var request PostPullRequestCloseRequestObject
+
+	request.Params = params
+
+	var body PostPullRequestCloseJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostPullRequestClose(ctx, request.(PostPullRequestCloseRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostPullRequestClose")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostPullRequestCloseResponseObject); ok {
+		if err := validResponse.VisitPostPullRequestCloseResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostPullRequestCreate operation middleware
+func (sh *strictHandler) PostPullRequestCreate(w http.ResponseWriter, r *http.Request, params PostPullRequestCreateParams) {
+	var request PostPullRequestCreateRequestObject
+
+	request.Params = params
+
+	var body PostPullRequestCreateJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostPullRequestCreate(ctx, request.(PostPullRequestCreateRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostPullRequestCreate")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostPullRequestCreateResponseObject); ok {
+		if err := validResponse.VisitPostPullRequestCreateResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetPullRequestGet operation middleware
+func (sh *strictHandler) GetPullRequestGet(w http.ResponseWriter, r *http.Request, params GetPullRequestGetParams) {
+	var request GetPullRequestGetRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetPullRequestGet(ctx, request.(GetPullRequestGetRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetPullRequestGet")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetPullRequestGetResponseObject); ok {
+		if err := validResponse.VisitGetPullRequestGetResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetPullRequestHistory operation middleware
+func (sh *strictHandler) GetPullRequestHistory(w http.ResponseWriter, r *http.Request, params GetPullRequestHistoryParams) {
+	var request GetPullRequestHistoryRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetPullRequestHistory(ctx, request.(GetPullRequestHistoryRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetPullRequestHistory")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetPullRequestHistoryResponseObject); ok {
+		if err := validResponse.VisitGetPullRequestHistoryResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetPullRequestList operation middleware
+func (sh *strictHandler) GetPullRequestList(w http.ResponseWriter, r *http.Request, params GetPullRequestListParams) {
+	var request GetPullRequestListRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetPullRequestList(ctx, request.(GetPullRequestListRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetPullRequestList")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetPullRequestListResponseObject); ok {
+		if err := validResponse.VisitGetPullRequestListResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostPullRequestMerge operation middleware
+func (sh *strictHandler) PostPullRequestMerge(w http.ResponseWriter, r *http.Request, params PostPullRequestMergeParams) {
+	var request PostPullRequestMergeRequestObject
+
+	request.Params = params
+
+	var body PostPullRequestMergeJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostPullRequestMerge(ctx, request.(PostPullRequestMergeRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostPullRequestMerge")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostPullRequestMergeResponseObject); ok {
+		if err := validResponse.VisitPostPullRequestMergeResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostPullRequestReady operation middleware
+func (sh *strictHandler) PostPullRequestReady(w http.ResponseWriter, r *http.Request, params PostPullRequestReadyParams) {
+	var request PostPullRequestReadyRequestObject
+
+	request.Params = params
+
+	var body PostPullRequestReadyJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostPullRequestReady(ctx, request.(PostPullRequestReadyRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostPullRequestReady")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostPullRequestReadyResponseObject); ok {
+		if err := validResponse.VisitPostPullRequestReadyResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostPullRequestReassign operation middleware
+func (sh *strictHandler) PostPullRequestReassign(w http.ResponseWriter, r *http.Request, params PostPullRequestReassignParams) {
+	var request PostPullRequestReassignRequestObject
+
+	request.Params = params
+
+	var body PostPullRequestReassignJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostPullRequestReassign(ctx, request.(PostPullRequestReassignRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostPullRequestReassign")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostPullRequestReassignResponseObject); ok {
+		if err := validResponse.VisitPostPullRequestReassignResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostPullRequestReopen operation middleware
+func (sh *strictHandler) PostPullRequestReopen(w http.ResponseWriter, r *http.Request, params PostPullRequestReopenParams) {
+	var request PostPullRequestReopenRequestObject
+
+	request.Params = params
+
+	var body PostPullRequestReopenJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostPullRequestReopen(ctx, request.(PostPullRequestReopenRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostPullRequestReopen")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostPullRequestReopenResponseObject); ok {
+		if err := validResponse.VisitPostPullRequestReopenResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostPullRequestReview operation middleware
+func (sh *strictHandler) PostPullRequestReview(w http.ResponseWriter, r *http.Request, params PostPullRequestReviewParams) {
+	var request PostPullRequestReviewRequestObject
+
+	request.Params = params
+
+	var body PostPullRequestReviewJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostPullRequestReview(ctx, request.(PostPullRequestReviewRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostPullRequestReview")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostPullRequestReviewResponseObject); ok {
+		if err := validResponse.VisitPostPullRequestReviewResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostTeamAdd operation middleware
+func (sh *strictHandler) PostTeamAdd(w http.ResponseWriter, r *http.Request, params PostTeamAddParams) {
+	var request PostTeamAddRequestObject
+
+	request.Params = params
+
+	var body PostTeamAddJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostTeamAdd(ctx, request.(PostTeamAddRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostTeamAdd")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostTeamAddResponseObject); ok {
+		if err := validResponse.VisitPostTeamAddResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostTeamAddMember operation middleware
+func (sh *strictHandler) PostTeamAddMember(w http.ResponseWriter, r *http.Request, params PostTeamAddMemberParams) {
+	var request PostTeamAddMemberRequestObject
+
+	request.Params = params
+
+	var body PostTeamAddMemberJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostTeamAddMember(ctx, request.(PostTeamAddMemberRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostTeamAddMember")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostTeamAddMemberResponseObject); ok {
+		if err := validResponse.VisitPostTeamAddMemberResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostTeamArchive operation middleware
+func (sh *strictHandler) PostTeamArchive(w http.ResponseWriter, r *http.Request, params PostTeamArchiveParams) {
+	var request PostTeamArchiveRequestObject
+
+	request.Params = params
+
+	var body PostTeamArchiveJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostTeamArchive(ctx, request.(PostTeamArchiveRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostTeamArchive")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostTeamArchiveResponseObject); ok {
+		if err := validResponse.VisitPostTeamArchiveResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetTeamCodeOwners operation middleware
+func (sh *strictHandler) GetTeamCodeOwners(w http.ResponseWriter, r *http.Request, params GetTeamCodeOwnersParams) {
+	var request GetTeamCodeOwnersRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetTeamCodeOwners(ctx, request.(GetTeamCodeOwnersRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetTeamCodeOwners")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetTeamCodeOwnersResponseObject); ok {
+		if err := validResponse.VisitGetTeamCodeOwnersResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostTeamCodeOwners operation middleware
+func (sh *strictHandler) PostTeamCodeOwners(w http.ResponseWriter, r *http.Request, params PostTeamCodeOwnersParams) {
+	var request PostTeamCodeOwnersRequestObject
+
+	request.Params = params
+
+	var body PostTeamCodeOwnersJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostTeamCodeOwners(ctx, request.(PostTeamCodeOwnersRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostTeamCodeOwners")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostTeamCodeOwnersResponseObject); ok {
+		if err := validResponse.VisitPostTeamCodeOwnersResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostTeamDelete operation middleware
+func (sh *strictHandler) PostTeamDelete(w http.ResponseWriter, r *http.Request, params PostTeamDeleteParams) {
+	var request PostTeamDeleteRequestObject
+
+	request.Params = params
+
+	var body PostTeamDeleteJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostTeamDelete(ctx, request.(PostTeamDeleteRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostTeamDelete")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostTeamDeleteResponseObject); ok {
+		if err := validResponse.VisitPostTeamDeleteResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetTeamGet operation middleware
+func (sh *strictHandler) GetTeamGet(w http.ResponseWriter, r *http.Request, params GetTeamGetParams) {
+	var request GetTeamGetRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetTeamGet(ctx, request.(GetTeamGetRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetTeamGet")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetTeamGetResponseObject); ok {
+		if err := validResponse.VisitGetTeamGetResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostTeamMoveMember operation middleware
+func (sh *strictHandler) PostTeamMoveMember(w http.ResponseWriter, r *http.Request, params PostTeamMoveMemberParams) {
+	var request PostTeamMoveMemberRequestObject
+
+	request.Params = params
+
+	var body PostTeamMoveMemberJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostTeamMoveMember(ctx, request.(PostTeamMoveMemberRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostTeamMoveMember")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostTeamMoveMemberResponseObject); ok {
+		if err := validResponse.VisitPostTeamMoveMemberResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostTeamRemoveMember operation middleware
+func (sh *strictHandler) PostTeamRemoveMember(w http.ResponseWriter, r *http.Request, params PostTeamRemoveMemberParams) {
+	var request PostTeamRemoveMemberRequestObject
+
+	request.Params = params
+
+	var body PostTeamRemoveMemberJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostTeamRemoveMember(ctx, request.(PostTeamRemoveMemberRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostTeamRemoveMember")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostTeamRemoveMemberResponseObject); ok {
+		if err := validResponse.VisitPostTeamRemoveMemberResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostTeamSettings operation middleware
+func (sh *strictHandler) PostTeamSettings(w http.ResponseWriter, r *http.Request, params PostTeamSettingsParams) {
+	var request PostTeamSettingsRequestObject
+
+	request.Params = params
+
+	var body PostTeamSettingsJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostTeamSettings(ctx, request.(PostTeamSettingsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostTeamSettings")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostTeamSettingsResponseObject); ok {
+		if err := validResponse.VisitPostTeamSettingsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetUserStats operation middleware
+func (sh *strictHandler) GetUserStats(w http.ResponseWriter, r *http.Request) {
+	var request GetUserStatsRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetUserStats(ctx, request.(GetUserStatsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetUserStats")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetUserStatsResponseObject); ok {
+		if err := validResponse.VisitGetUserStatsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetUsersAbsences operation middleware
+func (sh *strictHandler) GetUsersAbsences(w http.ResponseWriter, r *http.Request, params GetUsersAbsencesParams) {
+	var request GetUsersAbsencesRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetUsersAbsences(ctx, request.(GetUsersAbsencesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetUsersAbsences")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetUsersAbsencesResponseObject); ok {
+		if err := validResponse.VisitGetUsersAbsencesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostUsersAbsencesCancel operation middleware
+func (sh *strictHandler) PostUsersAbsencesCancel(w http.ResponseWriter, r *http.Request, params PostUsersAbsencesCancelParams) {
+	var request PostUsersAbsencesCancelRequestObject
 
-type PostUsersSetIsActiveRequestObject struct {
-	Body *PostUsersSetIsActiveJSONRequestBody
-}
+	request.Params = params
 
-type PostUsersSetIsActiveResponseObject interface {
-	VisitPostUsersSetIsActiveResponse(w http.ResponseWriter) error
-}
+	var body PostUsersAbsencesCancelJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-type PostUsersSetIsActive200JSONResponse struct {
-	User *User `json:"user,omitempty"`
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostUsersAbsencesCancel(ctx, request.(PostUsersAbsencesCancelRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostUsersAbsencesCancel")
+	}
 
-func (response PostUsersSetIsActive200JSONResponse) VisitPostUsersSetIsActiveResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostUsersAbsencesCancelResponseObject); ok {
+		if err := validResponse.VisitPostUsersAbsencesCancelResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type PostUsersSetIsActive401JSONResponse ErrorResponse
+// PostUsersAbsencesCreate operation middleware
+func (sh *strictHandler) PostUsersAbsencesCreate(w http.ResponseWriter, r *http.Request, params PostUsersAbsencesCreateParams) {
+	var request PostUsersAbsencesCreateRequestObject
 
-func (response PostUsersSetIsActive401JSONResponse) VisitPostUsersSetIsActiveResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	request.Params = params
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var body PostUsersAbsencesCreateJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-type PostUsersSetIsActive404JSONResponse ErrorResponse
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostUsersAbsencesCreate(ctx, request.(PostUsersAbsencesCreateRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostUsersAbsencesCreate")
+	}
 
-func (response PostUsersSetIsActive404JSONResponse) VisitPostUsersSetIsActiveResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostUsersAbsencesCreateResponseObject); ok {
+		if err := validResponse.VisitPostUsersAbsencesCreateResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-// StrictServerInterface represents all server handlers.
-type StrictServerInterface interface {
-	// Создать PR и автоматически назначить до 2 ревьюверов из команды автора
-	// (POST /pullRequest/create)
-	PostPullRequestCreate(ctx context.Context, request PostPullRequestCreateRequestObject) (PostPullRequestCreateResponseObject, error)
-	// Пометить PR как MERGED (идемпотентная операция)
-	// (POST /pullRequest/merge)
-	PostPullRequestMerge(ctx context.Context, request PostPullRequestMergeRequestObject) (PostPullRequestMergeResponseObject, error)
-	// Переназначить конкретного ревьювера на другого из его команды
-	// (POST /pullRequest/reassign)
-	PostPullRequestReassign(ctx context.Context, request PostPullRequestReassignRequestObject) (PostPullRequestReassignResponseObject, error)
-	// Создать команду с участниками (создаёт/обновляет пользователей)
-	// (POST /team/add)
-	PostTeamAdd(ctx context.Context, request PostTeamAddRequestObject) (PostTeamAddResponseObject, error)
-	// Получить команду с участниками
-	// (GET /team/get)
-	GetTeamGet(ctx context.Context, request GetTeamGetRequestObject) (GetTeamGetResponseObject, error)
-	// Получить статистику по назначениям пользователей
-	// (GET /user_stats)
-	GetUserStats(ctx context.Context, request GetUserStatsRequestObject) (GetUserStatsResponseObject, error)
-	// Получить PR'ы, где пользователь назначен ревьювером
-	// (GET /users/getReview)
-	GetUsersGetReview(ctx context.Context, request GetUsersGetReviewRequestObject) (GetUsersGetReviewResponseObject, error)
-	// Установить флаг активности пользователя
-	// (POST /users/setIsActive)
-	PostUsersSetIsActive(ctx context.Context, request PostUsersSetIsActiveRequestObject) (PostUsersSetIsActiveResponseObject, error)
-}
+// GetUsersGetReview operation middleware
+func (sh *strictHandler) GetUsersGetReview(w http.ResponseWriter, r *http.Request, params GetUsersGetReviewParams) {
+	var request GetUsersGetReviewRequestObject
 
-type StrictHandlerFunc = strictnethttp.StrictHttpHandlerFunc
-type StrictMiddlewareFunc = strictnethttp.StrictHttpMiddlewareFunc
+	request.Params = params
 
-type StrictHTTPServerOptions struct {
-	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
-	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetUsersGetReview(ctx, request.(GetUsersGetReviewRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetUsersGetReview")
+	}
 
-func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
-		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusBadRequest)
-		},
-		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		},
-	}}
-}
+	response, err := handler(r.Context(), w, r, request)
 
-func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc, options StrictHTTPServerOptions) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetUsersGetReviewResponseObject); ok {
+		if err := validResponse.VisitGetUsersGetReviewResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type strictHandler struct {
-	ssi         StrictServerInterface
-	middlewares []StrictMiddlewareFunc
-	options     StrictHTTPServerOptions
-}
+// PostUsersLinkIdentity operation middleware
+func (sh *strictHandler) PostUsersLinkIdentity(w http.ResponseWriter, r *http.Request, params PostUsersLinkIdentityParams) {
+	var request PostUsersLinkIdentityRequestObject
 
-// PostPullRequestCreate operation middleware
-func (sh *strictHandler) PostPullRequestCreate(w http.ResponseWriter, r *http.Request) {
-	var request PostPullRequestCreateRequestObject
+	request.Params = params
 
-	var body PostPullRequestCreateJSONRequestBody
+	var body PostUsersLinkIdentityJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -877,18 +6118,18 @@ func (sh *strictHandler) PostPullRequestCreate(w http.ResponseWriter, r *http.Re
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.PostPullRequestCreate(ctx, request.(PostPullRequestCreateRequestObject))
+		return sh.ssi.PostUsersLinkIdentity(ctx, request.(PostUsersLinkIdentityRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostPullRequestCreate")
+		handler = middleware(handler, "PostUsersLinkIdentity")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(PostPullRequestCreateResponseObject); ok {
-		if err := validResponse.VisitPostPullRequestCreateResponse(w); err != nil {
+	} else if validResponse, ok := response.(PostUsersLinkIdentityResponseObject); ok {
+		if err := validResponse.VisitPostUsersLinkIdentityResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -896,11 +6137,13 @@ func (sh *strictHandler) PostPullRequestCreate(w http.ResponseWriter, r *http.Re
 	}
 }
 
-// PostPullRequestMerge operation middleware
-func (sh *strictHandler) PostPullRequestMerge(w http.ResponseWriter, r *http.Request) {
-	var request PostPullRequestMergeRequestObject
+// PostUsersSetIsActive operation middleware
+func (sh *strictHandler) PostUsersSetIsActive(w http.ResponseWriter, r *http.Request, params PostUsersSetIsActiveParams) {
+	var request PostUsersSetIsActiveRequestObject
 
-	var body PostPullRequestMergeJSONRequestBody
+	request.Params = params
+
+	var body PostUsersSetIsActiveJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -908,18 +6151,18 @@ func (sh *strictHandler) PostPullRequestMerge(w http.ResponseWriter, r *http.Req
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.PostPullRequestMerge(ctx, request.(PostPullRequestMergeRequestObject))
+		return sh.ssi.PostUsersSetIsActive(ctx, request.(PostUsersSetIsActiveRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostPullRequestMerge")
+		handler = middleware(handler, "PostUsersSetIsActive")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(PostPullRequestMergeResponseObject); ok {
-		if err := validResponse.VisitPostPullRequestMergeResponse(w); err != nil {
+	} else if validResponse, ok := response.(PostUsersSetIsActiveResponseObject); ok {
+		if err := validResponse.VisitPostUsersSetIsActiveResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -927,11 +6170,13 @@ func (sh *strictHandler) PostPullRequestMerge(w http.ResponseWriter, r *http.Req
 	}
 }
 
-// PostPullRequestReassign operation middleware
-func (sh *strictHandler) PostPullRequestReassign(w http.ResponseWriter, r *http.Request) {
-	var request PostPullRequestReassignRequestObject
+// PostUsersSetMaxOpenReviews operation middleware
+func (sh *strictHandler) PostUsersSetMaxOpenReviews(w http.ResponseWriter, r *http.Request, params PostUsersSetMaxOpenReviewsParams) {
+	var request PostUsersSetMaxOpenReviewsRequestObject
 
-	var body PostPullRequestReassignJSONRequestBody
+	request.Params = params
+
+	var body PostUsersSetMaxOpenReviewsJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -939,18 +6184,18 @@ func (sh *strictHandler) PostPullRequestReassign(w http.ResponseWriter, r *http.
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.PostPullRequestReassign(ctx, request.(PostPullRequestReassignRequestObject))
+		return sh.ssi.PostUsersSetMaxOpenReviews(ctx, request.(PostUsersSetMaxOpenReviewsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostPullRequestReassign")
+		handler = middleware(handler, "PostUsersSetMaxOpenReviews")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(PostPullRequestReassignResponseObject); ok {
-		if err := validResponse.VisitPostPullRequestReassignResponse(w); err != nil {
+	} else if validResponse, ok := response.(PostUsersSetMaxOpenReviewsResponseObject); ok {
+		if err := validResponse.VisitPostUsersSetMaxOpenReviewsResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -958,11 +6203,13 @@ func (sh *strictHandler) PostPullRequestReassign(w http.ResponseWriter, r *http.
 	}
 }
 
-// PostTeamAdd operation middleware
-func (sh *strictHandler) PostTeamAdd(w http.ResponseWriter, r *http.Request) {
-	var request PostTeamAddRequestObject
+// PostUsersUnlinkIdentity operation middleware
+func (sh *strictHandler) PostUsersUnlinkIdentity(w http.ResponseWriter, r *http.Request, params PostUsersUnlinkIdentityParams) {
+	var request PostUsersUnlinkIdentityRequestObject
 
-	var body PostTeamAddJSONRequestBody
+	request.Params = params
+
+	var body PostUsersUnlinkIdentityJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -970,18 +6217,18 @@ func (sh *strictHandler) PostTeamAdd(w http.ResponseWriter, r *http.Request) {
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.PostTeamAdd(ctx, request.(PostTeamAddRequestObject))
+		return sh.ssi.PostUsersUnlinkIdentity(ctx, request.(PostUsersUnlinkIdentityRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostTeamAdd")
+		handler = middleware(handler, "PostUsersUnlinkIdentity")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(PostTeamAddResponseObject); ok {
-		if err := validResponse.VisitPostTeamAddResponse(w); err != nil {
+	} else if validResponse, ok := response.(PostUsersUnlinkIdentityResponseObject); ok {
+		if err := validResponse.VisitPostUsersUnlinkIdentityResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -989,25 +6236,25 @@ func (sh *strictHandler) PostTeamAdd(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// GetTeamGet operation middleware
-func (sh *strictHandler) GetTeamGet(w http.ResponseWriter, r *http.Request, params GetTeamGetParams) {
-	var request GetTeamGetRequestObject
+// GetUsersWorkload operation middleware
+func (sh *strictHandler) GetUsersWorkload(w http.ResponseWriter, r *http.Request, params GetUsersWorkloadParams) {
+	var request GetUsersWorkloadRequestObject
 
 	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetTeamGet(ctx, request.(GetTeamGetRequestObject))
+		return sh.ssi.GetUsersWorkload(ctx, request.(GetUsersWorkloadRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetTeamGet")
+		handler = middleware(handler, "GetUsersWorkload")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetTeamGetResponseObject); ok {
-		if err := validResponse.VisitGetTeamGetResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetUsersWorkloadResponseObject); ok {
+		if err := validResponse.VisitGetUsersWorkloadResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1015,23 +6262,32 @@ func (sh *strictHandler) GetTeamGet(w http.ResponseWriter, r *http.Request, para
 	}
 }
 
-// GetUserStats operation middleware
-func (sh *strictHandler) GetUserStats(w http.ResponseWriter, r *http.Request) {
-	var request GetUserStatsRequestObject
+// PostWebhookCreate operation middleware
+func (sh *strictHandler) PostWebhookCreate(w http.ResponseWriter, r *http.Request, params PostWebhookCreateParams) {
+	var request PostWebhookCreateRequestObject
+
+	request.Params = params
+
+	var body PostWebhookCreateJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetUserStats(ctx, request.(GetUserStatsRequestObject))
+		return sh.ssi.PostWebhookCreate(ctx, request.(PostWebhookCreateRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetUserStats")
+		handler = middleware(handler, "PostWebhookCreate")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetUserStatsResponseObject); ok {
-		if err := validResponse.VisitGetUserStatsResponse(w); err != nil {
+	} else if validResponse, ok := response.(PostWebhookCreateResponseObject); ok {
+		if err := validResponse.VisitPostWebhookCreateResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1039,25 +6295,32 @@ func (sh *strictHandler) GetUserStats(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// GetUsersGetReview operation middleware
-func (sh *strictHandler) GetUsersGetReview(w http.ResponseWriter, r *http.Request, params GetUsersGetReviewParams) {
-	var request GetUsersGetReviewRequestObject
+// PostWebhookDelete operation middleware
+func (sh *strictHandler) PostWebhookDelete(w http.ResponseWriter, r *http.Request, params PostWebhookDeleteParams) {
+	var request PostWebhookDeleteRequestObject
 
 	request.Params = params
 
+	var body PostWebhookDeleteJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetUsersGetReview(ctx, request.(GetUsersGetReviewRequestObject))
+		return sh.ssi.PostWebhookDelete(ctx, request.(PostWebhookDeleteRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetUsersGetReview")
+		handler = middleware(handler, "PostWebhookDelete")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetUsersGetReviewResponseObject); ok {
-		if err := validResponse.VisitGetUsersGetReviewResponse(w); err != nil {
+	} else if validResponse, ok := response.(PostWebhookDeleteResponseObject); ok {
+		if err := validResponse.VisitPostWebhookDeleteResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1065,11 +6328,63 @@ func (sh *strictHandler) GetUsersGetReview(w http.ResponseWriter, r *http.Reques
 	}
 }
 
-// PostUsersSetIsActive operation middleware
-func (sh *strictHandler) PostUsersSetIsActive(w http.ResponseWriter, r *http.Request) {
-	var request PostUsersSetIsActiveRequestObject
+// GetWebhookDeliveries operation middleware
+func (sh *strictHandler) GetWebhookDeliveries(w http.ResponseWriter, r *http.Request, params GetWebhookDeliveriesParams) {
+	var request GetWebhookDeliveriesRequestObject
 
-	var body PostUsersSetIsActiveJSONRequestBody
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetWebhookDeliveries(ctx, request.(GetWebhookDeliveriesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetWebhookDeliveries")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetWebhookDeliveriesResponseObject); ok {
+		if err := validResponse.VisitGetWebhookDeliveriesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetWebhookList operation middleware
+func (sh *strictHandler) GetWebhookList(w http.ResponseWriter, r *http.Request) {
+	var request GetWebhookListRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetWebhookList(ctx, request.(GetWebhookListRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetWebhookList")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetWebhookListResponseObject); ok {
+		if err := validResponse.VisitGetWebhookListResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostWebhookUpdate operation middleware
+func (sh *strictHandler) PostWebhookUpdate(w http.ResponseWriter, r *http.Request, params PostWebhookUpdateParams) {
+	var request PostWebhookUpdateRequestObject
+
+	request.Params = params
+
+	var body PostWebhookUpdateJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -1077,18 +6392,18 @@ func (sh *strictHandler) PostUsersSetIsActive(w http.ResponseWriter, r *http.Req
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.PostUsersSetIsActive(ctx, request.(PostUsersSetIsActiveRequestObject))
+		return sh.ssi.PostWebhookUpdate(ctx, request.(PostWebhookUpdateRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostUsersSetIsActive")
+		handler = middleware(handler, "PostWebhookUpdate")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(PostUsersSetIsActiveResponseObject); ok {
-		if err := validResponse.VisitPostUsersSetIsActiveResponse(w); err != nil {
+	} else if validResponse, ok := response.(PostWebhookUpdateResponseObject); ok {
+		if err := validResponse.VisitPostWebhookUpdateResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
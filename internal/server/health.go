@@ -0,0 +1,45 @@
+package server
+
+import (
+	"context"
+	"pull_requests_service/internal/domain/entity"
+	"pull_requests_service/internal/server/generated"
+
+	"github.com/samber/lo"
+)
+
+// HealthService проверяет живость и готовность реплики.
+type HealthService interface {
+	Live(ctx context.Context) entity.HealthReport
+	Ready(ctx context.Context) entity.HealthReport
+}
+
+func (s *Server) GetHealthLive(ctx context.Context, _ generated.GetHealthLiveRequestObject) (
+	generated.GetHealthLiveResponseObject, error) {
+	return generated.GetHealthLive200JSONResponse(toAPIHealth(s.healthService.Live(ctx))), nil
+}
+
+func (s *Server) GetHealthReady(ctx context.Context, _ generated.GetHealthReadyRequestObject) (
+	generated.GetHealthReadyResponseObject, error) {
+	report := s.healthService.Ready(ctx)
+	if report.Status != entity.HealthUp {
+		return generated.GetHealthReady503JSONResponse(toAPIHealth(report)), nil
+	}
+	return generated.GetHealthReady200JSONResponse(toAPIHealth(report)), nil
+}
+
+func toAPIHealth(report entity.HealthReport) generated.HealthResponse {
+	checks := make([]generated.HealthCheck, 0, len(report.Checks))
+	for _, check := range report.Checks {
+		checks = append(checks, generated.HealthCheck{
+			Name:    check.Name,
+			Status:  generated.HealthStatus(check.Status),
+			Message: lo.EmptyableToPtr(check.Message),
+		})
+	}
+
+	return generated.HealthResponse{
+		Status: generated.HealthStatus(report.Status),
+		Checks: checks,
+	}
+}
@@ -0,0 +1,190 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"net/http"
+	"pull_requests_service/internal/domain"
+	"pull_requests_service/internal/domain/entity"
+	"pull_requests_service/internal/server/generated"
+	"pull_requests_service/pkg/contextx"
+	"pull_requests_service/pkg/errcodes"
+	"pull_requests_service/pkg/logx"
+)
+
+const (
+	HeaderIdempotencyKey     = "Idempotency-Key"
+	HeaderIdempotentReplayed = "Idempotent-Replayed"
+
+	maxIdempotencyKeyLength = 255
+)
+
+type IdempotencyService interface {
+	Begin(ctx context.Context, scope, key, fingerprint string) (*entity.IdempotencyRecord, error)
+	Complete(ctx context.Context, record entity.IdempotencyRecord) error
+	Release(ctx context.Context, scope, key string) error
+}
+
+// Idempotency повторяет сохранённый ответ на POST-запрос с тем же Idempotency-Key вместо нового выполнения.
+// Ключ действует в пределах вызывающего, метода и пути; отпечаток — query и тело запроса.
+// Ставится внутри Authorize: отказы авторизации не сохраняются. Тело длиннее maxBodySize байт отклоняется с 413.
+func Idempotency(svc IdempotencyService, maxBodySize int64) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			key := r.Header.Get(HeaderIdempotencyKey)
+			if r.Method != http.MethodPost || key == "" {
+				next.ServeHTTP(w, r)
+				return
+			}
+			if !validIdempotencyKey(key) {
+				writeError(w, http.StatusBadRequest, generated.INVALIDARGUMENT,
+					"Idempotency-Key must be up to 255 printable characters")
+				return
+			}
+
+			body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxBodySize))
+			if err != nil {
+				var tooLarge *http.MaxBytesError
+				if errors.As(err, &tooLarge) {
+					writeError(w, http.StatusRequestEntityTooLarge, generated.INVALIDARGUMENT, "request body is too large")
+					return
+				}
+				writeError(w, http.StatusBadRequest, generated.INVALIDARGUMENT, "failed to read request body")
+				return
+			}
+			r.Body = io.NopCloser(bytes.NewReader(body))
+
+			ctx := r.Context()
+			scope := idempotencyScope(r)
+
+			stored, err := svc.Begin(ctx, scope, key, requestFingerprint(r.URL.RawQuery, body))
+			if err != nil {
+				writeIdempotencyError(ctx, w, err)
+				return
+			}
+			if stored != nil {
+				replayResponse(w, *stored)
+				return
+			}
+
+			// ответ сохраняется и тогда, когда клиент уже отключился: его повтор должен получить результат
+			saveCtx := context.WithoutCancel(ctx)
+			recorder := &recordingResponseWriter{ResponseWriter: w, statusCode: http.StatusOK}
+			saved := false
+			defer func() {
+				if saved {
+					return
+				}
+				if releaseErr := svc.Release(saveCtx, scope, key); releaseErr != nil {
+					contextx.LoggerFromContextOrDefault(ctx).Error("failed to release idempotency key", logx.Error(releaseErr))
+				}
+			}()
+
+			next.ServeHTTP(recorder, r)
+
+			// на внутреннюю ошибку ответ не сохраняется, повтор выполнится заново
+			if recorder.statusCode >= http.StatusInternalServerError {
+				return
+			}
+
+			contentType := recorder.Header().Get("Content-Type")
+			record := entity.IdempotencyRecord{
+				Scope:        scope,
+				Key:          key,
+				StatusCode:   &recorder.statusCode,
+				ContentType:  &contentType,
+				ResponseBody: recorder.body.Bytes(),
+			}
+			if err = svc.Complete(saveCtx, record); err != nil {
+				contextx.LoggerFromContextOrDefault(ctx).Error("failed to save idempotent response", logx.Error(err))
+				return
+			}
+			saved = true
+		})
+	}
+}
+
+func writeIdempotencyError(ctx context.Context, w http.ResponseWriter, err error) {
+	var appErr *domain.AppError
+	if errors.As(err, &appErr) {
+		switch appErr.Code {
+		case errcodes.IdempotencyKeyReuse:
+			writeError(w, http.StatusUnprocessableEntity, generated.IDEMPOTENCYKEYREUSED, appErr.Message)
+			return
+		case errcodes.RequestInProgress:
+			writeError(w, http.StatusConflict, generated.REQUESTINPROGRESS, appErr.Message)
+			return
+		}
+	}
+
+	contextx.LoggerFromContextOrDefault(ctx).Error("failed to check idempotency key", logx.Error(err))
+	writeError(w, http.StatusInternalServerError, generated.INTERNALSERVERERROR, "internal server error")
+}
+
+func replayResponse(w http.ResponseWriter, record entity.IdempotencyRecord) {
+	if record.ContentType != nil && *record.ContentType != "" {
+		w.Header().Set("Content-Type", *record.ContentType)
+	}
+	w.Header().Set(HeaderIdempotentReplayed, "true")
+	w.WriteHeader(*record.StatusCode)
+	_, _ = w.Write(record.ResponseBody)
+}
+
+// idempotencyScope разделяет ключи разных вызывающих и операций.
+func idempotencyScope(r *http.Request) string {
+	caller := "anonymous"
+	if userID, err := contextx.UserIDFromContext(r.Context()); err == nil {
+		caller = userID.String()
+	}
+
+	return caller + " " + r.Method + " " + r.URL.Path
+}
+
+func requestFingerprint(query string, body []byte) string {
+	hash := sha256.New()
+	hash.Write([]byte(query))
+	hash.Write([]byte{0})
+	hash.Write(body)
+
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+func validIdempotencyKey(key string) bool {
+	if len(key) > maxIdempotencyKeyLength {
+		return false
+	}
+
+	for _, c := range key {
+		if c < ' ' || c > '~' {
+			return false
+		}
+	}
+
+	return true
+}
+
+// recordingResponseWriter пропускает ответ клиенту и запоминает его для повторов.
+type recordingResponseWriter struct {
+	http.ResponseWriter
+	statusCode  int
+	wroteHeader bool
+	body        bytes.Buffer
+}
+
+func (w *recordingResponseWriter) WriteHeader(statusCode int) {
+	if !w.wroteHeader {
+		w.statusCode = statusCode
+		w.wroteHeader = true
+	}
+	w.ResponseWriter.WriteHeader(statusCode)
+}
+
+func (w *recordingResponseWriter) Write(b []byte) (int, error) {
+	w.wroteHeader = true
+	w.body.Write(b)
+	return w.ResponseWriter.Write(b)
+}
@@ -0,0 +1,151 @@
+package server
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"pull_requests_service/internal/domain"
+	"pull_requests_service/internal/domain/entity"
+	"pull_requests_service/pkg/contextx"
+	"pull_requests_service/pkg/errcodes"
+)
+
+type memoryIdempotencyService struct {
+	records  map[string]entity.IdempotencyRecord
+	released int
+}
+
+func (s *memoryIdempotencyService) Begin(_ context.Context, scope, key, fingerprint string) (*entity.IdempotencyRecord, error) {
+	record, ok := s.records[scope+key]
+	if !ok {
+		s.records[scope+key] = entity.IdempotencyRecord{Scope: scope, Key: key, Fingerprint: fingerprint}
+		return nil, nil
+	}
+	if record.Fingerprint != fingerprint {
+		return nil, domain.NewError(errcodes.IdempotencyKeyReuse, "reused")
+	}
+	if !record.Completed() {
+		return nil, domain.NewError(errcodes.RequestInProgress, "in progress")
+	}
+	return &record, nil
+}
+
+func (s *memoryIdempotencyService) Complete(_ context.Context, record entity.IdempotencyRecord) error {
+	record.Fingerprint = s.records[record.Scope+record.Key].Fingerprint
+	s.records[record.Scope+record.Key] = record
+	return nil
+}
+
+func (s *memoryIdempotencyService) Release(_ context.Context, scope, key string) error {
+	delete(s.records, scope+key)
+	s.released++
+	return nil
+}
+
+func TestIdempotencyReplaysResponse(t *testing.T) {
+	rq := require.New(t)
+
+	svc := &memoryIdempotencyService{records: map[string]entity.IdempotencyRecord{}}
+	calls := 0
+	status := http.StatusCreated
+	handler := Idempotency(svc, 1<<10)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		body, _ := io.ReadAll(r.Body)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		_, _ = w.Write(body)
+	}))
+
+	send := func(key, body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/team/add", strings.NewReader(body))
+		req.Header.Set(HeaderIdempotencyKey, key)
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec
+	}
+
+	first := send("k1", `{"team_name":"backend"}`)
+	rq.Equal(http.StatusCreated, first.Code)
+	rq.Empty(first.Header().Get(HeaderIdempotentReplayed))
+
+	replayed := send("k1", `{"team_name":"backend"}`)
+	rq.Equal(1, calls)
+	rq.Equal(http.StatusCreated, replayed.Code)
+	rq.Equal("true", replayed.Header().Get(HeaderIdempotentReplayed))
+	rq.Equal("application/json", replayed.Header().Get("Content-Type"))
+	rq.Equal(first.Body.String(), replayed.Body.String())
+
+	reused := send("k1", `{"team_name":"frontend"}`)
+	rq.Equal(http.StatusUnprocessableEntity, reused.Code)
+	rq.Contains(reused.Body.String(), "IDEMPOTENCY_KEY_REUSED")
+	rq.Equal(1, calls)
+
+	// ответ 5xx не сохраняется: повтор с тем же ключом выполняется заново
+	status = http.StatusInternalServerError
+	rq.Equal(http.StatusInternalServerError, send("k2", `{}`).Code)
+	rq.Equal(1, svc.released)
+	status = http.StatusOK
+	rq.Equal(http.StatusOK, send("k2", `{}`).Code)
+	rq.Equal(3, calls)
+
+	rq.Equal(http.StatusBadRequest, send("bad\nkey", `{}`).Code)
+	rq.Equal(3, calls)
+
+	tooLarge := send("k3", `{"team_name":"`+strings.Repeat("x", 1<<10)+`"}`)
+	rq.Equal(http.StatusRequestEntityTooLarge, tooLarge.Code)
+	rq.Equal(3, calls)
+	rq.NotContains(svc.records, "anonymous POST /team/addk3")
+}
+
+func TestIdempotencyBulkImport(t *testing.T) {
+	rq := require.New(t)
+
+	svc := &memoryIdempotencyService{records: map[string]entity.IdempotencyRecord{}}
+	bulkService := &countingBulkService{}
+	bulk := NewBulkHandler(bulkService, 64)
+	handler := bulk.LimitBody(Idempotency(svc, 64)(http.HandlerFunc(bulk.Import)))
+
+	send := func(key, body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/bulk/import?kind=users&format=csv", strings.NewReader(body))
+		req = req.WithContext(contextx.WithUserID(req.Context(), "admin"))
+		req.Header.Set(HeaderIdempotencyKey, key)
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec
+	}
+
+	const file = "user_id,username\nu1,Alice\n"
+	first := send("import-1", file)
+	rq.Equal(http.StatusOK, first.Code)
+
+	replayed := send("import-1", file)
+	rq.Equal(http.StatusOK, replayed.Code)
+	rq.Equal("true", replayed.Header().Get(HeaderIdempotentReplayed))
+	rq.JSONEq(first.Body.String(), replayed.Body.String())
+	rq.Equal(1, bulkService.imports)
+
+	// тело больше лимита отклоняется до чтения целиком и до выполнения импорта
+	rq.Equal(http.StatusRequestEntityTooLarge, send("import-2", strings.Repeat(file, 10)).Code)
+	rq.Equal(1, bulkService.imports)
+	rq.NotContains(svc.records, "admin POST /bulk/importimport-2")
+}
+
+type countingBulkService struct {
+	BulkService
+	imports int
+}
+
+func (s *countingBulkService) Import(_ context.Context, kind, _ string, r io.Reader) (entity.ImportResult, error) {
+	s.imports++
+	body, err := io.ReadAll(r)
+	if err != nil {
+		return entity.ImportResult{}, err
+	}
+	return entity.ImportResult{Kind: kind, Total: strings.Count(string(body), "\n") - 1, Errors: []entity.ImportRowError{}}, nil
+}
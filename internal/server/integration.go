@@ -0,0 +1,269 @@
+package server
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"pull_requests_service/internal/domain"
+	"pull_requests_service/internal/domain/entity"
+	"pull_requests_service/internal/server/generated"
+	"pull_requests_service/pkg/contextx"
+	"pull_requests_service/pkg/errcodes"
+	"pull_requests_service/pkg/logx"
+	"pull_requests_service/pkg/webhook"
+)
+
+// IntegrationService применяет события PR, пришедшие из VCS.
+type IntegrationService interface {
+	LinkIdentity(ctx context.Context, identity entity.ExternalIdentity) (entity.ExternalIdentity, error)
+	UnlinkIdentity(ctx context.Context, provider, login string) error
+	HandlePullRequestEvent(ctx context.Context, event entity.VCSPullRequestEvent) error
+}
+
+const maxInboundPayloadSize = 10 << 20
+
+// IntegrationHandler принимает вебхуки GitHub и GitLab. Они не описаны в openapi.yaml:
+// подпись считается по сырому телу запроса, которое сгенерированный strict-обработчик не отдаёт.
+type IntegrationHandler struct {
+	service      IntegrationService
+	githubSecret string
+	gitlabToken  string
+}
+
+func NewIntegrationHandler(service IntegrationService, githubSecret, gitlabToken string) *IntegrationHandler {
+	return &IntegrationHandler{
+		service:      service,
+		githubSecret: githubSecret,
+		gitlabToken:  gitlabToken,
+	}
+}
+
+type githubPullRequestPayload struct {
+	Action      string `json:"action"`
+	PullRequest struct {
+		Number int    `json:"number"`
+		Title  string `json:"title"`
+		Merged bool   `json:"merged"`
+		User   struct {
+			Login string `json:"login"`
+		} `json:"user"`
+	} `json:"pull_request"`
+	Repository struct {
+		FullName string `json:"full_name"`
+	} `json:"repository"`
+}
+
+// GitHub обрабатывает событие pull_request, подписанное X-Hub-Signature-256.
+func (h *IntegrationHandler) GitHub(w http.ResponseWriter, r *http.Request) {
+	if h.githubSecret == "" {
+		writeError(w, http.StatusNotFound, generated.NOTFOUND, "github integration is not configured")
+		return
+	}
+
+	body, ok := readInboundBody(w, r)
+	if !ok {
+		return
+	}
+
+	if !webhook.Verify(h.githubSecret, body, r.Header.Get("X-Hub-Signature-256")) {
+		writeError(w, http.StatusUnauthorized, generated.UNAUTHORIZED, "invalid signature")
+		return
+	}
+
+	if r.Header.Get("X-GitHub-Event") != "pull_request" {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	var payload githubPullRequestPayload
+	if err := json.Unmarshal(body, &payload); err != nil {
+		writeError(w, http.StatusBadRequest, generated.INVALIDARGUMENT, "malformed pull_request payload")
+		return
+	}
+
+	event := entity.VCSPullRequestEvent{
+		Provider:    entity.ProviderGitHub,
+		DeliveryId:  r.Header.Get("X-GitHub-Delivery"),
+		Repository:  payload.Repository.FullName,
+		Number:      payload.PullRequest.Number,
+		Title:       payload.PullRequest.Title,
+		AuthorLogin: payload.PullRequest.User.Login,
+	}
+	switch {
+	case payload.Action == "opened":
+		event.Action = entity.VCSActionOpened
+	case payload.Action == "closed" && payload.PullRequest.Merged:
+		event.Action = entity.VCSActionMerged
+	}
+
+	h.handle(w, r, event)
+}
+
+type gitlabMergeRequestPayload struct {
+	ObjectKind string `json:"object_kind"`
+	User       struct {
+		Username string `json:"username"`
+	} `json:"user"`
+	Project struct {
+		PathWithNamespace string `json:"path_with_namespace"`
+	} `json:"project"`
+	ObjectAttributes struct {
+		Iid    int    `json:"iid"`
+		Title  string `json:"title"`
+		Action string `json:"action"`
+	} `json:"object_attributes"`
+}
+
+// GitLab обрабатывает Merge Request Hook, защищённый X-Gitlab-Token.
+func (h *IntegrationHandler) GitLab(w http.ResponseWriter, r *http.Request) {
+	if h.gitlabToken == "" {
+		writeError(w, http.StatusNotFound, generated.NOTFOUND, "gitlab integration is not configured")
+		return
+	}
+
+	token := r.Header.Get("X-Gitlab-Token")
+	if subtle.ConstantTimeCompare([]byte(token), []byte(h.gitlabToken)) != 1 {
+		writeError(w, http.StatusUnauthorized, generated.UNAUTHORIZED, "invalid token")
+		return
+	}
+
+	body, ok := readInboundBody(w, r)
+	if !ok {
+		return
+	}
+
+	var payload gitlabMergeRequestPayload
+	if err := json.Unmarshal(body, &payload); err != nil {
+		writeError(w, http.StatusBadRequest, generated.INVALIDARGUMENT, "malformed merge request payload")
+		return
+	}
+	if payload.ObjectKind != "merge_request" {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	// для action=open пользователь события и есть автор MR
+	event := entity.VCSPullRequestEvent{
+		Provider:    entity.ProviderGitLab,
+		DeliveryId:  r.Header.Get("X-Gitlab-Event-UUID"),
+		Repository:  payload.Project.PathWithNamespace,
+		Number:      payload.ObjectAttributes.Iid,
+		Title:       payload.ObjectAttributes.Title,
+		AuthorLogin: payload.User.Username,
+	}
+	switch payload.ObjectAttributes.Action {
+	case "open":
+		event.Action = entity.VCSActionOpened
+	case "merge":
+		event.Action = entity.VCSActionMerged
+	}
+
+	h.handle(w, r, event)
+}
+
+func (h *IntegrationHandler) handle(w http.ResponseWriter, r *http.Request, event entity.VCSPullRequestEvent) {
+	err := h.service.HandlePullRequestEvent(r.Context(), event)
+	if err == nil {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	var appErr *domain.AppError
+	if errors.As(err, &appErr) {
+		switch appErr.Code {
+		case errcodes.NotFound, errcodes.InvalidArgument, errcodes.InvalidTransition,
+			errcodes.NotApproved, errcodes.PrMerged:
+			// событие не применимо к текущему состоянию: повтор доставки без вмешательства не поможет
+			writeError(w, http.StatusUnprocessableEntity, generated.ErrorResponseErrorCode(appErr.Code), appErr.Message)
+			return
+		}
+	}
+
+	contextx.LoggerFromContextOrDefault(r.Context()).Error("failed to handle VCS webhook", logx.Error(err),
+		"provider", event.Provider, "delivery_id", event.DeliveryId)
+	writeError(w, http.StatusInternalServerError, generated.INTERNALSERVERERROR, "failed to handle webhook")
+}
+
+func readInboundBody(w http.ResponseWriter, r *http.Request) ([]byte, bool) {
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxInboundPayloadSize))
+	if err != nil {
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			writeError(w, http.StatusRequestEntityTooLarge, generated.INVALIDARGUMENT, "payload is too large")
+			return nil, false
+		}
+		writeError(w, http.StatusBadRequest, generated.INVALIDARGUMENT, "failed to read payload")
+		return nil, false
+	}
+
+	return body, true
+}
+
+func (s *Server) PostUsersLinkIdentity(ctx context.Context, request generated.PostUsersLinkIdentityRequestObject) (generated.PostUsersLinkIdentityResponseObject, error) {
+	identity, err := s.integrationService.LinkIdentity(ctx, entity.ExternalIdentity{
+		Provider: string(request.Body.Provider),
+		Login:    request.Body.Login,
+		UserId:   request.Body.UserId,
+	})
+	if err != nil {
+		var appErr *domain.AppError
+		if errors.As(err, &appErr) {
+			switch appErr.Code {
+			case errcodes.InvalidArgument:
+				return generated.PostUsersLinkIdentity400JSONResponse{
+					Error: struct {
+						Code    generated.ErrorResponseErrorCode `json:"code"`
+						Message string                           `json:"message"`
+					}{Code: generated.INVALIDARGUMENT, Message: appErr.Message},
+				}, nil
+			case errcodes.NotFound:
+				return generated.PostUsersLinkIdentity404JSONResponse{
+					Error: struct {
+						Code    generated.ErrorResponseErrorCode `json:"code"`
+						Message string                           `json:"message"`
+					}{Code: generated.NOTFOUND, Message: appErr.Message},
+				}, nil
+			}
+		}
+		return nil, err
+	}
+
+	return generated.PostUsersLinkIdentity200JSONResponse{
+		Identity: generated.ExternalIdentity{
+			Provider: generated.VcsProvider(identity.Provider),
+			Login:    identity.Login,
+			UserId:   identity.UserId,
+		},
+	}, nil
+}
+
+func (s *Server) PostUsersUnlinkIdentity(ctx context.Context, request generated.PostUsersUnlinkIdentityRequestObject) (generated.PostUsersUnlinkIdentityResponseObject, error) {
+	err := s.integrationService.UnlinkIdentity(ctx, string(request.Body.Provider), request.Body.Login)
+	if err != nil {
+		var appErr *domain.AppError
+		if errors.As(err, &appErr) {
+			switch appErr.Code {
+			case errcodes.InvalidArgument:
+				return generated.PostUsersUnlinkIdentity400JSONResponse{
+					Error: struct {
+						Code    generated.ErrorResponseErrorCode `json:"code"`
+						Message string                           `json:"message"`
+					}{Code: generated.INVALIDARGUMENT, Message: appErr.Message},
+				}, nil
+			case errcodes.NotFound:
+				return generated.PostUsersUnlinkIdentity404JSONResponse{
+					Error: struct {
+						Code    generated.ErrorResponseErrorCode `json:"code"`
+						Message string                           `json:"message"`
+					}{Code: generated.NOTFOUND, Message: appErr.Message},
+				}, nil
+			}
+		}
+		return nil, err
+	}
+
+	return generated.PostUsersUnlinkIdentity204Response{}, nil
+}
@@ -0,0 +1,141 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"pull_requests_service/internal/domain"
+	"pull_requests_service/internal/domain/entity"
+	"pull_requests_service/internal/server/generated"
+	"pull_requests_service/pkg/errcodes"
+	"pull_requests_service/pkg/webhook"
+)
+
+type fakeIntegrationService struct {
+	IntegrationService
+	events []entity.VCSPullRequestEvent
+	err    error
+}
+
+func (s *fakeIntegrationService) HandlePullRequestEvent(_ context.Context, event entity.VCSPullRequestEvent) error {
+	s.events = append(s.events, event)
+	return s.err
+}
+
+func TestIntegrationHandlerGitHub(t *testing.T) {
+	rq := require.New(t)
+
+	const secret = "gh-secret"
+	body := []byte(`{"action":"closed","pull_request":{"number":7,"title":"Add search","merged":true,
+		"user":{"login":"Octocat"}},"repository":{"full_name":"acme/api"}}`)
+
+	svc := &fakeIntegrationService{}
+	handler := NewIntegrationHandler(svc, secret, "")
+
+	send := func(signature string) int {
+		req := httptest.NewRequest(http.MethodPost, "/integrations/github", bytes.NewReader(body))
+		req.Header.Set("X-GitHub-Event", "pull_request")
+		req.Header.Set("X-GitHub-Delivery", "d-1")
+		req.Header.Set("X-Hub-Signature-256", signature)
+
+		rec := httptest.NewRecorder()
+		handler.GitHub(rec, req)
+		return rec.Code
+	}
+
+	rq.Equal(http.StatusUnauthorized, send("sha256=deadbeef"))
+	rq.Empty(svc.events)
+
+	rq.Equal(http.StatusNoContent, send(webhook.Sign(secret, body)))
+	rq.Equal([]entity.VCSPullRequestEvent{{
+		Provider:    entity.ProviderGitHub,
+		DeliveryId:  "d-1",
+		Action:      entity.VCSActionMerged,
+		Repository:  "acme/api",
+		Number:      7,
+		Title:       "Add search",
+		AuthorLogin: "Octocat",
+	}}, svc.events)
+	rq.Equal("github:acme/api#7", svc.events[0].PullRequestId())
+}
+
+func TestIntegrationHandlerGitLabToken(t *testing.T) {
+	rq := require.New(t)
+
+	svc := &fakeIntegrationService{}
+	handler := NewIntegrationHandler(svc, "", "gl-token")
+
+	body := []byte(`{"object_kind":"merge_request","user":{"username":"jdoe"},
+		"project":{"path_with_namespace":"acme/api"},"object_attributes":{"iid":3,"title":"Fix","action":"open"}}`)
+
+	req := httptest.NewRequest(http.MethodPost, "/integrations/gitlab", bytes.NewReader(body))
+	req.Header.Set("X-Gitlab-Token", "wrong")
+	rec := httptest.NewRecorder()
+	handler.GitLab(rec, req)
+	rq.Equal(http.StatusUnauthorized, rec.Code)
+
+	req = httptest.NewRequest(http.MethodPost, "/integrations/gitlab", bytes.NewReader(body))
+	req.Header.Set("X-Gitlab-Token", "gl-token")
+	rec = httptest.NewRecorder()
+	handler.GitLab(rec, req)
+	rq.Equal(http.StatusNoContent, rec.Code)
+	rq.Len(svc.events, 1)
+	rq.Equal(entity.VCSActionOpened, svc.events[0].Action)
+	rq.Equal("gitlab:acme/api#3", svc.events[0].PullRequestId())
+
+	// GitHub не настроен
+	rec = httptest.NewRecorder()
+	handler.GitHub(rec, httptest.NewRequest(http.MethodPost, "/integrations/github", bytes.NewReader(body)))
+	rq.Equal(http.StatusNotFound, rec.Code)
+}
+
+func TestIntegrationHandlerErrors(t *testing.T) {
+	body := []byte(`{"object_kind":"merge_request","user":{"username":"jdoe"},
+		"project":{"path_with_namespace":"acme/api"},"object_attributes":{"iid":3,"title":"Fix","action":"merge"}}`)
+
+	tests := []struct {
+		name   string
+		err    error
+		status int
+		code   generated.ErrorResponseErrorCode
+	}{
+		{"unknown pr", domain.NewError(errcodes.NotFound, "pull request not found"),
+			http.StatusUnprocessableEntity, generated.NOTFOUND},
+		{"invalid argument", domain.NewError(errcodes.InvalidArgument, "unknown provider"),
+			http.StatusUnprocessableEntity, generated.INVALIDARGUMENT},
+		{"invalid transition", domain.NewError(errcodes.InvalidTransition, "pull request is closed"),
+			http.StatusUnprocessableEntity, generated.INVALIDTRANSITION},
+		{"not approved", domain.NewError(errcodes.NotApproved, "not enough approvals"),
+			http.StatusUnprocessableEntity, generated.NOTAPPROVED},
+		{"already merged", domain.NewError(errcodes.PrMerged, "pull request is merged"),
+			http.StatusUnprocessableEntity, generated.PRMERGED},
+		{"internal app error", domain.NewError(errcodes.InternalServerError, "repository failure"),
+			http.StatusInternalServerError, generated.INTERNALSERVERERROR},
+		{"plain error", errors.New("connection refused"),
+			http.StatusInternalServerError, generated.INTERNALSERVERERROR},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rq := require.New(t)
+
+			handler := NewIntegrationHandler(&fakeIntegrationService{err: tt.err}, "", "gl-token")
+			req := httptest.NewRequest(http.MethodPost, "/integrations/gitlab", bytes.NewReader(body))
+			req.Header.Set("X-Gitlab-Token", "gl-token")
+			rec := httptest.NewRecorder()
+			handler.GitLab(rec, req)
+
+			rq.Equal(tt.status, rec.Code)
+			rq.Equal("application/json", rec.Header().Get("Content-Type"))
+			var response generated.ErrorResponse
+			rq.NoError(json.Unmarshal(rec.Body.Bytes(), &response))
+			rq.Equal(tt.code, response.Error.Code)
+		})
+	}
+}
@@ -0,0 +1,101 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"pull_requests_service/internal/domain"
+	"pull_requests_service/internal/server/generated"
+	"pull_requests_service/pkg/errcodes"
+
+	"github.com/samber/lo"
+)
+
+func (s *Server) PostPullRequestReady(ctx context.Context, request generated.PostPullRequestReadyRequestObject) (
+	generated.PostPullRequestReadyResponseObject, error) {
+
+	pr, err := s.prService.MarkReady(ctx, request.Body.PullRequestId, lo.FromPtr(request.Body.ChangedPaths))
+	if err != nil {
+		var appErr *domain.AppError
+		if errors.As(err, &appErr) {
+			switch appErr.Code {
+			case errcodes.NotFound:
+				return generated.PostPullRequestReady404JSONResponse{
+					Error: struct {
+						Code    generated.ErrorResponseErrorCode `json:"code"`
+						Message string                           `json:"message"`
+					}{Code: generated.NOTFOUND, Message: appErr.Message},
+				}, nil
+			case errcodes.InvalidTransition, errcodes.PrMerged:
+				return generated.PostPullRequestReady409JSONResponse{
+					Error: struct {
+						Code    generated.ErrorResponseErrorCode `json:"code"`
+						Message string                           `json:"message"`
+					}{Code: generated.ErrorResponseErrorCode(appErr.Code), Message: appErr.Message},
+				}, nil
+			}
+		}
+		return nil, err
+	}
+
+	return generated.PostPullRequestReady200JSONResponse{Pr: toAPIPullRequest(pr)}, nil
+}
+
+func (s *Server) PostPullRequestClose(ctx context.Context, request generated.PostPullRequestCloseRequestObject) (
+	generated.PostPullRequestCloseResponseObject, error) {
+
+	pr, err := s.prService.ClosePullRequest(ctx, request.Body.PullRequestId)
+	if err != nil {
+		var appErr *domain.AppError
+		if errors.As(err, &appErr) {
+			switch appErr.Code {
+			case errcodes.NotFound:
+				return generated.PostPullRequestClose404JSONResponse{
+					Error: struct {
+						Code    generated.ErrorResponseErrorCode `json:"code"`
+						Message string                           `json:"message"`
+					}{Code: generated.NOTFOUND, Message: appErr.Message},
+				}, nil
+			case errcodes.InvalidTransition, errcodes.PrMerged:
+				return generated.PostPullRequestClose409JSONResponse{
+					Error: struct {
+						Code    generated.ErrorResponseErrorCode `json:"code"`
+						Message string                           `json:"message"`
+					}{Code: generated.ErrorResponseErrorCode(appErr.Code), Message: appErr.Message},
+				}, nil
+			}
+		}
+		return nil, err
+	}
+
+	return generated.PostPullRequestClose200JSONResponse{Pr: toAPIPullRequest(pr)}, nil
+}
+
+func (s *Server) PostPullRequestReopen(ctx context.Context, request generated.PostPullRequestReopenRequestObject) (
+	generated.PostPullRequestReopenResponseObject, error) {
+
+	pr, err := s.prService.ReopenPullRequest(ctx, request.Body.PullRequestId)
+	if err != nil {
+		var appErr *domain.AppError
+		if errors.As(err, &appErr) {
+			switch appErr.Code {
+			case errcodes.NotFound:
+				return generated.PostPullRequestReopen404JSONResponse{
+					Error: struct {
+						Code    generated.ErrorResponseErrorCode `json:"code"`
+						Message string                           `json:"message"`
+					}{Code: generated.NOTFOUND, Message: appErr.Message},
+				}, nil
+			case errcodes.InvalidTransition, errcodes.PrMerged:
+				return generated.PostPullRequestReopen409JSONResponse{
+					Error: struct {
+						Code    generated.ErrorResponseErrorCode `json:"code"`
+						Message string                           `json:"message"`
+					}{Code: generated.ErrorResponseErrorCode(appErr.Code), Message: appErr.Message},
+				}, nil
+			}
+		}
+		return nil, err
+	}
+
+	return generated.PostPullRequestReopen200JSONResponse{Pr: toAPIPullRequest(pr)}, nil
+}
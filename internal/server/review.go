@@ -0,0 +1,85 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"pull_requests_service/internal/domain"
+	"pull_requests_service/internal/domain/entity"
+	"pull_requests_service/internal/server/generated"
+	"pull_requests_service/pkg/contextx"
+	"pull_requests_service/pkg/errcodes"
+
+	"github.com/samber/lo"
+)
+
+func (s *Server) PostPullRequestReview(ctx context.Context, request generated.PostPullRequestReviewRequestObject) (
+	generated.PostPullRequestReviewResponseObject, error) {
+
+	// пользователь отправляет ревью только от своего имени, за другого может только админ
+	if role, _ := contextx.RoleFromContext(ctx); role != contextx.RoleAdmin {
+		userID, err := contextx.UserIDFromContext(ctx)
+		if err != nil || userID.String() != request.Body.ReviewerId {
+			return generated.PostPullRequestReview403JSONResponse{
+				Error: struct {
+					Code    generated.ErrorResponseErrorCode `json:"code"`
+					Message string                           `json:"message"`
+				}{Code: generated.FORBIDDEN, Message: "reviewer_id must match the authenticated user"},
+			}, nil
+		}
+	}
+
+	pr, err := s.prService.SubmitReview(ctx, request.Body.PullRequestId, entity.Review{
+		ReviewerId: request.Body.ReviewerId,
+		State:      string(request.Body.State),
+		Comment:    lo.FromPtr(request.Body.Comment),
+	})
+	if err != nil {
+		var appErr *domain.AppError
+		if errors.As(err, &appErr) {
+			switch appErr.Code {
+			case errcodes.NotFound:
+				return generated.PostPullRequestReview404JSONResponse{
+					Error: struct {
+						Code    generated.ErrorResponseErrorCode `json:"code"`
+						Message string                           `json:"message"`
+					}{Code: generated.NOTFOUND, Message: appErr.Message},
+				}, nil
+			case errcodes.InvalidArgument:
+				return generated.PostPullRequestReview400JSONResponse{
+					Error: struct {
+						Code    generated.ErrorResponseErrorCode `json:"code"`
+						Message string                           `json:"message"`
+					}{Code: generated.INVALIDARGUMENT, Message: appErr.Message},
+				}, nil
+			case errcodes.PrMerged, errcodes.NotAssigned, errcodes.InvalidTransition:
+				return generated.PostPullRequestReview409JSONResponse{
+					Error: struct {
+						Code    generated.ErrorResponseErrorCode `json:"code"`
+						Message string                           `json:"message"`
+					}{Code: generated.ErrorResponseErrorCode(appErr.Code), Message: appErr.Message},
+				}, nil
+			}
+		}
+		return nil, err
+	}
+
+	return generated.PostPullRequestReview200JSONResponse{Pr: toAPIPullRequest(pr)}, nil
+}
+
+// toAPIReviews возвращает nil, если ревью PR не загружались.
+func toAPIReviews(reviews []entity.Review) *[]generated.PullRequestReview {
+	if reviews == nil {
+		return nil
+	}
+
+	apiReviews := make([]generated.PullRequestReview, len(reviews))
+	for i, review := range reviews {
+		apiReviews[i] = generated.PullRequestReview{
+			ReviewerId: review.ReviewerId,
+			State:      generated.ReviewState(review.State),
+			Comment:    review.Comment,
+			ReviewedAt: review.ReviewedAt,
+		}
+	}
+	return &apiReviews
+}
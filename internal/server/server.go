@@ -6,44 +6,77 @@ import (
 	"pull_requests_service/internal/domain"
 	"pull_requests_service/internal/domain/entity"
 	"pull_requests_service/internal/server/generated"
+	"pull_requests_service/pkg/contextx"
 	"pull_requests_service/pkg/errcodes"
+	"pull_requests_service/pkg/logx"
+
+	"github.com/samber/lo"
 )
 
 type PullRequestService interface {
 	CreatePullRequest(ctx context.Context, pr entity.PullRequest) (entity.PullRequest, error)
 	Merge(ctx context.Context, prId string) (entity.PullRequest, error)
 	Reassign(ctx context.Context, prId string, oldReviewerId string) (entity.PullRequest, string, error)
-	GetUserReviews(ctx context.Context, userId string) ([]entity.PullRequest, error)
+	GetUserReviews(ctx context.Context, filter entity.UserReviewsFilter, cursor string) ([]entity.ReviewAssignment, string, error)
+	GetHistory(ctx context.Context, prId string) ([]entity.ReviewerAssignment, error)
+	GetPullRequest(ctx context.Context, prId string) (entity.PullRequest, error)
+	ListPullRequests(ctx context.Context, filter entity.PullRequestFilter, cursor string) ([]entity.PullRequest, string, error)
+	SubmitReview(ctx context.Context, prId string, review entity.Review) (entity.PullRequest, error)
+	MarkReady(ctx context.Context, prId string, changedPaths []string) (entity.PullRequest, error)
+	ClosePullRequest(ctx context.Context, prId string) (entity.PullRequest, error)
+	ReopenPullRequest(ctx context.Context, prId string) (entity.PullRequest, error)
 }
 
 // TeamService определяет бизнес-логику для работы с командами и их участниками.
 type TeamService interface {
 	TeamCreate(ctx context.Context, team entity.Team, users []entity.User) (entity.Team, []entity.User, error)
 	TeamGet(ctx context.Context, name string) (entity.Team, []entity.User, error)
+	UpdateSettings(ctx context.Context, name string, settings entity.TeamSettings) (entity.Team, error)
+	AddMember(ctx context.Context, teamName string, user entity.User) (entity.User, error)
+	RemoveMember(ctx context.Context, teamName, userId string) (entity.MembershipChange, error)
+	MoveMember(ctx context.Context, userId, teamName string) (entity.MembershipChange, error)
+	ArchiveTeam(ctx context.Context, name string, policy entity.TeamRetirePolicy) (entity.TeamRetirement, error)
+	DeleteTeam(ctx context.Context, name string, policy entity.TeamRetirePolicy) (entity.TeamRetirement, error)
+	SetCodeOwners(ctx context.Context, owners entity.CodeOwners) (entity.CodeOwners, error)
+	GetCodeOwners(ctx context.Context, teamName string) (entity.CodeOwners, error)
 }
 
 // UserService определяет бизнес-логику для работы с пользователями.
 type UserService interface {
 	SetIsActive(ctx context.Context, userId string, isActive bool) (entity.User, error)
+	SetMaxOpenReviews(ctx context.Context, userId string, maxOpenReviews int) (entity.UserWorkload, error)
 }
 
 type StatsService interface {
 	GetUserAssignmentStats(ctx context.Context) ([]entity.UserAssignmentStat, error)
+	GetWorkload(ctx context.Context, teamName string) ([]entity.UserWorkload, error)
 }
 
 type Server struct {
-	prService    PullRequestService
-	teamService  TeamService
-	userService  UserService
-	statsService StatsService
+	prService          PullRequestService
+	teamService        TeamService
+	userService        UserService
+	statsService       StatsService
+	auditService       AuditService
+	webhookService     WebhookService
+	integrationService IntegrationService
+	absenceService     AbsenceService
+	healthService      HealthService
 }
 
-func NewServer(prSvc PullRequestService, teamSvc TeamService, userSvc UserService, statSvc StatsService) *Server {
+func NewServer(prSvc PullRequestService, teamSvc TeamService, userSvc UserService, statSvc StatsService,
+	auditSvc AuditService, webhookSvc WebhookService, integrationSvc IntegrationService, absenceSvc AbsenceService,
+	healthSvc HealthService) *Server {
 	return &Server{
-		prService:    prSvc,
-		teamService:  teamSvc,
-		userService:  userSvc,
-		statsService: statSvc,
+		prService:          prSvc,
+		teamService:        teamSvc,
+		userService:        userSvc,
+		statsService:       statSvc,
+		auditService:       auditSvc,
+		webhookService:     webhookSvc,
+		integrationService: integrationSvc,
+		absenceService:     absenceSvc,
+		healthService:      healthSvc,
 	}
 }
 
@@ -51,9 +84,13 @@ func (s *Server) PostPullRequestCreate(ctx context.Context,
 	request generated.PostPullRequestCreateRequestObject) (generated.PostPullRequestCreateResponseObject, error) {
 
 	prToCreate := entity.PullRequest{
-		Id:       request.Body.PullRequestId,
-		Name:     request.Body.PullRequestName,
-		AuthorId: request.Body.AuthorId,
+		Id:           request.Body.PullRequestId,
+		Name:         request.Body.PullRequestName,
+		AuthorId:     request.Body.AuthorId,
+		ChangedPaths: lo.FromPtr(request.Body.ChangedPaths),
+	}
+	if lo.FromPtr(request.Body.Draft) {
+		prToCreate.Status = entity.StatusDraft
 	}
 
 	createdPR, err := s.prService.CreatePullRequest(ctx, prToCreate)
@@ -86,12 +123,121 @@ func (s *Server) PostPullRequestCreate(ctx context.Context,
 			AuthorId:          createdPR.AuthorId,
 			AssignedReviewers: createdPR.AssignedReviewers,
 			Status:            generated.PullRequestStatus(createdPR.Status),
+			Reviews:           toAPIReviews(createdPR.Reviews),
 		},
 	}
 
 	return response, nil
 }
 
+func (s *Server) GetPullRequestGet(ctx context.Context, request generated.GetPullRequestGetRequestObject) (generated.GetPullRequestGetResponseObject, error) {
+	pr, err := s.prService.GetPullRequest(ctx, request.Params.PullRequestId)
+	if err != nil {
+		var appErr *domain.AppError
+		if errors.As(err, &appErr) && appErr.Code == errcodes.NotFound {
+			return generated.GetPullRequestGet404JSONResponse{
+				Error: struct {
+					Code    generated.ErrorResponseErrorCode `json:"code"`
+					Message string                           `json:"message"`
+				}{Code: generated.NOTFOUND, Message: appErr.Message},
+			}, nil
+		}
+		return nil, err
+	}
+
+	return generated.GetPullRequestGet200JSONResponse{Pr: toAPIPullRequest(pr)}, nil
+}
+
+func (s *Server) GetPullRequestHistory(ctx context.Context, request generated.GetPullRequestHistoryRequestObject) (generated.GetPullRequestHistoryResponseObject, error) {
+	prId := request.Params.PullRequestId
+
+	history, err := s.prService.GetHistory(ctx, prId)
+	if err != nil {
+		var appErr *domain.AppError
+		if errors.As(err, &appErr) && appErr.Code == errcodes.NotFound {
+			return generated.GetPullRequestHistory404JSONResponse{
+				Error: struct {
+					Code    generated.ErrorResponseErrorCode `json:"code"`
+					Message string                           `json:"message"`
+				}{Code: generated.NOTFOUND, Message: appErr.Message},
+			}, nil
+		}
+		return nil, err
+	}
+
+	response := generated.GetPullRequestHistory200JSONResponse{
+		PullRequestId: prId,
+		History:       make([]generated.ReviewerAssignment, len(history)),
+	}
+	for i, a := range history {
+		response.History[i] = generated.ReviewerAssignment{
+			ReviewerId:    a.ReviewerId,
+			AssignedAt:    a.AssignedAt,
+			ReplacedAt:    a.ReplacedAt,
+			ReplaceReason: (*generated.ReviewerAssignmentReplaceReason)(a.ReplaceReason),
+			SourceTeam:    a.SourceTeam,
+			IsCurrent:     a.IsCurrent,
+			ReviewState:   lo.ToPtr(generated.ReviewState(a.ReviewState)),
+		}
+	}
+	return response, nil
+}
+
+func (s *Server) GetPullRequestList(ctx context.Context, request generated.GetPullRequestListRequestObject) (generated.GetPullRequestListResponseObject, error) {
+	params := request.Params
+	filter := entity.PullRequestFilter{
+		Status:            string(lo.FromPtr(params.Status)),
+		AuthorId:          lo.FromPtr(params.AuthorId),
+		TeamName:          lo.FromPtr(params.TeamName),
+		ReviewerId:        lo.FromPtr(params.ReviewerId),
+		NeedMoreReviewers: params.NeedMoreReviewers,
+		CreatedFrom:       params.CreatedFrom,
+		CreatedTo:         params.CreatedTo,
+		Limit:             lo.FromPtr(params.Limit),
+	}
+
+	prs, nextCursor, err := s.prService.ListPullRequests(ctx, filter, lo.FromPtr(params.Cursor))
+	if err != nil {
+		var appErr *domain.AppError
+		if errors.As(err, &appErr) && appErr.Code == errcodes.InvalidArgument {
+			return generated.GetPullRequestList400JSONResponse{
+				Error: struct {
+					Code    generated.ErrorResponseErrorCode `json:"code"`
+					Message string                           `json:"message"`
+				}{Code: generated.INVALIDARGUMENT, Message: appErr.Message},
+			}, nil
+		}
+		return nil, err
+	}
+
+	response := generated.GetPullRequestList200JSONResponse{
+		PullRequests: make([]generated.PullRequest, 0, len(prs)),
+	}
+	for _, pr := range prs {
+		response.PullRequests = append(response.PullRequests, toAPIPullRequest(pr))
+	}
+	if nextCursor != "" {
+		response.NextCursor = &nextCursor
+	}
+
+	return response, nil
+}
+
+func toAPIPullRequest(pr entity.PullRequest) generated.PullRequest {
+	return generated.PullRequest{
+		PullRequestId:     pr.Id,
+		PullRequestName:   pr.Name,
+		AuthorId:          pr.AuthorId,
+		Status:            generated.PullRequestStatus(pr.Status),
+		AssignedReviewers: pr.AssignedReviewers,
+		NeedMoreReviewers: lo.ToPtr(pr.NeedMoreReviewers),
+		CreatedAt:         &pr.CreatedAt,
+		MergedAt:          pr.MergedAt,
+		ClosedAt:          pr.ClosedAt,
+		Reviews:           toAPIReviews(pr.Reviews),
+	}
+}
+
 func (s *Server) GetTeamGet(ctx context.Context, request generated.GetTeamGetRequestObject) (generated.GetTeamGetResponseObject, error) {
 	teamName := request.Params.TeamName
 	team, users, err := s.teamService.TeamGet(ctx, teamName)
@@ -112,15 +258,22 @@ func (s *Server) GetTeamGet(ctx context.Context, request generated.GetTeamGetReq
 	members := make([]generated.TeamMember, 0, len(users))
 	for _, u := range users {
 		members = append(members, generated.TeamMember{
-			UserId:   u.Id,
-			Username: u.Name,
-			IsActive: u.IsActive,
+			UserId:    u.Id,
+			Username:  u.Name,
+			IsActive:  u.IsActive,
+			Seniority: lo.ToPtr(u.Seniority),
 		})
 	}
 
 	response := generated.GetTeamGet200JSONResponse{
-		TeamName: team.Name,
-		Members:  members,
+		TeamName:          team.Name,
+		ReviewerStrategy:  lo.ToPtr(generated.ReviewerStrategy(team.ReviewerStrategy)),
+		RequiredReviewers: lo.ToPtr(team.RequiredReviewers),
+		Members:           members,
+		ArchivedAt:        team.ArchivedAt,
+		FallbackTeams:     lo.ToPtr(team.FallbackTeams),
+		MaxOpenReviews:    team.MaxOpenReviews,
+		RequireApprovals:  lo.ToPtr(team.RequireApprovals),
 	}
 
 	return response, nil
@@ -134,18 +287,33 @@ func (s *Server) PostPullRequestMerge(ctx context.Context, request generated.Pos
 		if errors.As(err, &appErr) {
 			switch appErr.Code {
 			case errcodes.NotFound:
-				response := generated.PostPullRequestMerge404JSONResponse{
+				return generated.PostPullRequestMerge404JSONResponse{
 					Error: struct {
 						Code    generated.ErrorResponseErrorCode `json:"code"`
 						Message string                           `json:"message"`
-					}{
-						Code:    generated.ErrorResponseErrorCode(appErr.Code),
-						Message: appErr.Error(),
-					}}
-				return response, nil
+					}{Code: generated.NOTFOUND, Message: appErr.Message},
+				}, nil
+			case errcodes.NotApproved, errcodes.InvalidTransition:
+				return generated.PostPullRequestMerge409JSONResponse{
+					Error: struct {
+						Code    generated.ErrorResponseErrorCode `json:"code"`
+						Message string                           `json:"message"`
+					}{Code: generated.ErrorResponseErrorCode(appErr.Code), Message: appErr.Message},
+				}, nil
 			}
 		}
+
+		// детали внутренней ошибки остаются в логе, клиенту отдаётся только код
+		contextx.LoggerFromContextOrDefault(ctx).Error("failed to merge pull request", logx.Error(err),
+			"pull_request_id", prId)
+		return generated.PostPullRequestMerge500JSONResponse{
+			Error: struct {
+				Code    generated.ErrorResponseErrorCode `json:"code"`
+				Message string                           `json:"message"`
+			}{Code: generated.INTERNALSERVERERROR, Message: "internal server error"},
+		}, nil
 	}
+
 	response := generated.PostPullRequestMerge200JSONResponse{
 		Pr: &generated.PullRequest{
 			AssignedReviewers: pr.AssignedReviewers,
@@ -155,6 +323,7 @@ func (s *Server) PostPullRequestMerge(ctx context.Context, request generated.Pos
 			PullRequestId:     pr.Id,
 			PullRequestName:   pr.Name,
 			Status:            generated.PullRequestStatus(pr.Status),
+			Reviews:           toAPIReviews(pr.Reviews),
 		},
 	}
 	return response, nil
@@ -210,6 +379,7 @@ func (s *Server) PostPullRequestReassign(ctx context.Context, request generated.
 			PullRequestId:     pr.Id,
 			PullRequestName:   pr.Name,
 			Status:            generated.PullRequestStatus(pr.Status),
+			Reviews:           toAPIReviews(pr.Reviews),
 		},
 		ReplacedBy: newId,
 	}
@@ -231,16 +401,22 @@ func (s *Server) PostTeamAdd(ctx context.Context, request generated.PostTeamAddR
 	}
 
 	domainTeam := entity.Team{
-		Name: request.Body.TeamName,
+		Name:              request.Body.TeamName,
+		ReviewerStrategy:  string(lo.FromPtr(request.Body.ReviewerStrategy)),
+		RequiredReviewers: lo.FromPtr(request.Body.RequiredReviewers),
+		FallbackTeams:     lo.FromPtr(request.Body.FallbackTeams),
+		MaxOpenReviews:    request.Body.MaxOpenReviews,
+		RequireApprovals:  lo.FromPtr(request.Body.RequireApprovals),
 	}
 
 	domainUsers := make([]entity.User, len(request.Body.Members))
 	for i, member := range request.Body.Members {
 		domainUsers[i] = entity.User{
-			Id:       member.UserId,
-			Name:     member.Username,
-			IsActive: member.IsActive,
-			Team:     request.Body.TeamName,
+			Id:        member.UserId,
+			Name:      member.Username,
+			IsActive:  member.IsActive,
+			Team:      request.Body.TeamName,
+			Seniority: lo.FromPtr(member.Seniority),
 		}
 	}
 
@@ -267,16 +443,70 @@ func (s *Server) PostTeamAdd(ctx context.Context, request generated.PostTeamAddR
 	apiMembers := make([]generated.TeamMember, len(createdUsers))
 	for i, u := range createdUsers {
 		apiMembers[i] = generated.TeamMember{
-			UserId:   u.Id,
-			Username: u.Name,
-			IsActive: u.IsActive,
+			UserId:    u.Id,
+			Username:  u.Name,
+			IsActive:  u.IsActive,
+			Seniority: lo.ToPtr(u.Seniority),
 		}
 	}
 
 	response := generated.PostTeamAdd201JSONResponse{
 		Team: &generated.Team{
-			TeamName: createdTeam.Name,
-			Members:  apiMembers,
+			TeamName:          createdTeam.Name,
+			ReviewerStrategy:  lo.ToPtr(generated.ReviewerStrategy(createdTeam.ReviewerStrategy)),
+			RequiredReviewers: lo.ToPtr(createdTeam.RequiredReviewers),
+			Members:           apiMembers,
+			FallbackTeams:     lo.ToPtr(createdTeam.FallbackTeams),
+			MaxOpenReviews:    createdTeam.MaxOpenReviews,
+			RequireApprovals:  lo.ToPtr(createdTeam.RequireApprovals),
+		},
+	}
+	return response, nil
+}
+
+func (s *Server) PostTeamSettings(ctx context.Context, request generated.PostTeamSettingsRequestObject) (generated.PostTeamSettingsResponseObject, error) {
+	settings := entity.TeamSettings{
+		RequiredReviewers: request.Body.RequiredReviewers,
+		FallbackTeams:     request.Body.FallbackTeams,
+		MaxOpenReviews:    request.Body.MaxOpenReviews,
+		RequireApprovals:  request.Body.RequireApprovals,
+	}
+	if request.Body.ReviewerStrategy != nil {
+		settings.ReviewerStrategy = lo.ToPtr(string(*request.Body.ReviewerStrategy))
+	}
+
+	team, err := s.teamService.UpdateSettings(ctx, request.Body.TeamName, settings)
+	if err != nil {
+		var appErr *domain.AppError
+		if errors.As(err, &appErr) {
+			switch appErr.Code {
+			case errcodes.NotFound:
+				return generated.PostTeamSettings404JSONResponse{
+					Error: struct {
+						Code    generated.ErrorResponseErrorCode `json:"code"`
+						Message string                           `json:"message"`
+					}{Code: generated.NOTFOUND, Message: appErr.Message},
+				}, nil
+			case errcodes.InvalidArgument:
+				return generated.PostTeamSettings400JSONResponse{
+					Error: struct {
+						Code    generated.ErrorResponseErrorCode `json:"code"`
+						Message string                           `json:"message"`
+					}{Code: generated.INVALIDARGUMENT, Message: appErr.Message},
+				}, nil
+			}
+		}
+		return nil, err
+	}
+
+	response := generated.PostTeamSettings200JSONResponse{
+		Settings: generated.TeamSettings{
+			TeamName:          team.Name,
+			ReviewerStrategy:  generated.ReviewerStrategy(team.ReviewerStrategy),
+			RequiredReviewers: team.RequiredReviewers,
+			FallbackTeams:     team.FallbackTeams,
+			MaxOpenReviews:    team.MaxOpenReviews,
+			RequireApprovals:  team.RequireApprovals,
 		},
 	}
 	return response, nil
@@ -315,22 +545,40 @@ func (s *Server) PostUsersSetIsActive(ctx context.Context, request generated.Pos
 
 func (s *Server) GetUsersGetReview(ctx context.Context, request generated.GetUsersGetReviewRequestObject) (generated.GetUsersGetReviewResponseObject, error) {
 	userId := request.Params.UserId
-	prs, err := s.prService.GetUserReviews(ctx, userId)
+	filter := entity.UserReviewsFilter{
+		UserId: userId,
+		Status: string(lo.FromPtr(request.Params.Status)),
+		Limit:  lo.FromPtr(request.Params.Limit),
+	}
+
+	reviews, nextCursor, err := s.prService.GetUserReviews(ctx, filter, lo.FromPtr(request.Params.Cursor))
 	if err != nil {
 		var appErr *domain.AppError
-		if errors.As(err, &appErr) {
-			return nil, err
+		if errors.As(err, &appErr) && appErr.Code == errcodes.InvalidArgument {
+			return generated.GetUsersGetReview400JSONResponse{
+				Error: struct {
+					Code    generated.ErrorResponseErrorCode `json:"code"`
+					Message string                           `json:"message"`
+				}{Code: generated.INVALIDARGUMENT, Message: appErr.Message},
+			}, nil
+		}
+		return nil, err
+	}
+
+	response := generated.GetUsersGetReview200JSONResponse{
+		UserId:       userId,
+		PullRequests: make([]generated.PullRequestShort, len(reviews)),
+	}
+	for i, review := range reviews {
+		response.PullRequests[i] = generated.PullRequestShort{
+			AuthorId:        review.AuthorId,
+			PullRequestId:   review.Id,
+			PullRequestName: review.Name,
+			Status:          generated.PullRequestShortStatus(review.Status),
 		}
 	}
-	var response generated.GetUsersGetReview200JSONResponse
-	response.UserId = userId
-	for _, pr := range prs {
-		response.PullRequests = append(response.PullRequests, generated.PullRequestShort{
-			AuthorId:        pr.AuthorId,
-			PullRequestId:   pr.Id,
-			PullRequestName: pr.Name,
-			Status:          generated.PullRequestShortStatus(pr.Status),
-		})
+	if nextCursor != "" {
+		response.NextCursor = &nextCursor
 	}
 	return response, nil
 }
@@ -0,0 +1,241 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+
+	"pull_requests_service/internal/domain"
+	"pull_requests_service/internal/domain/entity"
+	"pull_requests_service/internal/server/generated"
+	"pull_requests_service/pkg/contextx"
+	"pull_requests_service/pkg/errcodes"
+)
+
+type fakePullRequestService struct {
+	PullRequestService
+	mergeErr   error
+	reviewsErr error
+	cursor     string
+	reviews    []entity.Review
+	reviewErr  error
+}
+
+func (s *fakePullRequestService) Merge(_ context.Context, prId string) (entity.PullRequest, error) {
+	if s.mergeErr != nil {
+		return entity.PullRequest{}, s.mergeErr
+	}
+	return entity.PullRequest{Id: prId, Status: entity.StatusMerged}, nil
+}
+
+func (s *fakePullRequestService) SubmitReview(_ context.Context, prId string, review entity.Review) (
+	entity.PullRequest, error) {
+	if s.reviewErr != nil {
+		return entity.PullRequest{}, s.reviewErr
+	}
+	s.reviews = append(s.reviews, review)
+	return entity.PullRequest{Id: prId, Status: entity.StatusOpen}, nil
+}
+
+func (s *fakePullRequestService) GetPullRequest(_ context.Context, prId string) (entity.PullRequest, error) {
+	return entity.PullRequest{Id: prId, Status: entity.StatusOpen}, nil
+}
+
+func (s *fakePullRequestService) GetUserReviews(_ context.Context, filter entity.UserReviewsFilter, cursor string) (
+	[]entity.ReviewAssignment, string, error) {
+	if s.reviewsErr != nil {
+		return nil, "", s.reviewsErr
+	}
+	s.cursor = cursor
+	return []entity.ReviewAssignment{{PullRequest: entity.PullRequest{Id: "pr-1", Status: filter.Status}}}, "next-page", nil
+}
+
+func TestGetUsersGetReviewPage(t *testing.T) {
+	rq := require.New(t)
+
+	prService := &fakePullRequestService{}
+	srv := NewServer(prService, nil, nil, nil, nil, nil, nil, nil, nil)
+	status := generated.GetUsersGetReviewParamsStatus(entity.StatusOpen)
+	request := generated.GetUsersGetReviewRequestObject{Params: generated.GetUsersGetReviewParams{
+		UserId: "u1", Status: &status, Cursor: lo.ToPtr("page-2"),
+	}}
+
+	response, err := srv.GetUsersGetReview(context.Background(), request)
+	rq.NoError(err)
+	page, ok := response.(generated.GetUsersGetReview200JSONResponse)
+	rq.True(ok)
+	rq.Equal("page-2", prService.cursor)
+	rq.Equal("next-page", lo.FromPtr(page.NextCursor))
+	rq.Equal(generated.PullRequestShortStatus(entity.StatusOpen), page.PullRequests[0].Status)
+
+	prService.reviewsErr = domain.NewError(errcodes.InvalidArgument, "malformed cursor")
+	response, err = srv.GetUsersGetReview(context.Background(), request)
+	rq.NoError(err)
+	badRequest, ok := response.(generated.GetUsersGetReview400JSONResponse)
+	rq.True(ok)
+	rq.Equal(generated.INVALIDARGUMENT, badRequest.Error.Code)
+}
+
+func (s *fakePullRequestService) GetHistory(_ context.Context, prId string) ([]entity.ReviewerAssignment, error) {
+	if prId != "pr-1" {
+		return nil, domain.NewError(errcodes.NotFound, "pull request not found")
+	}
+	history := []entity.ReviewerAssignment{{ReviewerId: "u9", IsCurrent: true, ReviewState: entity.ReviewPending}}
+	for _, reason := range []string{entity.ReplaceReasonManual, entity.ReplaceReasonDeactivation,
+		entity.ReplaceReasonTeamMove, entity.ReplaceReasonPRClosed} {
+		history = append(history, entity.ReviewerAssignment{ReviewerId: "u1", ReplaceReason: lo.ToPtr(reason)})
+	}
+	return history, nil
+}
+
+func TestGetPullRequestHistory(t *testing.T) {
+	rq := require.New(t)
+
+	srv := NewServer(&fakePullRequestService{}, nil, nil, nil, nil, nil, nil, nil, nil)
+
+	response, err := srv.GetPullRequestHistory(context.Background(), generated.GetPullRequestHistoryRequestObject{
+		Params: generated.GetPullRequestHistoryParams{PullRequestId: "pr-1"},
+	})
+	rq.NoError(err)
+	history, ok := response.(generated.GetPullRequestHistory200JSONResponse)
+	rq.True(ok)
+	rq.Len(history.History, 5)
+	rq.True(history.History[0].IsCurrent)
+	rq.Nil(history.History[0].ReplaceReason)
+	// каждая причина замены из домена должна быть значением enum в openapi.yaml
+	rq.Equal([]generated.ReviewerAssignmentReplaceReason{
+		generated.ReviewerAssignmentReplaceReasonManualReassign,
+		generated.ReviewerAssignmentReplaceReasonDeactivation,
+		generated.ReviewerAssignmentReplaceReasonTeamMove,
+		generated.ReviewerAssignmentReplaceReasonPrClosed,
+	}, lo.Map(history.History[1:], func(a generated.ReviewerAssignment, _ int) generated.ReviewerAssignmentReplaceReason {
+		return *a.ReplaceReason
+	}))
+
+	response, err = srv.GetPullRequestHistory(context.Background(), generated.GetPullRequestHistoryRequestObject{
+		Params: generated.GetPullRequestHistoryParams{PullRequestId: "pr-404"},
+	})
+	rq.NoError(err)
+	notFound, ok := response.(generated.GetPullRequestHistory404JSONResponse)
+	rq.True(ok)
+	rq.Equal(generated.NOTFOUND, notFound.Error.Code)
+}
+
+type fakeAuditService struct {
+	events []entity.AuditEvent
+	err    error
+}
+
+func (s *fakeAuditService) ListEvents(context.Context, entity.AuditFilter, string) ([]entity.AuditEvent, string, error) {
+	return s.events, "", s.err
+}
+
+func TestGetAuditPayloads(t *testing.T) {
+	rq := require.New(t)
+
+	auditService := &fakeAuditService{events: []entity.AuditEvent{{
+		Id:         1,
+		Operation:  entity.AuditTeamCreate,
+		EntityType: entity.AuditEntityTeam,
+		EntityId:   "backend",
+		After:      []byte(`{"team_name":"backend","required_reviewers":2,"fallback_teams":["platform"]}`),
+	}}}
+	srv := NewServer(nil, nil, nil, nil, auditService, nil, nil, nil, nil)
+
+	response, err := srv.GetAudit(context.Background(), generated.GetAuditRequestObject{})
+	rq.NoError(err)
+	page, ok := response.(generated.GetAudit200JSONResponse)
+	rq.True(ok)
+	rq.Nil(page.NextCursor)
+	rq.Nil(page.Events[0].Before)
+	rq.Equal(map[string]interface{}{
+		"team_name":          "backend",
+		"required_reviewers": float64(2),
+		"fallback_teams":     []interface{}{"platform"},
+	}, *page.Events[0].After)
+
+	auditService.events[0].After = []byte(`not json`)
+	_, err = srv.GetAudit(context.Background(), generated.GetAuditRequestObject{})
+	rq.Error(err)
+
+	auditService.err = domain.NewError(errcodes.InvalidArgument, "malformed cursor")
+	response, err = srv.GetAudit(context.Background(), generated.GetAuditRequestObject{})
+	rq.NoError(err)
+	_, ok = response.(generated.GetAudit400JSONResponse)
+	rq.True(ok)
+}
+
+func TestPostPullRequestMergeErrors(t *testing.T) {
+	rq := require.New(t)
+
+	prService := &fakePullRequestService{}
+	srv := NewServer(prService, nil, nil, nil, nil, nil, nil, nil, nil)
+	request := generated.PostPullRequestMergeRequestObject{
+		Body: &generated.PostPullRequestMergeJSONRequestBody{PullRequestId: "pr-1"},
+	}
+
+	response, err := srv.PostPullRequestMerge(context.Background(), request)
+	rq.NoError(err)
+	rq.IsType(generated.PostPullRequestMerge200JSONResponse{}, response)
+
+	prService.mergeErr = domain.NewError(errcodes.InvalidTransition, "cannot merge PR in status CLOSED")
+	response, err = srv.PostPullRequestMerge(context.Background(), request)
+	rq.NoError(err)
+	conflict, ok := response.(generated.PostPullRequestMerge409JSONResponse)
+	rq.True(ok)
+	rq.Equal(generated.INVALIDTRANSITION, conflict.Error.Code)
+
+	prService.mergeErr = domain.WrapError(errors.New("connection reset"), errcodes.InternalServerError, "failed to merge")
+	response, err = srv.PostPullRequestMerge(context.Background(), request)
+	rq.NoError(err)
+	internal, ok := response.(generated.PostPullRequestMerge500JSONResponse)
+	rq.True(ok)
+	rq.Equal(generated.INTERNALSERVERERROR, internal.Error.Code)
+	rq.NotContains(internal.Error.Message, "connection reset")
+}
+
+func TestPostPullRequestReviewIdentity(t *testing.T) {
+	rq := require.New(t)
+
+	prService := &fakePullRequestService{}
+	srv := NewServer(prService, nil, nil, nil, nil, nil, nil, nil, nil)
+	request := generated.PostPullRequestReviewRequestObject{Body: &generated.PostPullRequestReviewJSONRequestBody{
+		PullRequestId: "pr-1",
+		ReviewerId:    "u2",
+		State:         generated.PostPullRequestReviewJSONBodyStateApproved,
+	}}
+	asUser := func(userID contextx.UserID, role contextx.Role) context.Context {
+		return contextx.WithRole(contextx.WithUserID(context.Background(), userID), role)
+	}
+
+	response, err := srv.PostPullRequestReview(asUser("u3", contextx.RoleUser), request)
+	rq.NoError(err)
+	forbidden, ok := response.(generated.PostPullRequestReview403JSONResponse)
+	rq.True(ok)
+	rq.Equal(generated.FORBIDDEN, forbidden.Error.Code)
+	rq.Empty(prService.reviews)
+
+	response, err = srv.PostPullRequestReview(asUser("u2", contextx.RoleUser), request)
+	rq.NoError(err)
+	_, ok = response.(generated.PostPullRequestReview200JSONResponse)
+	rq.True(ok)
+
+	// админ отправляет ревью за другого ревьювера
+	response, err = srv.PostPullRequestReview(asUser("admin", contextx.RoleAdmin), request)
+	rq.NoError(err)
+	_, ok = response.(generated.PostPullRequestReview200JSONResponse)
+	rq.True(ok)
+
+	rq.Len(prService.reviews, 2)
+	rq.Equal("u2", prService.reviews[1].ReviewerId)
+
+	prService.reviewErr = domain.NewError(errcodes.InvalidTransition, "cannot review closed PR")
+	response, err = srv.PostPullRequestReview(asUser("u2", contextx.RoleUser), request)
+	rq.NoError(err)
+	conflict, ok := response.(generated.PostPullRequestReview409JSONResponse)
+	rq.True(ok)
+	rq.Equal(generated.INVALIDTRANSITION, conflict.Error.Code)
+}
@@ -0,0 +1,121 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"pull_requests_service/internal/domain"
+	"pull_requests_service/internal/domain/entity"
+	"pull_requests_service/internal/server/generated"
+	"pull_requests_service/pkg/errcodes"
+
+	"github.com/samber/lo"
+)
+
+func (s *Server) PostTeamAddMember(ctx context.Context, request generated.PostTeamAddMemberRequestObject) (
+	generated.PostTeamAddMemberResponseObject, error) {
+
+	member := request.Body.Member
+	user, err := s.teamService.AddMember(ctx, request.Body.TeamName, entity.User{
+		Id:        member.UserId,
+		Name:      member.Username,
+		IsActive:  member.IsActive,
+		Seniority: lo.FromPtr(member.Seniority),
+	})
+	if err != nil {
+		var appErr *domain.AppError
+		if errors.As(err, &appErr) {
+			switch appErr.Code {
+			case errcodes.NotFound:
+				return generated.PostTeamAddMember404JSONResponse{
+					Error: struct {
+						Code    generated.ErrorResponseErrorCode `json:"code"`
+						Message string                           `json:"message"`
+					}{Code: generated.NOTFOUND, Message: appErr.Message},
+				}, nil
+			case errcodes.UserAlreadyExists:
+				return generated.PostTeamAddMember409JSONResponse{
+					Error: struct {
+						Code    generated.ErrorResponseErrorCode `json:"code"`
+						Message string                           `json:"message"`
+					}{Code: generated.USEREXISTS, Message: appErr.Message},
+				}, nil
+			case errcodes.InvalidArgument:
+				return generated.PostTeamAddMember400JSONResponse{
+					Error: struct {
+						Code    generated.ErrorResponseErrorCode `json:"code"`
+						Message string                           `json:"message"`
+					}{Code: generated.INVALIDARGUMENT, Message: appErr.Message},
+				}, nil
+			}
+		}
+		return nil, err
+	}
+
+	return generated.PostTeamAddMember200JSONResponse{User: toAPIUser(user)}, nil
+}
+
+func (s *Server) PostTeamRemoveMember(ctx context.Context, request generated.PostTeamRemoveMemberRequestObject) (
+	generated.PostTeamRemoveMemberResponseObject, error) {
+
+	change, err := s.teamService.RemoveMember(ctx, request.Body.TeamName, request.Body.UserId)
+	if err != nil {
+		var appErr *domain.AppError
+		if errors.As(err, &appErr) && appErr.Code == errcodes.NotFound {
+			return generated.PostTeamRemoveMember404JSONResponse{
+				Error: struct {
+					Code    generated.ErrorResponseErrorCode `json:"code"`
+					Message string                           `json:"message"`
+				}{Code: generated.NOTFOUND, Message: appErr.Message},
+			}, nil
+		}
+		return nil, err
+	}
+
+	return generated.PostTeamRemoveMember200JSONResponse(toAPIMembershipChange(change)), nil
+}
+
+func (s *Server) PostTeamMoveMember(ctx context.Context, request generated.PostTeamMoveMemberRequestObject) (
+	generated.PostTeamMoveMemberResponseObject, error) {
+
+	change, err := s.teamService.MoveMember(ctx, request.Body.UserId, request.Body.TeamName)
+	if err != nil {
+		var appErr *domain.AppError
+		if errors.As(err, &appErr) {
+			switch appErr.Code {
+			case errcodes.NotFound:
+				return generated.PostTeamMoveMember404JSONResponse{
+					Error: struct {
+						Code    generated.ErrorResponseErrorCode `json:"code"`
+						Message string                           `json:"message"`
+					}{Code: generated.NOTFOUND, Message: appErr.Message},
+				}, nil
+			case errcodes.InvalidArgument:
+				return generated.PostTeamMoveMember400JSONResponse{
+					Error: struct {
+						Code    generated.ErrorResponseErrorCode `json:"code"`
+						Message string                           `json:"message"`
+					}{Code: generated.INVALIDARGUMENT, Message: appErr.Message},
+				}, nil
+			}
+		}
+		return nil, err
+	}
+
+	return generated.PostTeamMoveMember200JSONResponse(toAPIMembershipChange(change)), nil
+}
+
+func toAPIUser(user entity.User) generated.User {
+	return generated.User{
+		UserId:   user.Id,
+		Username: user.Name,
+		TeamName: user.Team,
+		IsActive: user.IsActive,
+	}
+}
+
+func toAPIMembershipChange(change entity.MembershipChange) generated.TeamMembershipChange {
+	return generated.TeamMembershipChange{
+		User:                   toAPIUser(change.User),
+		ReassignedPullRequests: change.ReassignedPullRequests,
+	}
+}
@@ -0,0 +1,88 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"pull_requests_service/internal/domain"
+	"pull_requests_service/internal/domain/entity"
+	"pull_requests_service/internal/server/generated"
+	"pull_requests_service/pkg/errcodes"
+
+	"github.com/samber/lo"
+)
+
+func (s *Server) PostTeamArchive(ctx context.Context, request generated.PostTeamArchiveRequestObject) (
+	generated.PostTeamArchiveResponseObject, error) {
+
+	summary, err := s.teamService.ArchiveTeam(ctx, request.Body.TeamName, toRetirePolicy(request.Body.Policy))
+	if err != nil {
+		var appErr *domain.AppError
+		if errors.As(err, &appErr) {
+			switch appErr.Code {
+			case errcodes.NotFound:
+				return generated.PostTeamArchive404JSONResponse{
+					Error: struct {
+						Code    generated.ErrorResponseErrorCode `json:"code"`
+						Message string                           `json:"message"`
+					}{Code: generated.NOTFOUND, Message: appErr.Message},
+				}, nil
+			case errcodes.InvalidArgument:
+				return generated.PostTeamArchive400JSONResponse{
+					Error: struct {
+						Code    generated.ErrorResponseErrorCode `json:"code"`
+						Message string                           `json:"message"`
+					}{Code: generated.INVALIDARGUMENT, Message: appErr.Message},
+				}, nil
+			}
+		}
+		return nil, err
+	}
+
+	return generated.PostTeamArchive200JSONResponse(toAPITeamRetirement(summary)), nil
+}
+
+func (s *Server) PostTeamDelete(ctx context.Context, request generated.PostTeamDeleteRequestObject) (
+	generated.PostTeamDeleteResponseObject, error) {
+
+	summary, err := s.teamService.DeleteTeam(ctx, request.Body.TeamName, toRetirePolicy(request.Body.Policy))
+	if err != nil {
+		var appErr *domain.AppError
+		if errors.As(err, &appErr) {
+			switch appErr.Code {
+			case errcodes.NotFound:
+				return generated.PostTeamDelete404JSONResponse{
+					Error: struct {
+						Code    generated.ErrorResponseErrorCode `json:"code"`
+						Message string                           `json:"message"`
+					}{Code: generated.NOTFOUND, Message: appErr.Message},
+				}, nil
+			case errcodes.InvalidArgument:
+				return generated.PostTeamDelete400JSONResponse{
+					Error: struct {
+						Code    generated.ErrorResponseErrorCode `json:"code"`
+						Message string                           `json:"message"`
+					}{Code: generated.INVALIDARGUMENT, Message: appErr.Message},
+				}, nil
+			}
+		}
+		return nil, err
+	}
+
+	return generated.PostTeamDelete200JSONResponse(toAPITeamRetirement(summary)), nil
+}
+
+func toRetirePolicy(policy generated.TeamRetirePolicy) entity.TeamRetirePolicy {
+	return entity.TeamRetirePolicy{
+		PullRequests: string(policy.PullRequests),
+		TargetTeam:   lo.FromPtr(policy.TargetTeam),
+	}
+}
+
+func toAPITeamRetirement(summary entity.TeamRetirement) generated.TeamRetirement {
+	return generated.TeamRetirement{
+		TeamName:               summary.Team,
+		Members:                summary.Members,
+		ClosedPullRequests:     summary.ClosedPullRequests,
+		ReassignedPullRequests: summary.ReassignedPullRequests,
+	}
+}
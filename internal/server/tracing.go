@@ -0,0 +1,21 @@
+package server
+
+import (
+	"context"
+	"net/http"
+	"pull_requests_service/internal/server/generated"
+	"pull_requests_service/pkg/tracing"
+)
+
+// Trace открывает спан на вызов обработчика Server, названный по operationId из openapi.yaml.
+func Trace(f generated.StrictHandlerFunc, operationID string) generated.StrictHandlerFunc {
+	return func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		ctx, span := tracing.Tracer().Start(ctx, "Server."+operationID)
+		defer span.End()
+
+		response, err := f(ctx, w, r, request)
+		tracing.RecordError(span, err)
+
+		return response, err
+	}
+}
@@ -0,0 +1,201 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"pull_requests_service/internal/domain"
+	"pull_requests_service/internal/domain/entity"
+	"pull_requests_service/internal/server/generated"
+	"pull_requests_service/pkg/errcodes"
+
+	"github.com/samber/lo"
+)
+
+// WebhookService управляет подписками на исходящие вебхуки.
+type WebhookService interface {
+	CreateWebhook(ctx context.Context, sub entity.WebhookSubscription) (entity.WebhookSubscription, error)
+	ListWebhooks(ctx context.Context) ([]entity.WebhookSubscription, error)
+	UpdateWebhook(ctx context.Context, id int64, settings entity.WebhookSettings) (entity.WebhookSubscription, error)
+	DeleteWebhook(ctx context.Context, id int64) error
+	ListDeliveries(ctx context.Context, filter entity.WebhookDeliveryFilter, cursor string) ([]entity.WebhookDelivery, string, error)
+}
+
+func (s *Server) PostWebhookCreate(ctx context.Context, request generated.PostWebhookCreateRequestObject) (generated.PostWebhookCreateResponseObject, error) {
+	sub := entity.WebhookSubscription{
+		Url:    request.Body.Url,
+		Secret: lo.FromPtr(request.Body.Secret),
+		Events: fromAPIWebhookEvents(request.Body.Events),
+	}
+
+	created, err := s.webhookService.CreateWebhook(ctx, sub)
+	if err != nil {
+		var appErr *domain.AppError
+		if errors.As(err, &appErr) && appErr.Code == errcodes.InvalidArgument {
+			return generated.PostWebhookCreate400JSONResponse{
+				Error: struct {
+					Code    generated.ErrorResponseErrorCode `json:"code"`
+					Message string                           `json:"message"`
+				}{Code: generated.INVALIDARGUMENT, Message: appErr.Message},
+			}, nil
+		}
+		return nil, err
+	}
+
+	return generated.PostWebhookCreate201JSONResponse{
+		Webhook: toAPIWebhook(created),
+		Secret:  created.Secret,
+	}, nil
+}
+
+func (s *Server) GetWebhookList(ctx context.Context, _ generated.GetWebhookListRequestObject) (generated.GetWebhookListResponseObject, error) {
+	subs, err := s.webhookService.ListWebhooks(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	response := generated.GetWebhookList200JSONResponse{
+		Webhooks: make([]generated.Webhook, len(subs)),
+	}
+	for i, sub := range subs {
+		response.Webhooks[i] = toAPIWebhook(sub)
+	}
+	return response, nil
+}
+
+func (s *Server) PostWebhookUpdate(ctx context.Context, request generated.PostWebhookUpdateRequestObject) (generated.PostWebhookUpdateResponseObject, error) {
+	settings := entity.WebhookSettings{
+		Url:      request.Body.Url,
+		IsActive: request.Body.IsActive,
+	}
+	if request.Body.Events != nil {
+		settings.Events = fromAPIWebhookEvents(*request.Body.Events)
+	}
+
+	updated, err := s.webhookService.UpdateWebhook(ctx, request.Body.Id, settings)
+	if err != nil {
+		var appErr *domain.AppError
+		if errors.As(err, &appErr) {
+			switch appErr.Code {
+			case errcodes.InvalidArgument:
+				return generated.PostWebhookUpdate400JSONResponse{
+					Error: struct {
+						Code    generated.ErrorResponseErrorCode `json:"code"`
+						Message string                           `json:"message"`
+					}{Code: generated.INVALIDARGUMENT, Message: appErr.Message},
+				}, nil
+			case errcodes.NotFound:
+				return generated.PostWebhookUpdate404JSONResponse{
+					Error: struct {
+						Code    generated.ErrorResponseErrorCode `json:"code"`
+						Message string                           `json:"message"`
+					}{Code: generated.NOTFOUND, Message: appErr.Message},
+				}, nil
+			}
+		}
+		return nil, err
+	}
+
+	return generated.PostWebhookUpdate200JSONResponse{Webhook: toAPIWebhook(updated)}, nil
+}
+
+func (s *Server) PostWebhookDelete(ctx context.Context, request generated.PostWebhookDeleteRequestObject) (generated.PostWebhookDeleteResponseObject, error) {
+	if err := s.webhookService.DeleteWebhook(ctx, request.Body.Id); err != nil {
+		var appErr *domain.AppError
+		if errors.As(err, &appErr) && appErr.Code == errcodes.NotFound {
+			return generated.PostWebhookDelete404JSONResponse{
+				Error: struct {
+					Code    generated.ErrorResponseErrorCode `json:"code"`
+					Message string                           `json:"message"`
+				}{Code: generated.NOTFOUND, Message: appErr.Message},
+			}, nil
+		}
+		return nil, err
+	}
+
+	return generated.PostWebhookDelete204Response{}, nil
+}
+
+func (s *Server) GetWebhookDeliveries(ctx context.Context, request generated.GetWebhookDeliveriesRequestObject) (generated.GetWebhookDeliveriesResponseObject, error) {
+	params := request.Params
+	filter := entity.WebhookDeliveryFilter{
+		SubscriptionId: params.WebhookId,
+		Status:         string(lo.FromPtr(params.Status)),
+		Limit:          lo.FromPtr(params.Limit),
+	}
+
+	deliveries, nextCursor, err := s.webhookService.ListDeliveries(ctx, filter, lo.FromPtr(params.Cursor))
+	if err != nil {
+		var appErr *domain.AppError
+		if errors.As(err, &appErr) {
+			switch appErr.Code {
+			case errcodes.InvalidArgument:
+				return generated.GetWebhookDeliveries400JSONResponse{
+					Error: struct {
+						Code    generated.ErrorResponseErrorCode `json:"code"`
+						Message string                           `json:"message"`
+					}{Code: generated.INVALIDARGUMENT, Message: appErr.Message},
+				}, nil
+			case errcodes.NotFound:
+				return generated.GetWebhookDeliveries404JSONResponse{
+					Error: struct {
+						Code    generated.ErrorResponseErrorCode `json:"code"`
+						Message string                           `json:"message"`
+					}{Code: generated.NOTFOUND, Message: appErr.Message},
+				}, nil
+			}
+		}
+		return nil, err
+	}
+
+	response := generated.GetWebhookDeliveries200JSONResponse{
+		Deliveries: make([]generated.WebhookDelivery, len(deliveries)),
+	}
+	for i, d := range deliveries {
+		var payload map[string]interface{}
+		if err = json.Unmarshal(d.Payload, &payload); err != nil {
+			return nil, domain.WrapError(err, errcodes.InternalServerError, "failed to decode webhook payload")
+		}
+
+		response.Deliveries[i] = generated.WebhookDelivery{
+			Id:             d.Id,
+			WebhookId:      d.SubscriptionId,
+			EventId:        d.EventId,
+			EventType:      generated.WebhookEvent(d.EventType),
+			Status:         generated.WebhookDeliveryStatus(d.Status),
+			Attempts:       d.Attempts,
+			LastStatusCode: d.LastStatusCode,
+			LastError:      d.LastError,
+			Payload:        payload,
+			CreatedAt:      d.CreatedAt,
+			DeliveredAt:    d.DeliveredAt,
+		}
+	}
+	if nextCursor != "" {
+		response.NextCursor = &nextCursor
+	}
+	return response, nil
+}
+
+func toAPIWebhook(sub entity.WebhookSubscription) generated.Webhook {
+	events := make([]generated.WebhookEvent, len(sub.Events))
+	for i, event := range sub.Events {
+		events[i] = generated.WebhookEvent(event)
+	}
+
+	return generated.Webhook{
+		Id:        sub.Id,
+		Url:       sub.Url,
+		Events:    events,
+		IsActive:  sub.IsActive,
+		CreatedAt: sub.CreatedAt,
+	}
+}
+
+func fromAPIWebhookEvents(events []generated.WebhookEvent) []string {
+	result := make([]string, len(events))
+	for i, event := range events {
+		result[i] = string(event)
+	}
+	return result
+}
@@ -0,0 +1,74 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"pull_requests_service/internal/domain"
+	"pull_requests_service/internal/domain/entity"
+	"pull_requests_service/internal/server/generated"
+	"pull_requests_service/pkg/errcodes"
+
+	"github.com/samber/lo"
+)
+
+func (s *Server) GetUsersWorkload(ctx context.Context, request generated.GetUsersWorkloadRequestObject) (
+	generated.GetUsersWorkloadResponseObject, error) {
+
+	workloads, err := s.statsService.GetWorkload(ctx, lo.FromPtr(request.Params.TeamName))
+	if err != nil {
+		return nil, err
+	}
+
+	response := generated.GetUsersWorkload200JSONResponse{
+		Users: make([]generated.UserWorkload, len(workloads)),
+	}
+	for i, workload := range workloads {
+		response.Users[i] = toAPIWorkload(workload)
+	}
+	return response, nil
+}
+
+func (s *Server) PostUsersSetMaxOpenReviews(ctx context.Context, request generated.PostUsersSetMaxOpenReviewsRequestObject) (
+	generated.PostUsersSetMaxOpenReviewsResponseObject, error) {
+
+	workload, err := s.userService.SetMaxOpenReviews(ctx, request.Body.UserId, request.Body.MaxOpenReviews)
+	if err != nil {
+		var appErr *domain.AppError
+		if errors.As(err, &appErr) {
+			switch appErr.Code {
+			case errcodes.NotFound:
+				return generated.PostUsersSetMaxOpenReviews404JSONResponse{
+					Error: struct {
+						Code    generated.ErrorResponseErrorCode `json:"code"`
+						Message string                           `json:"message"`
+					}{Code: generated.NOTFOUND, Message: appErr.Message},
+				}, nil
+			case errcodes.InvalidArgument:
+				return generated.PostUsersSetMaxOpenReviews400JSONResponse{
+					Error: struct {
+						Code    generated.ErrorResponseErrorCode `json:"code"`
+						Message string                           `json:"message"`
+					}{Code: generated.INVALIDARGUMENT, Message: appErr.Message},
+				}, nil
+			}
+		}
+		return nil, err
+	}
+
+	return generated.PostUsersSetMaxOpenReviews200JSONResponse{Workload: toAPIWorkload(workload)}, nil
+}
+
+func toAPIWorkload(workload entity.UserWorkload) generated.UserWorkload {
+	apiWorkload := generated.UserWorkload{
+		UserId:         workload.UserId,
+		Username:       workload.Username,
+		TeamName:       workload.Team,
+		IsActive:       workload.IsActive,
+		OpenReviews:    workload.OpenReviews,
+		MaxOpenReviews: workload.MaxOpenReviews,
+	}
+	if available, ok := workload.Available(); ok {
+		apiWorkload.Available = &available
+	}
+	return apiWorkload
+}
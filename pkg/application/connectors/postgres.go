@@ -3,6 +3,7 @@ package connectors
 import (
 	"context"
 	"errors"
+	"fmt"
 	"github.com/golang-migrate/migrate/v4"
 	_ "github.com/golang-migrate/migrate/v4/database/postgres"
 	_ "github.com/golang-migrate/migrate/v4/source/file"
@@ -10,10 +11,12 @@ import (
 	"log/slog"
 	"net/url"
 	"pull_requests_service/pkg/logx"
+	"pull_requests_service/pkg/tracing"
 	"sync"
 	"time"
 
-	_ "github.com/jackc/pgx/v5/stdlib"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/stdlib"
 	"github.com/jmoiron/sqlx"
 	"github.com/samber/lo"
 )
@@ -25,11 +28,17 @@ type Postgres struct {
 	MaxOpenConns    int
 	ConnMaxLifetime time.Duration
 	init            sync.Once
+	// migrationVersion — версия схемы, до которой эта реплика накатила миграции при старте.
+	migrationVersion uint
 }
 
 func (p *Postgres) Client(ctx context.Context) *sqlx.DB {
 	p.init.Do(func() {
-		p.value = lo.Must(sqlx.ConnectContext(ctx, "pgx", p.DSN))
+		config := lo.Must(pgx.ParseConfig(p.DSN))
+		config.Tracer = tracing.QueryTracer{}
+
+		p.value = sqlx.NewDb(stdlib.OpenDB(*config), "pgx")
+		lo.Must0(p.value.PingContext(ctx))
 
 		p.value.SetMaxOpenConns(p.MaxOpenConns)
 		p.value.SetMaxIdleConns(p.MaxIdleConns)
@@ -68,6 +77,35 @@ func (p *Postgres) RunMigrations(ctx context.Context) error {
 			slog.String("error", err.Error()))
 		return err
 	}
-	logger(ctx).Info("Successfully migrated migrations")
+	version, _, err := m.Version()
+	if err != nil && !errors.Is(err, migrate.ErrNilVersion) {
+		logger(ctx).Error("Error reading migration version",
+			slog.String("error", err.Error()))
+		return err
+	}
+	p.migrationVersion = version
+	logger(ctx).Info("Successfully migrated migrations", slog.Uint64("version", uint64(version)))
 	return nil
 }
+
+// CheckMigrations сверяет состояние схемы в БД с версией, накатанной при старте.
+// Более новая версия допустима: её могла накатить реплика следующего релиза.
+func (p *Postgres) CheckMigrations(ctx context.Context) (uint, error) {
+	var state struct {
+		Version uint `db:"version"`
+		Dirty   bool `db:"dirty"`
+	}
+
+	err := p.value.GetContext(ctx, &state, `SELECT version, dirty FROM schema_migrations LIMIT 1`)
+	if err != nil {
+		return 0, fmt.Errorf("read schema_migrations: %w", err)
+	}
+	if state.Dirty {
+		return state.Version, fmt.Errorf("migration %d is dirty", state.Version)
+	}
+	if state.Version < p.migrationVersion {
+		return state.Version, fmt.Errorf("schema version %d is behind expected %d", state.Version, p.migrationVersion)
+	}
+
+	return state.Version, nil
+}
@@ -0,0 +1,75 @@
+package connectors
+
+import (
+	"context"
+	"log/slog"
+	"pull_requests_service/pkg/logx"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+
+	semconv "go.opentelemetry.io/otel/semconv/v1.37.0"
+)
+
+type Tracing struct {
+	value   *sdktrace.TracerProvider
+	Name    string
+	Version string
+	// Endpoint — адрес OTLP/HTTP приёмника; если пуст, спаны создаются (ради trace id), но не экспортируются.
+	Endpoint      string
+	SampleRatio   float64
+	ExportTimeout time.Duration
+	init          sync.Once
+}
+
+// Provider создаёт провайдер и регистрирует его глобально вместе с W3C propagator.
+func (t *Tracing) Provider(ctx context.Context) *sdktrace.TracerProvider {
+	t.init.Do(func() {
+		options := []sdktrace.TracerProviderOption{
+			sdktrace.WithResource(resource.NewWithAttributes(semconv.SchemaURL,
+				semconv.ServiceName(t.Name),
+				semconv.ServiceVersion(t.Version),
+			)),
+			sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(t.SampleRatio))),
+		}
+		if t.Endpoint != "" {
+			exporter, err := otlptracehttp.New(ctx,
+				otlptracehttp.WithEndpointURL(t.Endpoint),
+				otlptracehttp.WithTimeout(t.ExportTimeout),
+			)
+			if err != nil {
+				// без экспортёра спаны всё равно нужны ради trace id в логах и ответах
+				logger(ctx).Error("otlptracehttp.New", logx.Error(err))
+			} else {
+				options = append(options, sdktrace.WithBatcher(exporter, sdktrace.WithExportTimeout(t.ExportTimeout)))
+			}
+		}
+
+		t.value = sdktrace.NewTracerProvider(options...)
+		otel.SetTracerProvider(t.value)
+		otel.SetTextMapPropagator(propagation.TraceContext{})
+
+		logger(ctx).Info("tracing configured",
+			slog.String("endpoint", t.Endpoint),
+			slog.Float64("sample_ratio", t.SampleRatio),
+		)
+	})
+
+	return t.value
+}
+
+// Close выгружает накопленные спаны перед остановкой.
+func (t *Tracing) Close(ctx context.Context) {
+	if t.value == nil {
+		return
+	}
+
+	if err := t.value.Shutdown(ctx); err != nil {
+		logger(ctx).Error("tracing.Shutdown", logx.Error(err))
+	}
+}
@@ -0,0 +1,278 @@
+package bulkfile
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// Форматы файлов: JSON Lines (объект на строку) и CSV с заголовком из имён json-тегов.
+const (
+	FormatJSONL = "jsonl"
+	FormatCSV   = "csv"
+)
+
+// ListSeparator разделяет элементы списков в ячейке CSV.
+const ListSeparator = ";"
+
+const maxLineSize = 1 << 20
+
+var ErrUnknownFormat = errors.New("unknown format")
+
+// Read разбирает записи по одной и передаёт их в fn вместе с номером строки файла.
+// Ошибка разбора отдельной записи передаётся в fn, а не прерывает чтение;
+// Read возвращает только ошибки чтения, неверный заголовок CSV и ошибки fn.
+// Поля записи T сопоставляются по json-тегам; в CSV поддерживаются строки, числа,
+// bool, указатели на них и списки строк через ListSeparator.
+func Read[T any](r io.Reader, format string, fn func(line int, record T, err error) error) error {
+	switch format {
+	case FormatJSONL:
+		return readJSONL(r, fn)
+	case FormatCSV:
+		return readCSV(r, fn)
+	default:
+		return fmt.Errorf("%w '%s'", ErrUnknownFormat, format)
+	}
+}
+
+func readJSONL[T any](r io.Reader, fn func(line int, record T, err error) error) error {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxLineSize)
+
+	line := 0
+	for scanner.Scan() {
+		line++
+		raw := bytes.TrimSpace(scanner.Bytes())
+		if len(raw) == 0 {
+			continue
+		}
+
+		var record T
+		decoder := json.NewDecoder(bytes.NewReader(raw))
+		decoder.DisallowUnknownFields()
+		err := decoder.Decode(&record)
+		if err = fn(line, record, err); err != nil {
+			return err
+		}
+	}
+
+	return scanner.Err()
+}
+
+func readCSV[T any](r io.Reader, fn func(line int, record T, err error) error) error {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		return fmt.Errorf("read header: %w", err)
+	}
+
+	fields := fieldsByName(reflect.TypeFor[T]())
+	columns := make([]int, len(header))
+	for i, name := range header {
+		index, ok := fields[strings.TrimSpace(name)]
+		if !ok {
+			return fmt.Errorf("unknown column '%s'", name)
+		}
+		columns[i] = index
+	}
+
+	for {
+		row, readErr := reader.Read()
+		if errors.Is(readErr, io.EOF) {
+			return nil
+		}
+		line, _ := reader.FieldPos(0)
+
+		var record T
+		var parseErr *csv.ParseError
+		switch {
+		case errors.As(readErr, &parseErr) && errors.Is(parseErr.Err, csv.ErrFieldCount):
+			line = parseErr.Line
+			err = readErr
+		case readErr != nil:
+			return readErr
+		default:
+			err = decodeRow(reflect.ValueOf(&record).Elem(), header, columns, row)
+		}
+
+		if err = fn(line, record, err); err != nil {
+			return err
+		}
+	}
+}
+
+// Writer пишет записи в файл выбранного формата.
+type Writer[T any] struct {
+	format string
+	json   *json.Encoder
+	csv    *csv.Writer
+	fields []int
+}
+
+// NewWriter создаёт Writer; для CSV сразу пишется заголовок.
+func NewWriter[T any](w io.Writer, format string) (*Writer[T], error) {
+	switch format {
+	case FormatJSONL:
+		return &Writer[T]{format: format, json: json.NewEncoder(w)}, nil
+	case FormatCSV:
+		writer := &Writer[T]{format: format, csv: csv.NewWriter(w)}
+		typ := reflect.TypeFor[T]()
+		var header []string
+		for i := range typ.NumField() {
+			if name := fieldName(typ.Field(i)); name != "" {
+				header = append(header, name)
+				writer.fields = append(writer.fields, i)
+			}
+		}
+		return writer, writer.csv.Write(header)
+	default:
+		return nil, fmt.Errorf("%w '%s'", ErrUnknownFormat, format)
+	}
+}
+
+func (w *Writer[T]) Write(record T) error {
+	if w.json != nil {
+		return w.json.Encode(record)
+	}
+
+	value := reflect.ValueOf(record)
+	row := make([]string, len(w.fields))
+	for i, index := range w.fields {
+		row[i] = formatValue(value.Field(index))
+	}
+
+	return w.csv.Write(row)
+}
+
+// Flush дописывает буферизованные записи.
+func (w *Writer[T]) Flush() error {
+	if w.csv == nil {
+		return nil
+	}
+
+	w.csv.Flush()
+	return w.csv.Error()
+}
+
+func fieldsByName(typ reflect.Type) map[string]int {
+	fields := make(map[string]int, typ.NumField())
+	for i := range typ.NumField() {
+		if name := fieldName(typ.Field(i)); name != "" {
+			fields[name] = i
+		}
+	}
+
+	return fields
+}
+
+func fieldName(field reflect.StructField) string {
+	if !field.IsExported() {
+		return ""
+	}
+
+	name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+	if name == "-" {
+		return ""
+	}
+	if name == "" {
+		return field.Name
+	}
+
+	return name
+}
+
+func decodeRow(record reflect.Value, header []string, columns []int, row []string) error {
+	for i, cell := range row {
+		if err := parseValue(record.Field(columns[i]), strings.TrimSpace(cell)); err != nil {
+			return fmt.Errorf("column '%s': %w", header[i], err)
+		}
+	}
+
+	return nil
+}
+
+// parseValue заполняет поле значением ячейки; пустая ячейка оставляет нулевое значение.
+func parseValue(field reflect.Value, cell string) error {
+	if cell == "" {
+		return nil
+	}
+
+	if field.Kind() == reflect.Pointer {
+		value := reflect.New(field.Type().Elem())
+		if err := parseValue(value.Elem(), cell); err != nil {
+			return err
+		}
+		field.Set(value)
+		return nil
+	}
+
+	switch field.Kind() {
+	case reflect.String:
+		field.SetString(cell)
+	case reflect.Int, reflect.Int64:
+		n, err := strconv.ParseInt(cell, 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid number '%s'", cell)
+		}
+		field.SetInt(n)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(cell)
+		if err != nil {
+			return fmt.Errorf("invalid boolean '%s'", cell)
+		}
+		field.SetBool(b)
+	case reflect.Slice:
+		if field.Type().Elem().Kind() != reflect.String {
+			return fmt.Errorf("unsupported type %s", field.Type())
+		}
+		var items []string
+		for _, item := range strings.Split(cell, ListSeparator) {
+			if item = strings.TrimSpace(item); item != "" {
+				items = append(items, item)
+			}
+		}
+		field.Set(reflect.ValueOf(items))
+	default:
+		return fmt.Errorf("unsupported type %s", field.Type())
+	}
+
+	return nil
+}
+
+func formatValue(field reflect.Value) string {
+	if field.Kind() == reflect.Pointer {
+		if field.IsNil() {
+			return ""
+		}
+		field = field.Elem()
+	}
+
+	switch field.Kind() {
+	case reflect.String:
+		return field.String()
+	case reflect.Int, reflect.Int64:
+		return strconv.FormatInt(field.Int(), 10)
+	case reflect.Bool:
+		return strconv.FormatBool(field.Bool())
+	case reflect.Slice:
+		items := make([]string, field.Len())
+		for i := range items {
+			items[i] = field.Index(i).String()
+		}
+		return strings.Join(items, ListSeparator)
+	default:
+		return fmt.Sprint(field.Interface())
+	}
+}
@@ -0,0 +1,101 @@
+package bulkfile_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"pull_requests_service/pkg/bulkfile"
+)
+
+type record struct {
+	Id       string   `json:"id"`
+	Count    int      `json:"count"`
+	Limit    *int     `json:"limit"`
+	Enabled  bool     `json:"enabled"`
+	Tags     []string `json:"tags"`
+	Internal string   `json:"-"`
+}
+
+type row struct {
+	line   int
+	record record
+	err    error
+}
+
+func readAll(t *testing.T, format, input string) []row {
+	var rows []row
+	err := bulkfile.Read(strings.NewReader(input), format, func(line int, rec record, err error) error {
+		rows = append(rows, row{line: line, record: rec, err: err})
+		return nil
+	})
+	require.NoError(t, err)
+	return rows
+}
+
+func TestReadCSV(t *testing.T) {
+	rq := require.New(t)
+
+	rows := readAll(t, bulkfile.FormatCSV, "id,count,limit,enabled,tags\n"+
+		"a,1,,true,x;y\n"+
+		"b,two,,false,\n"+
+		"c,3\n")
+
+	rq.Len(rows, 3)
+	rq.NoError(rows[0].err)
+	rq.Equal(2, rows[0].line)
+	rq.Equal(record{Id: "a", Count: 1, Enabled: true, Tags: []string{"x", "y"}}, rows[0].record)
+	rq.ErrorContains(rows[1].err, "column 'count'")
+	rq.Equal(3, rows[1].line)
+	rq.Error(rows[2].err)
+	rq.Equal(4, rows[2].line)
+
+	err := bulkfile.Read(strings.NewReader("id,unknown\n"), bulkfile.FormatCSV, func(int, record, error) error { return nil })
+	rq.ErrorContains(err, "unknown column")
+}
+
+func TestReadJSONL(t *testing.T) {
+	rq := require.New(t)
+
+	rows := readAll(t, bulkfile.FormatJSONL, `{"id":"a","limit":5}`+"\n\n"+`{"id":"b","extra":1}`+"\n")
+
+	rq.Len(rows, 2)
+	rq.NoError(rows[0].err)
+	rq.Equal(5, *rows[0].record.Limit)
+	rq.Error(rows[1].err)
+	rq.Equal(3, rows[1].line)
+}
+
+func TestWriteReadRoundTrip(t *testing.T) {
+	rq := require.New(t)
+
+	limit := 3
+	records := []record{
+		{Id: "a", Count: 1, Limit: &limit, Enabled: true, Tags: []string{"x", "y"}, Internal: "hidden"},
+		{Id: "b, with comma"},
+	}
+
+	for _, format := range []string{bulkfile.FormatCSV, bulkfile.FormatJSONL} {
+		var buf bytes.Buffer
+		writer, err := bulkfile.NewWriter[record](&buf, format)
+		rq.NoError(err)
+		for _, rec := range records {
+			rq.NoError(writer.Write(rec))
+		}
+		rq.NoError(writer.Flush())
+
+		rows := readAll(t, format, buf.String())
+		rq.Len(rows, 2, format)
+		rq.NoError(rows[0].err)
+		rq.Equal("a", rows[0].record.Id)
+		rq.Equal(3, *rows[0].record.Limit)
+		rq.Equal([]string{"x", "y"}, rows[0].record.Tags)
+		rq.Empty(rows[0].record.Internal)
+		rq.Equal("b, with comma", rows[1].record.Id)
+	}
+
+	_, err := bulkfile.NewWriter[record](&bytes.Buffer{}, "xml")
+	rq.ErrorIs(err, bulkfile.ErrUnknownFormat)
+}
@@ -0,0 +1,140 @@
+package codeowners
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// ParseError — ошибка в строке файла владельцев, Line считается с 1.
+type ParseError struct {
+	Line int
+	Msg  string
+}
+
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("line %d: %s", e.Line, e.Msg)
+}
+
+// Rule — строка файла: шаблон пути и его владельцы без ведущего '@'.
+type Rule struct {
+	Pattern string
+	Owners  []string
+	re      *regexp.Regexp
+}
+
+// File — разобранный файл в формате CODEOWNERS. Как и в GitHub, из подходящих
+// правил действует последнее.
+type File struct {
+	Rules []Rule
+}
+
+// Parse разбирает содержимое файла. Поддерживаются шаблоны в стиле gitignore:
+// '*', '?', '**', ведущий '/' для привязки к корню и завершающий '/' для каталогов.
+// Отрицание '!' и классы символов '[...]' не поддерживаются, как и в GitHub.
+func Parse(content string) (File, error) {
+	var file File
+	for i, line := range strings.Split(content, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		fields := strings.Fields(line)
+		pattern := fields[0]
+		if strings.HasPrefix(pattern, "!") {
+			return File{}, &ParseError{Line: i + 1, Msg: "negation patterns are not supported"}
+		}
+		if strings.ContainsAny(pattern, "[]") {
+			return File{}, &ParseError{Line: i + 1, Msg: "character classes are not supported"}
+		}
+
+		var owners []string
+		for _, owner := range fields[1:] {
+			if strings.HasPrefix(owner, "#") {
+				break
+			}
+			owner = strings.TrimPrefix(owner, "@")
+			if owner == "" {
+				return File{}, &ParseError{Line: i + 1, Msg: "empty owner"}
+			}
+			owners = append(owners, owner)
+		}
+
+		re, err := regexp.Compile(patternRegexp(pattern))
+		if err != nil {
+			return File{}, &ParseError{Line: i + 1, Msg: fmt.Sprintf("invalid pattern %q", pattern)}
+		}
+		file.Rules = append(file.Rules, Rule{Pattern: pattern, Owners: owners, re: re})
+	}
+
+	return file, nil
+}
+
+// Owners возвращает владельцев пути по последнему подходящему правилу.
+func (f File) Owners(path string) []string {
+	path = strings.TrimPrefix(path, "/")
+	for i := len(f.Rules) - 1; i >= 0; i-- {
+		if f.Rules[i].re.MatchString(path) {
+			return f.Rules[i].Owners
+		}
+	}
+
+	return nil
+}
+
+// OwnersOf возвращает владельцев всех путей без повторов в порядке первого появления.
+func (f File) OwnersOf(paths []string) []string {
+	var owners []string
+	seen := make(map[string]struct{})
+	for _, path := range paths {
+		for _, owner := range f.Owners(path) {
+			if _, ok := seen[owner]; ok {
+				continue
+			}
+			seen[owner] = struct{}{}
+			owners = append(owners, owner)
+		}
+	}
+
+	return owners
+}
+
+func patternRegexp(pattern string) string {
+	dirOnly := strings.HasSuffix(pattern, "/")
+	pattern = strings.TrimSuffix(pattern, "/")
+
+	// шаблон со слешем в начале или середине привязан к корню, без слеша — к любому уровню
+	anchored := strings.Contains(pattern, "/")
+	pattern = strings.TrimPrefix(pattern, "/")
+
+	var b strings.Builder
+	b.WriteString("^")
+	if !anchored {
+		b.WriteString("(?:.*/)?")
+	}
+	for i := 0; i < len(pattern); i++ {
+		switch {
+		case strings.HasPrefix(pattern[i:], "**/"):
+			b.WriteString("(?:.*/)?")
+			i += 2
+		case strings.HasPrefix(pattern[i:], "**"):
+			b.WriteString(".*")
+			i++
+		case pattern[i] == '*':
+			b.WriteString("[^/]*")
+		case pattern[i] == '?':
+			b.WriteString("[^/]")
+		default:
+			b.WriteString(regexp.QuoteMeta(pattern[i : i+1]))
+		}
+	}
+	if dirOnly {
+		b.WriteString("/.*$")
+	} else {
+		// шаблон без завершающего слеша совпадает и с файлом, и с каталогом
+		b.WriteString("(?:/.*)?$")
+	}
+
+	return b.String()
+}
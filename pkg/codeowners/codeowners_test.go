@@ -0,0 +1,60 @@
+package codeowners_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"pull_requests_service/pkg/codeowners"
+)
+
+func TestOwners(t *testing.T) {
+	rq := require.New(t)
+
+	file, err := codeowners.Parse(`
+# владельцы по умолчанию
+*                 @u1
+*.go              u2
+/docs/            @u3 # документация
+internal/**/sql   u4 u5
+build/logs/       u6
+Makefile
+`)
+	rq.NoError(err)
+	rq.Len(file.Rules, 6)
+
+	rq.Equal([]string{"u1"}, file.Owners("README.md"))
+	rq.Equal([]string{"u2"}, file.Owners("cmd/main.go"))
+	rq.Equal([]string{"u3"}, file.Owners("docs/api/index.md"))
+	rq.Equal([]string{"u1"}, file.Owners("pkg/docs/index.md"))
+	rq.Equal([]string{"u4", "u5"}, file.Owners("internal/sql/query.sql"))
+	rq.Equal([]string{"u4", "u5"}, file.Owners("internal/a/b/sql/query.sql"))
+	rq.Equal([]string{"u6"}, file.Owners("/build/logs/out.txt"))
+	rq.Equal([]string{"u1"}, file.Owners("build/logs"))
+
+	// последнее подходящее правило без владельцев снимает их
+	rq.Empty(file.Owners("Makefile"))
+	rq.Empty(file.Owners("sub/Makefile"))
+
+	rq.Equal([]string{"u2", "u1", "u3"}, file.OwnersOf([]string{"a.go", "b.go", "x.txt", "docs/y.md"}))
+}
+
+func TestParseErrors(t *testing.T) {
+	rq := require.New(t)
+
+	_, err := codeowners.Parse("* u1\n!vendor/ u2")
+	var parseErr *codeowners.ParseError
+	rq.ErrorAs(err, &parseErr)
+	rq.Equal(2, parseErr.Line)
+
+	_, err = codeowners.Parse("*.[ch] u1")
+	rq.ErrorAs(err, &parseErr)
+	rq.Equal(1, parseErr.Line)
+
+	_, err = codeowners.Parse("*.go @")
+	rq.ErrorAs(err, &parseErr)
+
+	file, err := codeowners.Parse("")
+	rq.NoError(err)
+	rq.Empty(file.Owners("main.go"))
+}
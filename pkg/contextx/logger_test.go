@@ -8,7 +8,7 @@ import (
 
 	"github.com/stretchr/testify/require"
 
-	"go-backend-example/pkg/contextx"
+	"pull_requests_service/pkg/contextx"
 )
 
 func TestLogger(t *testing.T) {
@@ -0,0 +1,27 @@
+package contextx
+
+import (
+	"context"
+	"fmt"
+)
+
+type RequestID string
+
+type contextKeyRequestID struct{}
+
+func (r RequestID) String() string {
+	return string(r)
+}
+
+func WithRequestID(ctx context.Context, requestID RequestID) context.Context {
+	return context.WithValue(ctx, contextKeyRequestID{}, requestID)
+}
+
+func RequestIDFromContext(ctx context.Context) (RequestID, error) {
+	requestID, ok := ctx.Value(contextKeyRequestID{}).(RequestID)
+	if !ok {
+		return "", fmt.Errorf("request id: %w", ErrNoValue)
+	}
+
+	return requestID, nil
+}
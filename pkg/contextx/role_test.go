@@ -0,0 +1,28 @@
+package contextx_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"pull_requests_service/pkg/contextx"
+)
+
+func TestRole(t *testing.T) {
+	rq := require.New(t)
+	ctx := context.Background()
+
+	var testRoleEmpty contextx.Role
+
+	role, err := contextx.RoleFromContext(ctx)
+	rq.Equal(testRoleEmpty, role)
+	rq.ErrorIs(err, contextx.ErrNoValue)
+	rq.ErrorContains(err, "role: no value in context")
+
+	ctx = contextx.WithRole(ctx, contextx.RoleAdmin)
+
+	role, err = contextx.RoleFromContext(ctx)
+	rq.Equal(contextx.RoleAdmin, role)
+	rq.NoError(err)
+}
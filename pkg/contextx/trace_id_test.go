@@ -6,7 +6,7 @@ import (
 
 	"github.com/stretchr/testify/require"
 
-	"go-backend-example/pkg/contextx"
+	"pull_requests_service/pkg/contextx"
 )
 
 func TestTraceID(t *testing.T) {
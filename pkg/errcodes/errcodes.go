@@ -11,4 +11,9 @@ const (
 	NoCandidate         failure.ErrorCode = "NO_CANDIDATE"
 	PrMerged            failure.ErrorCode = "PR_MERGED"
 	NotAssigned         failure.ErrorCode = "NOT_ASSIGNED"
+	InvalidArgument     failure.ErrorCode = "INVALID_ARGUMENT"
+	NotApproved         failure.ErrorCode = "NOT_APPROVED"
+	InvalidTransition   failure.ErrorCode = "INVALID_TRANSITION"
+	IdempotencyKeyReuse failure.ErrorCode = "IDEMPOTENCY_KEY_REUSED"
+	RequestInProgress   failure.ErrorCode = "REQUEST_IN_PROGRESS"
 )
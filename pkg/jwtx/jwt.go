@@ -0,0 +1,148 @@
+package jwtx
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+const algHS256 = "HS256"
+
+var (
+	ErrMalformed      = errors.New("malformed token")
+	ErrAlgorithm      = errors.New("unsupported signing algorithm")
+	ErrSignature      = errors.New("invalid token signature")
+	ErrExpired        = errors.New("token is expired")
+	ErrNotValidYet    = errors.New("token is not valid yet")
+	ErrMissingSubject = errors.New("token has no subject")
+)
+
+// Claims — набор полей полезной нагрузки, которые понимает сервис.
+type Claims struct {
+	Subject   string `json:"sub"`
+	Role      string `json:"role"`
+	IssuedAt  int64  `json:"iat,omitempty"`
+	NotBefore int64  `json:"nbf,omitempty"`
+	ExpiresAt int64  `json:"exp,omitempty"`
+}
+
+type header struct {
+	Alg string `json:"alg"`
+	Typ string `json:"typ"`
+}
+
+var encoding = base64.RawURLEncoding //nolint:gochecknoglobals
+
+// Sign выпускает HS256 токен с переданными claims.
+func Sign(claims Claims, key []byte) (string, error) {
+	h, err := json.Marshal(header{Alg: algHS256, Typ: "JWT"})
+	if err != nil {
+		return "", fmt.Errorf("json.Marshal(header): %w", err)
+	}
+
+	p, err := json.Marshal(claims)
+	if err != nil {
+		return "", fmt.Errorf("json.Marshal(claims): %w", err)
+	}
+
+	unsigned := encoding.EncodeToString(h) + "." + encoding.EncodeToString(p)
+
+	return unsigned + "." + encoding.EncodeToString(sign(unsigned, key)), nil
+}
+
+// Verifier проверяет подпись и сроки действия токенов.
+// Ключей может быть несколько, чтобы старые токены жили во время ротации секрета.
+type Verifier struct {
+	keys   [][]byte
+	leeway time.Duration
+	now    func() time.Time
+}
+
+func NewVerifier(keys [][]byte, leeway time.Duration) *Verifier {
+	return &Verifier{
+		keys:   keys,
+		leeway: leeway,
+		now:    time.Now,
+	}
+}
+
+func (v *Verifier) Verify(token string) (Claims, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 { //nolint:mnd // header.payload.signature
+		return Claims{}, ErrMalformed
+	}
+
+	var h header
+	if err := decodeSegment(parts[0], &h); err != nil {
+		return Claims{}, err
+	}
+
+	if h.Alg != algHS256 {
+		return Claims{}, fmt.Errorf("%w: %q", ErrAlgorithm, h.Alg)
+	}
+
+	signature, err := encoding.DecodeString(parts[2])
+	if err != nil {
+		return Claims{}, ErrMalformed
+	}
+
+	if !v.validSignature(parts[0]+"."+parts[1], signature) {
+		return Claims{}, ErrSignature
+	}
+
+	var claims Claims
+	if err = decodeSegment(parts[1], &claims); err != nil {
+		return Claims{}, err
+	}
+
+	now := v.now()
+
+	if claims.ExpiresAt != 0 && now.After(time.Unix(claims.ExpiresAt, 0).Add(v.leeway)) {
+		return Claims{}, ErrExpired
+	}
+
+	if claims.NotBefore != 0 && now.Add(v.leeway).Before(time.Unix(claims.NotBefore, 0)) {
+		return Claims{}, ErrNotValidYet
+	}
+
+	if claims.Subject == "" {
+		return Claims{}, ErrMissingSubject
+	}
+
+	return claims, nil
+}
+
+func (v *Verifier) validSignature(unsigned string, signature []byte) bool {
+	for _, key := range v.keys {
+		if hmac.Equal(signature, sign(unsigned, key)) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func sign(unsigned string, key []byte) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(unsigned))
+
+	return mac.Sum(nil)
+}
+
+func decodeSegment(segment string, dest any) error {
+	raw, err := encoding.DecodeString(segment)
+	if err != nil {
+		return ErrMalformed
+	}
+
+	if err = json.Unmarshal(raw, dest); err != nil {
+		return ErrMalformed
+	}
+
+	return nil
+}
@@ -0,0 +1,84 @@
+package jwtx_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"pull_requests_service/pkg/jwtx"
+)
+
+func TestVerify(t *testing.T) {
+	rq := require.New(t)
+
+	current := []byte("current-secret")
+	previous := []byte("previous-secret")
+	verifier := jwtx.NewVerifier([][]byte{current, previous}, time.Second)
+
+	claims := jwtx.Claims{
+		Subject:   "u1",
+		Role:      "admin",
+		ExpiresAt: time.Now().Add(time.Hour).Unix(),
+	}
+
+	token, err := jwtx.Sign(claims, current)
+	rq.NoError(err)
+
+	got, err := verifier.Verify(token)
+	rq.NoError(err)
+	rq.Equal(claims, got)
+
+	token, err = jwtx.Sign(claims, previous)
+	rq.NoError(err)
+
+	_, err = verifier.Verify(token)
+	rq.NoError(err)
+
+	token, err = jwtx.Sign(claims, []byte("unknown-secret"))
+	rq.NoError(err)
+
+	_, err = verifier.Verify(token)
+	rq.ErrorIs(err, jwtx.ErrSignature)
+
+	claims.ExpiresAt = time.Now().Add(-time.Hour).Unix()
+	token, err = jwtx.Sign(claims, current)
+	rq.NoError(err)
+
+	_, err = verifier.Verify(token)
+	rq.ErrorIs(err, jwtx.ErrExpired)
+
+	claims.ExpiresAt = 0
+	claims.NotBefore = time.Now().Add(time.Hour).Unix()
+	token, err = jwtx.Sign(claims, current)
+	rq.NoError(err)
+
+	_, err = verifier.Verify(token)
+	rq.ErrorIs(err, jwtx.ErrNotValidYet)
+
+	claims.NotBefore = 0
+	claims.Subject = ""
+	token, err = jwtx.Sign(claims, current)
+	rq.NoError(err)
+
+	_, err = verifier.Verify(token)
+	rq.ErrorIs(err, jwtx.ErrMissingSubject)
+}
+
+func TestVerifyMalformed(t *testing.T) {
+	rq := require.New(t)
+
+	verifier := jwtx.NewVerifier([][]byte{[]byte("secret")}, 0)
+
+	_, err := verifier.Verify("not-a-token")
+	rq.ErrorIs(err, jwtx.ErrMalformed)
+
+	token, err := jwtx.Sign(jwtx.Claims{Subject: "u1"}, []byte("secret"))
+	rq.NoError(err)
+
+	parts := strings.Split(token, ".")
+	// {"alg":"none","typ":"JWT"}
+	_, err = verifier.Verify("eyJhbGciOiJub25lIiwidHlwIjoiSldUIn0." + parts[1] + ".")
+	rq.ErrorIs(err, jwtx.ErrAlgorithm)
+}
@@ -0,0 +1,52 @@
+package middlewarex
+
+import (
+	"net/http"
+	"strings"
+
+	"pull_requests_service/pkg/contextx"
+	"pull_requests_service/pkg/jwtx"
+	"pull_requests_service/pkg/logx"
+)
+
+type TokenVerifier interface {
+	Verify(token string) (jwtx.Claims, error)
+}
+
+// Auth кладёт в контекст пользователя и роль из Bearer-токена.
+// Запросы без токена или с невалидным токеном пропускаются дальше анонимными —
+// решение об отказе принимает слой авторизации конкретной операции.
+func Auth(verifier TokenVerifier) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			token, ok := bearerToken(r)
+			if !ok {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			claims, err := verifier.Verify(token)
+			if err != nil {
+				logger(r.Context()).Debug("auth: token rejected", logx.Error(err))
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			ctx := contextx.WithUserID(r.Context(), contextx.UserID(claims.Subject))
+			ctx = contextx.WithRole(ctx, contextx.Role(claims.Role))
+
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
+func bearerToken(r *http.Request) (string, bool) {
+	const prefix = "Bearer "
+
+	value := r.Header.Get("Authorization")
+	if len(value) <= len(prefix) || !strings.EqualFold(value[:len(prefix)], prefix) {
+		return "", false
+	}
+
+	return strings.TrimSpace(value[len(prefix):]), true
+}
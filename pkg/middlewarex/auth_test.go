@@ -0,0 +1,60 @@
+package middlewarex_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"pull_requests_service/pkg/contextx"
+	"pull_requests_service/pkg/jwtx"
+	"pull_requests_service/pkg/middlewarex"
+)
+
+func TestAuth(t *testing.T) {
+	rq := require.New(t)
+
+	key := []byte("secret")
+	valid, err := jwtx.Sign(jwtx.Claims{Subject: "u1", Role: "user", ExpiresAt: time.Now().Add(time.Hour).Unix()}, key)
+	rq.NoError(err)
+	expired, err := jwtx.Sign(jwtx.Claims{Subject: "u1", Role: "user", ExpiresAt: time.Now().Add(-time.Hour).Unix()}, key)
+	rq.NoError(err)
+	foreign, err := jwtx.Sign(jwtx.Claims{Subject: "u1", Role: "admin"}, []byte("other-secret"))
+	rq.NoError(err)
+
+	var userID contextx.UserID
+	var role contextx.Role
+	handler := middlewarex.Auth(jwtx.NewVerifier([][]byte{key}, time.Second))(
+		http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+			userID, _ = contextx.UserIDFromContext(r.Context())
+			role, _ = contextx.RoleFromContext(r.Context())
+		}))
+
+	tests := []struct {
+		name          string
+		authorization string
+		userID        contextx.UserID
+		role          contextx.Role
+	}{
+		{name: "valid token", authorization: "Bearer " + valid, userID: "u1", role: contextx.RoleUser},
+		{name: "lowercase scheme", authorization: "bearer " + valid, userID: "u1", role: contextx.RoleUser},
+		{name: "no header"},
+		{name: "not bearer", authorization: "Basic dTE6cGFzcw=="},
+		{name: "garbage token", authorization: "Bearer not-a-jwt"},
+		{name: "expired token", authorization: "Bearer " + expired},
+		{name: "unknown key", authorization: "Bearer " + foreign},
+	}
+	for _, tt := range tests {
+		userID, role = "", ""
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		if tt.authorization != "" {
+			req.Header.Set("Authorization", tt.authorization)
+		}
+
+		handler.ServeHTTP(httptest.NewRecorder(), req)
+		rq.Equal(tt.userID, userID, tt.name)
+		rq.Equal(tt.role, role, tt.name)
+	}
+}
@@ -1,5 +1,21 @@
 package middlewarex
 
-import "pull_requests_service/pkg/contextx"
+import (
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+
+	"pull_requests_service/pkg/contextx"
+)
 
 var logger = contextx.LoggerFromContextOrDefault //nolint:gochecknoglobals
+
+const unmatchedRoute = "unmatched"
+
+// routePattern возвращает шаблон маршрута chi; заполняется только после того, как запрос прошёл роутинг.
+func routePattern(r *http.Request) string {
+	if rctx := chi.RouteContext(r.Context()); rctx != nil && rctx.RoutePattern() != "" {
+		return rctx.RoutePattern()
+	}
+	return unmatchedRoute
+}
@@ -1,7 +1,6 @@
 package middlewarex
 
 import (
-	"context"
 	"log/slog"
 	"net/http"
 	"time"
@@ -12,18 +11,13 @@ func Logger(next http.Handler) http.Handler {
 		startTime := time.Now()
 		logger(r.Context()).Info("request", RequestLogRestapi(r))
 
-		ctx := context.WithValue(r.Context(), "startTime", startTime)
-		ctx = context.WithValue(ctx, "ip", r.RemoteAddr)
-		ctx = context.WithValue(ctx, "userAgent", r.UserAgent())
-		r = r.WithContext(ctx)
-
 		lw := LoggingResponseWriter{
 			ResponseWriter: w,
 			StatusCode:     http.StatusOK,
 			Size:           0,
 		}
 		next.ServeHTTP(&lw, r)
-		logger(r.Context()).Info("response", ResponseLogRestapi(r.Context(), lw))
+		logger(r.Context()).Info("response", ResponseLogRestapi(r, lw, startTime))
 	})
 }
 
@@ -38,10 +32,9 @@ func RequestLogRestapi(r *http.Request) slog.Attr {
 	return slog.Any("request_info", requestInfo)
 }
 
-func ResponseLogRestapi(ctx context.Context, w LoggingResponseWriter) slog.Attr {
-	start := ctx.Value("startTime")
-	startTime := start.(time.Time)
+func ResponseLogRestapi(r *http.Request, w LoggingResponseWriter, startTime time.Time) slog.Attr {
 	responseInfo := []slog.Attr{
+		slog.String("route", routePattern(r)),
 		slog.Int("status", w.StatusCode),
 		slog.Int("Size", w.Size),
 		slog.Int64("duration", time.Since(startTime).Milliseconds()),
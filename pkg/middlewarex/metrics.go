@@ -0,0 +1,43 @@
+package middlewarex
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+//nolint:gochecknoglobals
+var (
+	httpRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "http_requests_total",
+		Help: "Number of HTTP requests by route and status.",
+	}, []string{"method", "route", "status"})
+
+	httpDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "http_request_duration_seconds",
+		Help:    "HTTP request latency by route.",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"method", "route"})
+)
+
+// Metrics считает запросы и их длительность в разрезе шаблона маршрута chi,
+// чтобы идентификаторы из пути не раздували число временных рядов.
+func Metrics(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		startTime := time.Now()
+
+		lw := LoggingResponseWriter{
+			ResponseWriter: w,
+			StatusCode:     http.StatusOK,
+			Size:           0,
+		}
+		next.ServeHTTP(&lw, r)
+
+		route := routePattern(r)
+		httpRequests.WithLabelValues(r.Method, route, strconv.Itoa(lw.StatusCode)).Inc()
+		httpDuration.WithLabelValues(r.Method, route).Observe(time.Since(startTime).Seconds())
+	})
+}
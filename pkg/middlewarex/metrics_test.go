@@ -0,0 +1,40 @@
+package middlewarex_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/stretchr/testify/require"
+
+	"pull_requests_service/pkg/middlewarex"
+)
+
+func TestMetricsUsesRoutePattern(t *testing.T) {
+	rq := require.New(t)
+
+	router := chi.NewRouter()
+	router.Use(middlewarex.Metrics)
+	router.Get("/items/{id}", func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusTeapot)
+	})
+	router.Handle("/metrics", promhttp.Handler())
+
+	for _, id := range []string{"1", "2"} {
+		router.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/items/"+id, nil))
+	}
+	router.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/missing", nil))
+
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	rq.Equal(http.StatusOK, rec.Code)
+
+	body := rec.Body.String()
+	rq.Contains(body, `http_requests_total{method="GET",route="/items/{id}",status="418"} 2`)
+	rq.Contains(body, `http_requests_total{method="GET",route="unmatched",status="404"} 1`)
+	rq.Contains(body, `http_request_duration_seconds_count{method="GET",route="/items/{id}"} 2`)
+	rq.False(strings.Contains(body, `route="/items/1"`))
+}
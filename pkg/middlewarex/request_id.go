@@ -0,0 +1,72 @@
+package middlewarex
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"log/slog"
+	"net/http"
+
+	"pull_requests_service/pkg/contextx"
+)
+
+const (
+	HeaderRequestID = "X-Request-ID"
+
+	maxRequestIDLength = 128
+)
+
+// RequestID берёт идентификатор запроса из X-Request-ID или генерирует новый, возвращает его в ответе
+// и кладёт в контекст вместе с дочерним логгером, чтобы все строки лога запроса можно было связать.
+func RequestID(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requestID := r.Header.Get(HeaderRequestID)
+		if !validRequestID(requestID) {
+			requestID = newRequestID()
+		}
+
+		w.Header().Set(HeaderRequestID, requestID)
+
+		ctx := contextx.WithRequestID(r.Context(), contextx.RequestID(requestID))
+		ctx = contextx.WithLogger(ctx, logger(ctx).With(slog.String("request_id", requestID)))
+
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+// RouteLogger дополняет логгер запроса шаблоном маршрута и пользователем.
+// Ставится на уровне маршрутов: только там шаблон уже известен, а Auth уже отработал.
+func RouteLogger(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		attrs := []any{slog.String("route", routePattern(r))}
+		if userID, err := contextx.UserIDFromContext(ctx); err == nil {
+			attrs = append(attrs, slog.String("user_id", userID.String()))
+		}
+		ctx = contextx.WithLogger(ctx, logger(ctx).With(attrs...))
+
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+// validRequestID пропускает только короткие печатные id без пробелов, чтобы клиент не мог засорить логи.
+func validRequestID(id string) bool {
+	if id == "" || len(id) > maxRequestIDLength {
+		return false
+	}
+
+	for _, c := range id {
+		if c <= ' ' || c > '~' {
+			return false
+		}
+	}
+
+	return true
+}
+
+func newRequestID() string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+
+	return hex.EncodeToString(b)
+}
@@ -0,0 +1,68 @@
+package middlewarex_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/require"
+
+	"pull_requests_service/pkg/contextx"
+	"pull_requests_service/pkg/middlewarex"
+)
+
+func TestRequestIDAndRouteLogger(t *testing.T) {
+	rq := require.New(t)
+
+	var logs bytes.Buffer
+	base := slog.New(slog.NewJSONHandler(&logs, nil))
+
+	var seenRequestID contextx.RequestID
+	router := chi.NewRouter()
+	router.Use(
+		func(next http.Handler) http.Handler {
+			return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				ctx := contextx.WithLogger(r.Context(), base)
+				ctx = contextx.WithUserID(ctx, "u1")
+				next.ServeHTTP(w, r.WithContext(ctx))
+			})
+		},
+		middlewarex.RequestID,
+	)
+	router.With(middlewarex.RouteLogger).Get("/items/{id}", func(_ http.ResponseWriter, r *http.Request) {
+		seenRequestID, _ = contextx.RequestIDFromContext(r.Context())
+		contextx.LoggerFromContextOrDefault(r.Context()).Info("handled")
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/items/1", nil)
+	req.Header.Set(middlewarex.HeaderRequestID, "req-42")
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+
+	rq.Equal("req-42", rec.Header().Get(middlewarex.HeaderRequestID))
+	rq.Equal(contextx.RequestID("req-42"), seenRequestID)
+
+	var line map[string]any
+	rq.NoError(json.Unmarshal(logs.Bytes(), &line))
+	rq.Equal("handled", line["msg"])
+	rq.Equal("req-42", line["request_id"])
+	rq.Equal("/items/{id}", line["route"])
+	rq.Equal("u1", line["user_id"])
+
+	for _, header := range []string{"", "has space", strings.Repeat("a", 129)} {
+		req = httptest.NewRequest(http.MethodGet, "/items/1", nil)
+		req.Header.Set(middlewarex.HeaderRequestID, header)
+		rec = httptest.NewRecorder()
+		router.ServeHTTP(rec, req)
+
+		generated := rec.Header().Get(middlewarex.HeaderRequestID)
+		rq.Len(generated, 32)
+		rq.NotEqual(header, generated)
+		rq.Equal(contextx.RequestID(generated), seenRequestID)
+	}
+}
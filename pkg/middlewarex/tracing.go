@@ -0,0 +1,59 @@
+package middlewarex
+
+import (
+	"log/slog"
+	"net/http"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+
+	"pull_requests_service/pkg/contextx"
+	"pull_requests_service/pkg/tracing"
+
+	semconv "go.opentelemetry.io/otel/semconv/v1.37.0"
+)
+
+// Tracing продолжает трейс из заголовка traceparent (или начинает новый) и открывает серверный спан запроса.
+// Trace id кладётся в контекст и в логгер, а traceparent возвращается клиенту для поиска трейса.
+func Tracing(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		propagator := otel.GetTextMapPropagator()
+		ctx := propagator.Extract(r.Context(), propagation.HeaderCarrier(r.Header))
+
+		ctx, span := tracing.Tracer().Start(ctx, r.Method,
+			trace.WithSpanKind(trace.SpanKindServer),
+			trace.WithAttributes(
+				semconv.HTTPRequestMethodKey.String(r.Method),
+				semconv.URLPath(r.URL.Path),
+			),
+		)
+		defer span.End()
+
+		spanCtx := span.SpanContext()
+		ctx = contextx.WithTraceID(ctx, contextx.TraceID(spanCtx.TraceID().String()))
+		ctx = contextx.WithLogger(ctx, logger(ctx).With(
+			slog.String("trace_id", spanCtx.TraceID().String()),
+			slog.String("span_id", spanCtx.SpanID().String()),
+		))
+		propagator.Inject(ctx, propagation.HeaderCarrier(w.Header()))
+
+		lw := LoggingResponseWriter{
+			ResponseWriter: w,
+			StatusCode:     http.StatusOK,
+			Size:           0,
+		}
+		next.ServeHTTP(&lw, r.WithContext(ctx))
+
+		route := routePattern(r)
+		span.SetName(r.Method + " " + route)
+		span.SetAttributes(
+			semconv.HTTPRoute(route),
+			semconv.HTTPResponseStatusCode(lw.StatusCode),
+		)
+		if lw.StatusCode >= http.StatusInternalServerError {
+			span.SetStatus(codes.Error, http.StatusText(lw.StatusCode))
+		}
+	})
+}
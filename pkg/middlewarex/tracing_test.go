@@ -0,0 +1,58 @@
+package middlewarex_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+
+	"pull_requests_service/pkg/contextx"
+	"pull_requests_service/pkg/middlewarex"
+)
+
+func TestTracingContinuesTraceparent(t *testing.T) {
+	rq := require.New(t)
+
+	exporter := tracetest.NewInMemoryExporter()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	t.Cleanup(func() { _ = provider.Shutdown(t.Context()) })
+
+	const (
+		traceID      = "4bf92f3577b34da6a3ce929d0e0e4736"
+		parentSpanID = "00f067aa0ba902b7"
+	)
+
+	var seenTraceID contextx.TraceID
+	router := chi.NewRouter()
+	router.Use(middlewarex.Tracing)
+	router.Get("/items/{id}", func(w http.ResponseWriter, r *http.Request) {
+		seenTraceID, _ = contextx.TraceIDFromContext(r.Context())
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/items/42", nil)
+	req.Header.Set("traceparent", "00-"+traceID+"-"+parentSpanID+"-01")
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+
+	rq.Equal(contextx.TraceID(traceID), seenTraceID)
+	rq.Contains(rec.Header().Get("traceparent"), traceID)
+
+	spans := exporter.GetSpans()
+	rq.Len(spans, 1)
+	span := spans[0]
+	rq.Equal("GET /items/{id}", span.Name)
+	rq.Equal(trace.SpanKindServer, span.SpanKind)
+	rq.Equal(traceID, span.SpanContext.TraceID().String())
+	rq.Equal(parentSpanID, span.Parent.SpanID().String())
+	rq.Equal("Error", span.Status.Code.String())
+}
@@ -0,0 +1,52 @@
+package pagination_test
+
+import (
+	"encoding/base64"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"pull_requests_service/pkg/pagination"
+)
+
+func TestCursor(t *testing.T) {
+	rq := require.New(t)
+
+	cursor := pagination.Cursor{
+		At: time.Date(2025, 10, 24, 12, 34, 56, 789, time.UTC),
+		ID: "pr-1001|with-separator",
+	}
+
+	decoded, err := pagination.Decode(cursor.Encode())
+	rq.NoError(err)
+	rq.True(cursor.At.Equal(decoded.At))
+	rq.Equal(cursor.ID, decoded.ID)
+
+	_, err = pagination.Decode("%%%")
+	rq.ErrorIs(err, pagination.ErrInvalidCursor)
+
+	_, err = pagination.Decode("bm90LWEtY3Vyc29y") // not-a-cursor
+	rq.ErrorIs(err, pagination.ErrInvalidCursor)
+}
+
+func TestCursorRejectsMalformed(t *testing.T) {
+	rq := require.New(t)
+
+	// время в другой зоне нормализуется в UTC и переживает кодирование
+	moscow := time.FixedZone("MSK", 3*60*60)
+	at := time.Date(2025, 10, 24, 15, 0, 0, 1, moscow)
+	decoded, err := pagination.Decode(pagination.Cursor{At: at, ID: "pr-1"}.Encode())
+	rq.NoError(err)
+	rq.True(at.Equal(decoded.At))
+
+	for _, raw := range []string{
+		"",
+		"yesterday|pr-1",
+		"2025-10-24T12:00:00Z|",
+		"2025-10-24T12:00:00Z",
+	} {
+		_, err = pagination.Decode(base64.RawURLEncoding.EncodeToString([]byte(raw)))
+		rq.ErrorIs(err, pagination.ErrInvalidCursor, raw)
+	}
+}
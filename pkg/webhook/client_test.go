@@ -0,0 +1,56 @@
+package webhook_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"pull_requests_service/pkg/webhook"
+)
+
+func TestClientSend(t *testing.T) {
+	rq := require.New(t)
+
+	const secret = "s3cret"
+	body := []byte(`{"type":"pr.merged"}`)
+
+	var (
+		gotBody    []byte
+		gotHeaders http.Header
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotBody, _ = io.ReadAll(r.Body)
+		gotHeaders = r.Header.Clone()
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer srv.Close()
+
+	client := webhook.NewClient(srv.Client())
+	status, err := client.Send(context.Background(), srv.URL, secret,
+		webhook.Message{DeliveryID: "42", Event: "pr.merged", Body: body})
+	rq.NoError(err)
+	rq.Equal(http.StatusNoContent, status)
+
+	rq.Equal(body, gotBody)
+	rq.Equal("pr.merged", gotHeaders.Get(webhook.HeaderEvent))
+	rq.Equal("42", gotHeaders.Get(webhook.HeaderDelivery))
+	rq.True(webhook.Verify(secret, gotBody, gotHeaders.Get(webhook.HeaderSignature)))
+	rq.False(webhook.Verify("other", gotBody, gotHeaders.Get(webhook.HeaderSignature)))
+}
+
+func TestClientSendNon2xx(t *testing.T) {
+	rq := require.New(t)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer srv.Close()
+
+	status, err := webhook.NewClient(srv.Client()).Send(context.Background(), srv.URL, "s", webhook.Message{Body: []byte("{}")})
+	rq.Error(err)
+	rq.Equal(http.StatusBadGateway, status)
+}